@@ -10,6 +10,7 @@ import (
 //go:generate go build -o gencmd github.com/omniskop/vitrum/vit/generator/gencmd
 //go:generate ./gencmd -i Button.vit -o button_gen.go -p github.com/omniskop/vitrum/controls
 //go:generate ./gencmd -i TextField.vit -o textField_gen.go -p github.com/omniskop/vitrum/controls
+//go:generate ./gencmd -i TextArea.vit -o textArea_gen.go -p github.com/omniskop/vitrum/controls
 //go:generate rm ./gencmd
 
 func init() {
@@ -20,7 +21,7 @@ type ControlsLib struct {
 }
 
 func (l ControlsLib) ComponentNames() []string {
-	return []string{"Button", "TextField"}
+	return []string{"Button", "TextField", "TextArea"}
 }
 
 func (l ControlsLib) NewComponent(name string, id string, globalCtx *vit.GlobalContext) (vit.Component, bool) {
@@ -31,6 +32,8 @@ func (l ControlsLib) NewComponent(name string, id string, globalCtx *vit.GlobalC
 		comp, err = newButtonInGlobal(id, globalCtx, l)
 	case "TextField":
 		comp, err = newTextFieldInGlobal(id, globalCtx, l)
+	case "TextArea":
+		comp, err = newTextAreaInGlobal(id, globalCtx, l)
 	default:
 		return nil, false
 	}
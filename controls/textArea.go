@@ -0,0 +1,24 @@
+package controls
+
+import (
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/std"
+)
+
+func (a *TextArea) wasCompleted(*struct{}) {
+	editComponent, _ := a.Context().GetComponentByID("edit")
+	edit := editComponent.(*std.TextEdit)
+
+	// keep the text of the area and the edit in sync
+	editText := edit.MustProperty("text").(*vit.StringValue)
+	editText.AddDependent(vit.FuncDep(func() {
+		if a.text.String() != editText.String() {
+			a.text.SetStringValue(editText.String())
+		}
+	}))
+	a.text.AddDependent(vit.FuncDep(func() {
+		if a.text.String() != editText.String() {
+			editText.SetStringValue(a.text.String())
+		}
+	}))
+}
@@ -0,0 +1,227 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package controls
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	std "github.com/omniskop/vitrum/vit/std"
+	vpath "github.com/omniskop/vitrum/vit/vpath"
+)
+
+func newFileContextForTextArea(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	fileCtx := vit.NewFileContext(globalCtx)
+
+	var lib parse.Library
+	var err error
+	lib, err = parse.ResolveLibrary([]string{"Vit"})
+	if err != nil {
+		// The file used to generate the "TextArea" component imported a library called "Vit".
+		// If this error occurs that imported failed. Probably because the library is not known.
+		return nil, fmt.Errorf("unable to create file context for generated \"TextArea\" component: %w", err)
+	}
+	parse.AddLibraryToContainer(lib, &fileCtx.KnownComponents)
+
+	return fileCtx, nil
+}
+
+type TextArea struct {
+	*std.FocusScope
+	id string
+
+	text            vit.StringValue
+	placeholderText vit.StringValue
+	lineCount       vit.IntValue
+}
+
+// newTextAreaInGlobal creates an appropriate file context for the component and then returns a new TextArea instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newTextAreaInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*TextArea, error) {
+	fileCtx, err := newFileContextForTextArea(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewTextArea(id, fileCtx), nil
+}
+func NewTextArea(id string, context *vit.FileContext) *TextArea {
+	t := &TextArea{
+		FocusScope:      std.NewFocusScope("textArea", context),
+		id:              id,
+		text:            *vit.NewEmptyStringValue(),
+		placeholderText: *vit.NewEmptyStringValue(),
+		lineCount:       *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "edit.lineCount", Position: nil}),
+	}
+	// property assignments on embedded components
+	t.FocusScope.SetPropertyCode("activeFocusOnTab", vit.Code{FileCtx: context, Code: "true", Position: nil})
+	t.FocusScope.SetPropertyCode("width", vit.Code{FileCtx: context, Code: "300", Position: nil})
+	t.FocusScope.SetPropertyCode("height", vit.Code{FileCtx: context, Code: "150", Position: nil})
+	// register listeners for when a property changes
+	// register event listeners
+	var event vit.Listenable
+	var listener vit.Evaluater
+	event, _ = t.Root.Event("onCompleted")
+	listener = event.CreateListener(vit.Code{FileCtx: context, Code: "function() {}", Position: nil})
+	t.AddListenerFunction(listener)
+	event.(*vit.EventAttribute[struct{}]).AddListener(vit.ListenerCB[struct{}](t.wasCompleted))
+	// register enumerations
+	// add child components
+	var child vit.Component
+	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "Rectangle", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 15, StartColumn: 9, EndLine: 15, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 15, StartColumn: 23, EndLine: 15, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 16, StartColumn: 9, EndLine: 16, EndColumn: 37}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 16, StartColumn: 16, EndLine: 16, EndColumn: 37}, Identifier: []string{"color"}, Expression: "Vit.rgb(230, 230, 230)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 17, StartColumn: 9, EndLine: 17, EndColumn: 44}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 17, StartColumn: 23, EndLine: 17, EndColumn: 44}, Identifier: []string{"border", "color"}, Expression: "Vit.rgb(130, 130, 130)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 18, StartColumn: 9, EndLine: 18, EndColumn: 48}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 18, StartColumn: 23, EndLine: 18, EndColumn: 48}, Identifier: []string{"border", "width"}, Expression: "parent.activeFocus ? 2 : 0", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 19, StartColumn: 9, EndLine: 19, EndColumn: 17}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 19, StartColumn: 17, EndLine: 19, EndColumn: 17}, Identifier: []string{"radius"}, Expression: "5", Tags: map[string]string{}}}}, context)
+	t.AddChild(child)
+	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "Text", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 23, StartColumn: 9, EndLine: 23, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 23, StartColumn: 23, EndLine: 23, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 24, StartColumn: 9, EndLine: 24, EndColumn: 26}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 24, StartColumn: 26, EndLine: 24, EndColumn: 26}, Identifier: []string{"anchors", "margins"}, Expression: "5", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 25, StartColumn: 9, EndLine: 25, EndColumn: 59}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 25, StartColumn: 15, EndLine: 25, EndColumn: 59}, Identifier: []string{"text"}, Expression: "edit.text == \"\" ? parent.placeholderText : \"\"", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 26, StartColumn: 9, EndLine: 26, EndColumn: 37}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 26, StartColumn: 16, EndLine: 26, EndColumn: 37}, Identifier: []string{"color"}, Expression: "Vit.rgb(150, 150, 150)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 27, StartColumn: 9, EndLine: 27, EndColumn: 40}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 27, StartColumn: 28, EndLine: 27, EndColumn: 40}, Identifier: []string{"verticalAlignment"}, Expression: "Text.AlignTop", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 28, StartColumn: 9, EndLine: 28, EndColumn: 26}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 28, StartColumn: 25, EndLine: 28, EndColumn: 26}, Identifier: []string{"font", "pointSize"}, Expression: "40", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 29, StartColumn: 9, EndLine: 29, EndColumn: 33}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 29, StartColumn: 22, EndLine: 29, EndColumn: 33}, Identifier: []string{"font", "family"}, Expression: "\"Montserrat\"", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 30, StartColumn: 9, EndLine: 30, EndColumn: 32}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 30, StartColumn: 22, EndLine: 30, EndColumn: 32}, Identifier: []string{"font", "weight"}, Expression: "Text.Medium", Tags: map[string]string{}}}}, context)
+	t.AddChild(child)
+	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "TextEdit", ID: "edit", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 35, StartColumn: 9, EndLine: 35, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 35, StartColumn: 23, EndLine: 35, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 36, StartColumn: 9, EndLine: 36, EndColumn: 26}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 36, StartColumn: 26, EndLine: 36, EndColumn: 26}, Identifier: []string{"anchors", "margins"}, Expression: "5", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 37, StartColumn: 9, EndLine: 37, EndColumn: 19}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 37, StartColumn: 16, EndLine: 37, EndColumn: 19}, Identifier: []string{"focus"}, Expression: "true", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 38, StartColumn: 9, EndLine: 38, EndColumn: 26}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 38, StartColumn: 25, EndLine: 38, EndColumn: 26}, Identifier: []string{"font", "pointSize"}, Expression: "40", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 39, StartColumn: 9, EndLine: 39, EndColumn: 33}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 39, StartColumn: 22, EndLine: 39, EndColumn: 33}, Identifier: []string{"font", "family"}, Expression: "\"Montserrat\"", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 40, StartColumn: 9, EndLine: 40, EndColumn: 32}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextArea.vit"), StartLine: 40, StartColumn: 22, EndLine: 40, EndColumn: 32}, Identifier: []string{"font", "weight"}, Expression: "Text.Medium", Tags: map[string]string{}}}}, context)
+	t.AddChild(child)
+
+	context.RegisterComponent("textArea", t)
+
+	return t
+}
+
+func (t *TextArea) String() string {
+	return fmt.Sprintf("TextArea(%s)", t.id)
+}
+
+func (t *TextArea) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "text":
+		return &t.text, true
+	case "placeholderText":
+		return &t.placeholderText, true
+	case "lineCount":
+		return &t.lineCount, true
+	default:
+		return t.FocusScope.Property(key)
+	}
+}
+
+func (t *TextArea) MustProperty(key string) vit.Value {
+	v, ok := t.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (t *TextArea) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "text":
+		err = t.text.SetValue(value)
+	case "placeholderText":
+		err = t.placeholderText.SetValue(value)
+	case "lineCount":
+		err = t.lineCount.SetValue(value)
+	default:
+		return t.FocusScope.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("TextArea", key, t.id, err)
+	}
+	return nil
+}
+
+func (t *TextArea) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "text":
+		t.text.SetCode(code)
+	case "placeholderText":
+		t.placeholderText.SetCode(code)
+	case "lineCount":
+		t.lineCount.SetCode(code)
+	default:
+		return t.FocusScope.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (t *TextArea) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return t.FocusScope.Event(name)
+	}
+}
+
+func (t *TextArea) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "text":
+		return &t.text, true
+	case "placeholderText":
+		return &t.placeholderText, true
+	case "lineCount":
+		return &t.lineCount, true
+	default:
+		return t.FocusScope.ResolveVariable(key)
+	}
+}
+
+func (t *TextArea) AddChild(child vit.Component) {
+	child.SetParent(t)
+	t.AddChildButKeepParent(child)
+}
+
+func (t *TextArea) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range t.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(t)
+			t.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	t.AddChild(addThis)
+}
+
+func (t *TextArea) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = t
+	}
+	// properties
+	if changed, err := t.text.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextArea", "text", t.id, err))
+		}
+	}
+	if changed, err := t.placeholderText.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextArea", "placeholderText", t.id, err))
+		}
+	}
+	if changed, err := t.lineCount.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextArea", "lineCount", t.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := t.FocusScope.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (t *TextArea) As(target *vit.Component) bool {
+	if _, ok := (*target).(*TextArea); ok {
+		*target = t
+		return true
+	}
+	return t.Item.As(target)
+}
+
+func (t *TextArea) ID() string {
+	return t.id
+}
+
+func (t *TextArea) Finish() error {
+	return t.RootC().FinishInContext(t)
+}
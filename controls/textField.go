@@ -1,50 +1,33 @@
 package controls
 
 import (
-	"unicode/utf8"
-
 	vit "github.com/omniskop/vitrum/vit"
 	"github.com/omniskop/vitrum/vit/std"
 )
 
 func (f *TextField) wasCompleted(*struct{}) {
-	keyAreaComponent, _ := f.Context().GetComponentByID("keyArea")
-	keyArea := keyAreaComponent.(*std.KeyArea)
-	event, _ := keyArea.Event("onKeyDown")
-	event.(*vit.EventAttribute[std.KeyEvent]).AddListener(vit.ListenerCB(f.keyPressed))
-
-	mouseAreaComponent, _ := f.Context().GetComponentByID("mouseArea")
-	mouseArea := mouseAreaComponent.(*std.MouseArea)
-	event, _ = mouseArea.Event("onClicked")
-	event.(*vit.EventAttribute[std.MouseEvent]).AddListener(vit.ListenerCB(f.clicked))
-}
-
-func (f *TextField) keyPressed(event *std.KeyEvent) {
-	text := f.text.String()
+	inputComponent, _ := f.Context().GetComponentByID("input")
+	input := inputComponent.(*std.TextInput)
+
+	// keep the text of the field and the input in sync
+	inputText := input.MustProperty("text").(*vit.StringValue)
+	inputText.AddDependent(vit.FuncDep(func() {
+		if f.text.String() != inputText.String() {
+			f.text.SetStringValue(inputText.String())
+		}
+	}))
+	f.text.AddDependent(vit.FuncDep(func() {
+		if f.text.String() != inputText.String() {
+			inputText.SetStringValue(f.text.String())
+		}
+	}))
 
-	if event.Letter == 0 {
-		switch event.Code {
-		case "Backspace":
-			if len(text) > 0 {
-				_, size := utf8.DecodeLastRuneInString(text)
-				text = text[:len(text)-size]
-			}
+	// component definitions can't be bound through expressions which is why the validator is passed on manually
+	updateValidator := func() {
+		if def := f.validator.ComponentDefinition(); def != nil {
+			input.SetProperty("validator", vit.ComponentDefinitionInContext{ComponentDefinition: def, Context: f.validator.Context()})
 		}
-	} else {
-		text += string(event.Letter)
 	}
-
-	f.text.SetValue(text)
-}
-
-func (f *TextField) clicked(event *std.MouseEvent) {
-	f.Context().Global.Environment.RequestFocus(f)
-}
-
-func (f *TextField) Focus() {
-	f.focused.SetValue(true)
-}
-
-func (f *TextField) Blur() {
-	f.focused.SetValue(false)
+	f.validator.AddDependent(vit.FuncDep(updateValidator))
+	updateValidator()
 }
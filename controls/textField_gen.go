@@ -27,11 +27,13 @@ func newFileContextForTextField(globalCtx *vit.GlobalContext) (*vit.FileContext,
 }
 
 type TextField struct {
-	*std.Item
+	*std.FocusScope
 	id string
 
-	text    vit.StringValue
-	focused vit.BoolValue
+	text            vit.StringValue
+	validator       vit.ComponentDefValue
+	inputMask       vit.StringValue
+	acceptableInput vit.BoolValue
 }
 
 // newTextFieldInGlobal creates an appropriate file context for the component and then returns a new TextField instance.
@@ -46,14 +48,17 @@ func newTextFieldInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary p
 }
 func NewTextField(id string, context *vit.FileContext) *TextField {
 	t := &TextField{
-		Item:    std.NewItem("textField", context),
-		id:      id,
-		text:    *vit.NewEmptyStringValue(),
-		focused: *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		FocusScope:      std.NewFocusScope("textField", context),
+		id:              id,
+		text:            *vit.NewEmptyStringValue(),
+		validator:       *vit.NewEmptyComponentDefValue(),
+		inputMask:       *vit.NewEmptyStringValue(),
+		acceptableInput: *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "input.acceptableInput", Position: nil}),
 	}
 	// property assignments on embedded components
-	t.Item.SetPropertyCode("width", vit.Code{FileCtx: context, Code: "300", Position: nil})
-	t.Item.SetPropertyCode("height", vit.Code{FileCtx: context, Code: "25", Position: nil})
+	t.FocusScope.SetPropertyCode("activeFocusOnTab", vit.Code{FileCtx: context, Code: "true", Position: nil})
+	t.FocusScope.SetPropertyCode("width", vit.Code{FileCtx: context, Code: "300", Position: nil})
+	t.FocusScope.SetPropertyCode("height", vit.Code{FileCtx: context, Code: "25", Position: nil})
 	// register listeners for when a property changes
 	// register event listeners
 	var event vit.Listenable
@@ -65,13 +70,9 @@ func NewTextField(id string, context *vit.FileContext) *TextField {
 	// register enumerations
 	// add child components
 	var child vit.Component
-	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "MouseArea", ID: "mouseArea", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 14, StartColumn: 9, EndLine: 14, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 14, StartColumn: 23, EndLine: 14, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 15, StartColumn: 9, EndLine: 15, EndColumn: 45}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 15, StartColumn: 26, EndLine: 15, EndColumn: 45}, Identifier: []string{"acceptedButtons"}, Expression: "MouseArea.leftButton", Tags: map[string]string{}}}}, context)
+	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "Rectangle", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 16, StartColumn: 9, EndLine: 16, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 16, StartColumn: 23, EndLine: 16, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 17, StartColumn: 9, EndLine: 17, EndColumn: 37}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 17, StartColumn: 16, EndLine: 17, EndColumn: 37}, Identifier: []string{"color"}, Expression: "Vit.rgb(230, 230, 230)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 18, StartColumn: 9, EndLine: 18, EndColumn: 44}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 18, StartColumn: 23, EndLine: 18, EndColumn: 44}, Identifier: []string{"border", "color"}, Expression: "Vit.rgb(130, 130, 130)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 19, StartColumn: 9, EndLine: 19, EndColumn: 48}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 19, StartColumn: 23, EndLine: 19, EndColumn: 48}, Identifier: []string{"border", "width"}, Expression: "parent.activeFocus ? 2 : 0", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 20, StartColumn: 9, EndLine: 20, EndColumn: 17}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 20, StartColumn: 17, EndLine: 20, EndColumn: 17}, Identifier: []string{"radius"}, Expression: "5", Tags: map[string]string{}}}}, context)
 	t.AddChild(child)
-	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "KeyArea", ID: "keyArea", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 20, StartColumn: 9, EndLine: 20, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 20, StartColumn: 23, EndLine: 20, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 21, StartColumn: 9, EndLine: 21, EndColumn: 31}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 21, StartColumn: 18, EndLine: 21, EndColumn: 31}, Identifier: []string{"enabled"}, Expression: "parent.focused", Tags: map[string]string{}}}}, context)
-	t.AddChild(child)
-	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "Rectangle", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 25, StartColumn: 9, EndLine: 25, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 25, StartColumn: 23, EndLine: 25, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 26, StartColumn: 9, EndLine: 26, EndColumn: 37}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 26, StartColumn: 16, EndLine: 26, EndColumn: 37}, Identifier: []string{"color"}, Expression: "Vit.rgb(230, 230, 230)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 27, StartColumn: 9, EndLine: 27, EndColumn: 44}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 27, StartColumn: 23, EndLine: 27, EndColumn: 44}, Identifier: []string{"border", "color"}, Expression: "Vit.rgb(130, 130, 130)", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 28, StartColumn: 9, EndLine: 28, EndColumn: 44}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 28, StartColumn: 23, EndLine: 28, EndColumn: 44}, Identifier: []string{"border", "width"}, Expression: "parent.focused ? 2 : 0", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 29, StartColumn: 9, EndLine: 29, EndColumn: 17}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 29, StartColumn: 17, EndLine: 29, EndColumn: 17}, Identifier: []string{"radius"}, Expression: "5", Tags: map[string]string{}}}}, context)
-	t.AddChild(child)
-	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "Text", ID: "text", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 34, StartColumn: 9, EndLine: 34, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 34, StartColumn: 23, EndLine: 34, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 35, StartColumn: 9, EndLine: 35, EndColumn: 29}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 35, StartColumn: 29, EndLine: 35, EndColumn: 29}, Identifier: []string{"anchors", "leftMargin"}, Expression: "5", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 36, StartColumn: 9, EndLine: 36, EndColumn: 30}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 36, StartColumn: 30, EndLine: 36, EndColumn: 30}, Identifier: []string{"anchors", "rightMargin"}, Expression: "5", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 37, StartColumn: 9, EndLine: 37, EndColumn: 25}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 37, StartColumn: 15, EndLine: 37, EndColumn: 25}, Identifier: []string{"text"}, Expression: "parent.text", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 38, StartColumn: 9, EndLine: 38, EndColumn: 26}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 38, StartColumn: 25, EndLine: 38, EndColumn: 26}, Identifier: []string{"font", "pointSize"}, Expression: "40", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 39, StartColumn: 9, EndLine: 39, EndColumn: 33}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 39, StartColumn: 22, EndLine: 39, EndColumn: 33}, Identifier: []string{"font", "family"}, Expression: "\"Montserrat\"", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 40, StartColumn: 9, EndLine: 40, EndColumn: 32}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 40, StartColumn: 22, EndLine: 40, EndColumn: 32}, Identifier: []string{"font", "weight"}, Expression: "Text.Medium", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 41, StartColumn: 9, EndLine: 41, EndColumn: 44}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 41, StartColumn: 28, EndLine: 41, EndColumn: 44}, Identifier: []string{"verticalAlignment"}, Expression: "Text.AlignVCenter", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 42, StartColumn: 9, EndLine: 42, EndColumn: 43}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 42, StartColumn: 30, EndLine: 42, EndColumn: 43}, Identifier: []string{"horizontalAlignment"}, Expression: "Text.AlignLeft", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 43, StartColumn: 9, EndLine: 43, EndColumn: 29}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 43, StartColumn: 16, EndLine: 43, EndColumn: 29}, Identifier: []string{"elide"}, Expression: "Text.ElideLeft", Tags: map[string]string{}}}}, context)
+	child, _ = parse.InstantiateComponent(&vit.ComponentDefinition{BaseName: "TextInput", ID: "input", Properties: []vit.PropertyDefinition{vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 25, StartColumn: 9, EndLine: 25, EndColumn: 28}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 25, StartColumn: 23, EndLine: 25, EndColumn: 28}, Identifier: []string{"anchors", "fill"}, Expression: "parent", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 26, StartColumn: 9, EndLine: 26, EndColumn: 29}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 26, StartColumn: 29, EndLine: 26, EndColumn: 29}, Identifier: []string{"anchors", "leftMargin"}, Expression: "5", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 27, StartColumn: 9, EndLine: 27, EndColumn: 30}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 27, StartColumn: 30, EndLine: 27, EndColumn: 30}, Identifier: []string{"anchors", "rightMargin"}, Expression: "5", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 28, StartColumn: 9, EndLine: 28, EndColumn: 19}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 28, StartColumn: 16, EndLine: 28, EndColumn: 19}, Identifier: []string{"focus"}, Expression: "true", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 29, StartColumn: 9, EndLine: 29, EndColumn: 38}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 29, StartColumn: 20, EndLine: 29, EndColumn: 38}, Identifier: []string{"inputMask"}, Expression: "textField.inputMask", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 30, StartColumn: 9, EndLine: 30, EndColumn: 26}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 30, StartColumn: 25, EndLine: 30, EndColumn: 26}, Identifier: []string{"font", "pointSize"}, Expression: "40", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 31, StartColumn: 9, EndLine: 31, EndColumn: 33}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 31, StartColumn: 22, EndLine: 31, EndColumn: 33}, Identifier: []string{"font", "family"}, Expression: "\"Montserrat\"", Tags: map[string]string{}}, vit.PropertyDefinition{Pos: vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 32, StartColumn: 9, EndLine: 32, EndColumn: 32}, ValuePos: &vit.PositionRange{FilePath: vpath.Virtual("TextField.vit"), StartLine: 32, StartColumn: 22, EndLine: 32, EndColumn: 32}, Identifier: []string{"font", "weight"}, Expression: "Text.Medium", Tags: map[string]string{}}}}, context)
 	t.AddChild(child)
 
 	context.RegisterComponent("textField", t)
@@ -87,10 +88,14 @@ func (t *TextField) Property(key string) (vit.Value, bool) {
 	switch key {
 	case "text":
 		return &t.text, true
-	case "focused":
-		return &t.focused, true
+	case "validator":
+		return &t.validator, true
+	case "inputMask":
+		return &t.inputMask, true
+	case "acceptableInput":
+		return &t.acceptableInput, true
 	default:
-		return t.Item.Property(key)
+		return t.FocusScope.Property(key)
 	}
 }
 
@@ -107,10 +112,14 @@ func (t *TextField) SetProperty(key string, value interface{}) error {
 	switch key {
 	case "text":
 		err = t.text.SetValue(value)
-	case "focused":
-		err = t.focused.SetValue(value)
+	case "validator":
+		err = t.validator.SetValue(value)
+	case "inputMask":
+		err = t.inputMask.SetValue(value)
+	case "acceptableInput":
+		err = t.acceptableInput.SetValue(value)
 	default:
-		return t.Item.SetProperty(key, value)
+		return t.FocusScope.SetProperty(key, value)
 	}
 	if err != nil {
 		return vit.NewPropertyError("TextField", key, t.id, err)
@@ -122,10 +131,14 @@ func (t *TextField) SetPropertyCode(key string, code vit.Code) error {
 	switch key {
 	case "text":
 		t.text.SetCode(code)
-	case "focused":
-		t.focused.SetCode(code)
+	case "validator":
+		t.validator.SetCode(code)
+	case "inputMask":
+		t.inputMask.SetCode(code)
+	case "acceptableInput":
+		t.acceptableInput.SetCode(code)
 	default:
-		return t.Item.SetPropertyCode(key, code)
+		return t.FocusScope.SetPropertyCode(key, code)
 	}
 	return nil
 }
@@ -133,7 +146,7 @@ func (t *TextField) SetPropertyCode(key string, code vit.Code) error {
 func (t *TextField) Event(name string) (vit.Listenable, bool) {
 	switch name {
 	default:
-		return t.Item.Event(name)
+		return t.FocusScope.Event(name)
 	}
 }
 
@@ -141,10 +154,14 @@ func (t *TextField) ResolveVariable(key string) (interface{}, bool) {
 	switch key {
 	case "text":
 		return &t.text, true
-	case "focused":
-		return &t.focused, true
+	case "validator":
+		return &t.validator, true
+	case "inputMask":
+		return &t.inputMask, true
+	case "acceptableInput":
+		return &t.acceptableInput, true
 	default:
-		return t.Item.ResolveVariable(key)
+		return t.FocusScope.ResolveVariable(key)
 	}
 }
 
@@ -180,16 +197,28 @@ func (t *TextField) UpdateExpressions(context vit.Component) (int, vit.ErrorGrou
 			errs.Add(vit.NewPropertyError("TextField", "text", t.id, err))
 		}
 	}
-	if changed, err := t.focused.Update(context); changed || err != nil {
+	if changed, err := t.validator.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextField", "validator", t.id, err))
+		}
+	}
+	if changed, err := t.inputMask.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextField", "inputMask", t.id, err))
+		}
+	}
+	if changed, err := t.acceptableInput.Update(context); changed || err != nil {
 		sum++
 		if err != nil {
-			errs.Add(vit.NewPropertyError("TextField", "focused", t.id, err))
+			errs.Add(vit.NewPropertyError("TextField", "acceptableInput", t.id, err))
 		}
 	}
 
 	// methods
 
-	n, err := t.Item.UpdateExpressions(context)
+	n, err := t.FocusScope.UpdateExpressions(context)
 	sum += n
 	errs.AddGroup(err)
 	return sum, errs
@@ -11,6 +11,7 @@ require (
 	github.com/google/go-cmp v0.5.8
 	github.com/lucasb-eyer/go-colorful v1.2.0
 	github.com/tdewolff/canvas v0.0.0-20231218015800-2ad5075e9362
+	golang.org/x/image v0.13.0
 )
 
 require (
@@ -31,7 +32,6 @@ require (
 	github.com/wcharczuk/go-chart/v2 v2.1.1 // indirect
 	golang.org/x/exp v0.0.0-20231006140011-7918f672742d // indirect
 	golang.org/x/exp/shiny v0.0.0-20231006140011-7918f672742d // indirect
-	golang.org/x/image v0.13.0 // indirect
 	golang.org/x/net v0.17.0 // indirect
 	golang.org/x/sys v0.13.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
@@ -0,0 +1,68 @@
+package gui
+
+import (
+	"sync"
+
+	"gioui.org/app"
+	"gioui.org/io/clipboard"
+	"gioui.org/op"
+	"github.com/omniskop/vitrum/vit"
+)
+
+// gioClipboard provides access to the clipboard of the system through gio.
+// Gio only supports reading the clipboard asynchronously, thus the content is cached and refreshed whenever the window gains focus.
+type gioClipboard struct {
+	*vit.MemoryClipboard
+	mux           sync.Mutex
+	window        *app.Window
+	pendingWrite  bool
+	readRequested bool
+}
+
+func newGioClipboard() *gioClipboard {
+	return &gioClipboard{
+		MemoryClipboard: vit.NewMemoryClipboard(),
+		readRequested:   true,
+	}
+}
+
+func (c *gioClipboard) SetText(text string) {
+	c.MemoryClipboard.SetText(text)
+	c.mux.Lock()
+	c.pendingWrite = true
+	c.mux.Unlock()
+	c.invalidate()
+}
+
+// requestRead refreshes the cached content during the next frame.
+func (c *gioClipboard) requestRead() {
+	c.mux.Lock()
+	c.readRequested = true
+	c.mux.Unlock()
+	c.invalidate()
+}
+
+func (c *gioClipboard) invalidate() {
+	if c.window != nil {
+		c.window.Invalidate()
+	}
+}
+
+// receive updates the cached content with the text read from the clipboard of the system.
+func (c *gioClipboard) receive(e clipboard.Event) {
+	c.MemoryClipboard.SetText(e.Text)
+}
+
+// addOps adds all clipboard operations that are necessary for the next frame.
+func (c *gioClipboard) addOps(ops *op.Ops) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.pendingWrite {
+		clipboard.WriteOp{Text: c.Text()}.Add(ops)
+		c.pendingWrite = false
+	}
+	if c.readRequested {
+		clipboard.ReadOp{Tag: c}.Add(ops)
+		c.readRequested = false
+	}
+}
@@ -56,7 +56,7 @@ var keyCodeMapping = map[string]KeyCode{
 	key.NameDeleteForward:  "Delete",
 	key.NamePageUp:         "PageUp",
 	key.NamePageDown:       "PageDown",
-	key.NameTab:            "Tag",
+	key.NameTab:            "Tab",
 	key.NameSpace:          "Space",
 	key.NameCtrl:           "ControlLeft",
 	key.NameShift:          "ShiftLeft",
@@ -8,6 +8,7 @@ import (
 	"unicode/utf8"
 
 	"gioui.org/app"
+	"gioui.org/io/clipboard"
 	"gioui.org/io/key"
 	"gioui.org/io/pointer"
 	"gioui.org/io/system"
@@ -17,70 +18,34 @@ import (
 	"github.com/omniskop/vitrum/vit"
 	"github.com/omniskop/vitrum/vit/parse"
 	"github.com/omniskop/vitrum/vit/std"
+	"github.com/omniskop/vitrum/vit/vimage"
 	"github.com/omniskop/vitrum/vit/vpath"
 	"github.com/tdewolff/canvas"
 	gioRenderer "github.com/tdewolff/canvas/renderers/gio"
 )
 
+// componentHandler translates gio events and passes them on to the backend independent input handler.
 type componentHandler struct {
-	mouse            map[*std.MouseArea]bool
-	key              map[*std.KeyArea]bool
-	focusedComponent vit.FocusableComponent
-	logger           *log.Logger
+	*std.InputHandler
+	pressedKeys map[string]bool // used to detect auto repeated key presses
+	// pendingKey is the press of a printable key that is held back because the text it produces will be received as an edit event.
+	// Both are delivered as a single event so that each key press is only seen once.
+	pendingKey *std.KeyEvent
 }
 
 func newComponentHandler(log *log.Logger) *componentHandler {
 	return &componentHandler{
-		mouse:  make(map[*std.MouseArea]bool),
-		key:    make(map[*std.KeyArea]bool),
-		logger: log,
+		InputHandler: std.NewInputHandler(log),
+		pressedKeys:  make(map[string]bool),
 	}
 }
 
-func (h *componentHandler) RegisterComponent(id string, comp vit.Component) {
-	switch comp := comp.(type) {
-	case *std.MouseArea:
-		h.mouse[comp] = true
-	case *std.KeyArea:
-		h.key[comp] = true
-	}
-}
-
-func (h *componentHandler) UnregisterComponent(id string, comp vit.Component) {
-	switch comp := comp.(type) {
-	case *std.MouseArea:
-		if _, ok := h.mouse[comp]; ok {
-			delete(h.mouse, comp)
-		}
-	case *std.KeyArea:
-		if _, ok := h.key[comp]; ok {
-			delete(h.key, comp)
-		}
-	}
-}
-
-func (h *componentHandler) resetFocus() {
-	if h.focusedComponent != nil {
-		h.focusedComponent.Blur()
-		h.focusedComponent = nil
-	}
-}
-
-func (h *componentHandler) RequestFocus(comp vit.FocusableComponent) {
-	if h.focusedComponent != nil {
-		h.focusedComponent.Blur()
-	}
-	h.focusedComponent = comp
-	comp.Focus()
-}
-
-func (h *componentHandler) TriggerMouseEvent(e pointer.Event, metric unit.Metric) {
+func (h *componentHandler) TriggerGioMouseEvent(e pointer.Event, metric unit.Metric) {
 	mouseEvent := std.MouseEvent{
 		X: int(e.Position.X / metric.PxPerDp),
 		Y: int(e.Position.Y / metric.PxPerDp),
 	}
 	if e.Buttons&pointer.ButtonPrimary > 0 {
-		h.resetFocus()
 		mouseEvent.Buttons |= std.MouseArea_MouseButtons_leftButton
 	}
 	if e.Buttons&pointer.ButtonSecondary > 0 {
@@ -89,19 +54,106 @@ func (h *componentHandler) TriggerMouseEvent(e pointer.Event, metric unit.Metric
 	if e.Buttons&pointer.ButtonTertiary > 0 {
 		mouseEvent.Buttons |= std.MouseArea_MouseButtons_middleButton
 	}
-	for ma := range h.mouse {
-		ma.TriggerEvent(mouseEvent)
+	h.TriggerMouseEvent(mouseEvent)
+}
+
+func (h *componentHandler) TriggerGioKeyEvent(e key.Event) {
+	h.flushKeyEvents()
+	keyEvent := std.KeyEvent{
+		Pressed:   e.State == key.Press,
+		Modifiers: convertModifiers(e.Modifiers),
+	}
+	code, special := keyCodeMapping[e.Name]
+	if special {
+		keyEvent.Code = string(code)
+		keyEvent.Key = string(code)
+	} else {
+		keyEvent.Key = strings.ToUpper(e.Name)
+	}
+	if keyEvent.Pressed {
+		// gio doesn't report repeated key presses explicitly, thus we detect them ourself
+		keyEvent.AutoRepeat = h.pressedKeys[e.Name]
+		h.pressedKeys[e.Name] = true
+	} else {
+		delete(h.pressedKeys, e.Name)
+	}
+	if !special && keyEvent.Pressed && keyEvent.Modifiers&^std.KeyArea_Modifiers_shiftModifier == 0 {
+		// printable key; the text that it produces will be received as an edit event
+		h.pendingKey = &keyEvent
+		return
 	}
+	h.TriggerKeyEvent(keyEvent)
 }
 
-func (h *componentHandler) TriggerKeyEvent(e std.KeyEvent) {
-	for ka := range h.key {
-		ka.TriggerEvent(e)
+func (h *componentHandler) TriggerGioEditEvent(e key.EditEvent) {
+	r, _ := utf8.DecodeRuneInString(e.Text)
+	event := std.KeyEvent{
+		Pressed: true,
+		Letter:  r,
+		Text:    e.Text,
 	}
+	if h.pendingKey != nil {
+		// the edit event replaces the press of the key that produced it
+		event.Modifiers = h.pendingKey.Modifiers
+		event.AutoRepeat = h.pendingKey.AutoRepeat
+		h.pendingKey = nil
+	}
+	h.TriggerKeyEvent(event)
+}
+
+// flushKeyEvents delivers the press of a printable key that hasn't been followed by an edit event.
+// This is called after all events of a frame have been handled.
+func (h *componentHandler) flushKeyEvents() {
+	if h.pendingKey == nil {
+		return
+	}
+	event := *h.pendingKey
+	h.pendingKey = nil
+	h.TriggerKeyEvent(event)
+}
+
+// gioCursors maps the cursor shapes of mouse areas to the corresponding gio cursors.
+var gioCursors = map[std.MouseArea_CursorShape]pointer.Cursor{
+	std.MouseArea_CursorShape_ArrowCursor:        pointer.CursorDefault,
+	std.MouseArea_CursorShape_PointingHandCursor: pointer.CursorPointer,
+	std.MouseArea_CursorShape_IBeamCursor:        pointer.CursorText,
+	std.MouseArea_CursorShape_CrossCursor:        pointer.CursorCrosshair,
+	std.MouseArea_CursorShape_WaitCursor:         pointer.CursorWait,
+	std.MouseArea_CursorShape_BusyCursor:         pointer.CursorProgress,
+	std.MouseArea_CursorShape_ForbiddenCursor:    pointer.CursorNotAllowed,
+	std.MouseArea_CursorShape_OpenHandCursor:     pointer.CursorGrab,
+	std.MouseArea_CursorShape_ClosedHandCursor:   pointer.CursorGrabbing,
+	std.MouseArea_CursorShape_SizeHorCursor:      pointer.CursorEastWestResize,
+	std.MouseArea_CursorShape_SizeVerCursor:      pointer.CursorNorthSouthResize,
+	std.MouseArea_CursorShape_SizeBDiagCursor:    pointer.CursorNorthEastSouthWestResize,
+	std.MouseArea_CursorShape_SizeFDiagCursor:    pointer.CursorNorthWestSouthEastResize,
+	std.MouseArea_CursorShape_SizeAllCursor:      pointer.CursorAllScroll,
+	std.MouseArea_CursorShape_BlankCursor:        pointer.CursorNone,
 }
 
-func (h *componentHandler) Logger() *log.Logger {
-	return h.logger
+// GioCursor returns the gio cursor matching the cursor shape of the hovered mouse area.
+func (h *componentHandler) GioCursor() pointer.Cursor {
+	if cursor, ok := gioCursors[h.CursorShape()]; ok {
+		return cursor
+	}
+	return pointer.CursorDefault
+}
+
+func convertModifiers(mods key.Modifiers) std.KeyArea_Modifiers {
+	var out std.KeyArea_Modifiers
+	if mods.Contain(key.ModShift) {
+		out |= std.KeyArea_Modifiers_shiftModifier
+	}
+	if mods.Contain(key.ModCtrl) {
+		out |= std.KeyArea_Modifiers_controlModifier
+	}
+	if mods.Contain(key.ModAlt) {
+		out |= std.KeyArea_Modifiers_altModifier
+	}
+	if mods.Contain(key.ModSuper) || mods.Contain(key.ModCommand) {
+		out |= std.KeyArea_Modifiers_superModifier
+	}
+	return out
 }
 
 type Window struct {
@@ -109,15 +161,20 @@ type Window struct {
 	handler       *componentHandler
 	mainComponent vit.Component
 	gioWindow     *app.Window
+	clipboard     *gioClipboard
 	logger        *log.Logger
+	// removeRefreshListener stops the window from being drawn again when images of providers are refreshed
+	removeRefreshListener func()
 }
 
 func NewWindow(source vpath.Path, log *log.Logger) (*Window, error) {
 	w := &Window{
-		manager: parse.NewManager(),
-		handler: newComponentHandler(log),
-		logger:  log,
+		manager:   parse.NewManager(),
+		handler:   newComponentHandler(log),
+		clipboard: newGioClipboard(),
+		logger:    log,
 	}
+	w.handler.SetClipboard(w.clipboard)
 	err := w.manager.SetSource(source)
 	if err != nil {
 		return nil, err
@@ -187,6 +244,11 @@ func (w *Window) prepare() error {
 			}
 		}
 	})
+	w.clipboard.window = w.gioWindow
+	// images of providers are reloaded when the window is drawn
+	w.removeRefreshListener = vimage.AddRefreshListener(w.gioWindow.Invalidate)
+	// results of background work, like images that have been loaded asynchronously, are applied in the next frame
+	w.handler.OnBackgroundDone(w.gioWindow.Invalidate)
 
 	return nil
 }
@@ -198,6 +260,9 @@ func (w *Window) run() error {
 	if w.gioWindow == nil {
 		return fmt.Errorf("window: no underlying gio window set")
 	}
+	if w.removeRefreshListener != nil {
+		defer w.removeRefreshListener()
+	}
 
 	var ops op.Ops
 	for {
@@ -213,39 +278,31 @@ func (w *Window) run() error {
 			for _, ev := range e.Queue.Events(w) {
 				switch event := ev.(type) {
 				case pointer.Event:
-					w.handler.TriggerMouseEvent(event, gtx.Metric)
+					w.handler.TriggerGioMouseEvent(event, gtx.Metric)
 				case key.Event:
-					code, ok := keyCodeMapping[event.Name]
-					if ok {
-						// special character
-						w.handler.TriggerKeyEvent(std.KeyEvent{
-							Pressed: event.State == key.Press,
-							Code:    string(code),
-						})
-					} else {
-						// regular letter
-						// here we are only interested in key releases as we have received the press as an edit event already
-						if event.State == key.Release {
-							r, _ := utf8.DecodeRuneInString(event.Name)
-							w.handler.TriggerKeyEvent(std.KeyEvent{
-								Pressed: false,
-								Letter:  r,
-							})
-						}
+					w.handler.TriggerGioKeyEvent(event)
+				case key.FocusEvent:
+					if event.Focus {
+						// the clipboard might have been changed while the window wasn't focused
+						w.clipboard.requestRead()
 					}
 				case key.EditEvent:
-					// a key was pressed
+					// text has been entered
 					r, _ := utf8.DecodeRuneInString(event.Text)
 					// we wan't to be informed about changes to this specific key
 					keysOfInterest = append(keysOfInterest, string(unicode.ToUpper(r)))
-					w.handler.TriggerKeyEvent(std.KeyEvent{
-						Pressed: true,
-						Letter:  r,
-					})
+					w.handler.TriggerGioEditEvent(event)
 				default:
 					// fmt.Printf("unknown event: %T %v\n", ev, ev)
 				}
 			}
+			w.handler.flushKeyEvents()
+
+			for _, ev := range e.Queue.Events(w.clipboard) {
+				if event, ok := ev.(clipboard.Event); ok {
+					w.clipboard.receive(event)
+				}
+			}
 
 			// render new frame
 			layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
@@ -258,7 +315,8 @@ func (w *Window) run() error {
 				// update dimensions of main component to reflect window size
 				w.mainComponent.SetProperty("width", virtualWindowBounds.Width())
 				w.mainComponent.SetProperty("height", virtualWindowBounds.Height())
-				// update all expressions
+				// apply the results of background work and update all expressions
+				w.handler.FinishBackgroundWork()
 				errs := w.manager.UpdateFully()
 				if errs.Failed() {
 					w.logger.Println(fmt.Errorf("window update:"))
@@ -271,7 +329,7 @@ func (w *Window) run() error {
 				ctx := canvas.NewContext(c)
 				ctx.SetCoordSystem(canvas.CartesianIV) // move origin of the context to the top left corner
 				err := w.mainComponent.Draw(
-					vit.DrawingContext{ctx},
+					vit.DrawingContext{Context: ctx, Resolution: canvas.DPMM(float64(gtx.Metric.PxPerDp))},
 					virtualWindowBounds,
 				)
 				if err != nil {
@@ -280,7 +338,14 @@ func (w *Window) run() error {
 				return c.Dimensions()
 			})
 
+			// components like animations might want to be drawn again later
+			if at, ok := w.handler.NextRedraw(); ok {
+				op.InvalidateOp{At: at}.Add(gtx.Ops)
+			}
+
 			// register input operations for the next frame
+			w.handler.GioCursor().Add(gtx.Ops)
+			w.clipboard.addOps(gtx.Ops)
 			pointer.InputOp{
 				Tag:   w,
 				Types: pointer.Press | pointer.Release | pointer.Move | pointer.Drag | pointer.Scroll,
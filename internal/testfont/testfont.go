@@ -0,0 +1,25 @@
+// Package testfont provides a font for tests so that they don't depend on the fonts that are installed on the system.
+// The font is Dynalight by Astigmatic which is licensed under the SIL Open Font License, see testdata/OFL.txt.
+package testfont
+
+import "embed"
+
+// Family is the family name that is stored in the font.
+const Family = "Dynalight"
+
+// Name is the path of the font file in FS.
+const Name = "testdata/Dynalight-Regular.otf"
+
+// FS contains the font file.
+//
+//go:embed testdata/Dynalight-Regular.otf
+var FS embed.FS
+
+// Data returns the content of the font file.
+func Data() []byte {
+	data, err := FS.ReadFile(Name)
+	if err != nil {
+		panic(err) // the file is embedded
+	}
+	return data
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"time"
 
 	vit "github.com/omniskop/vitrum/vit"
 	parse "github.com/omniskop/vitrum/vit/parse"
@@ -12,8 +13,12 @@ import (
 	"github.com/tdewolff/canvas/renderers/pdf"
 )
 
+// rasterResolution is used for content that needs to be rasterized, like effects.
+var rasterResolution = canvas.DPI(300)
+
 type componentHandler struct {
-	logger *log.Logger
+	logger    *log.Logger
+	clipboard *vit.MemoryClipboard
 }
 
 func (h componentHandler) RegisterComponent(id string, comp vit.Component) {}
@@ -22,10 +27,22 @@ func (h componentHandler) UnregisterComponent(id string, comp vit.Component) {}
 
 func (h componentHandler) RequestFocus(comp vit.FocusableComponent) {}
 
+func (h componentHandler) Clipboard() vit.Clipboard {
+	return h.clipboard
+}
+
 func (h componentHandler) Logger() *log.Logger {
 	return h.logger
 }
 
+// ScheduleRedraw does nothing as documents are only drawn once.
+func (h componentHandler) ScheduleRedraw(at time.Time) {}
+
+// RunInBackground runs the work immediately as everything needs to be ready before the document is drawn.
+func (h componentHandler) RunInBackground(work func() (finish func())) {
+	work()()
+}
+
 type Document struct {
 	manager *parse.Manager
 	handler *componentHandler
@@ -34,7 +51,7 @@ type Document struct {
 func NewDocument(path vpath.Path) (*Document, error) {
 	doc := &Document{
 		manager: parse.NewManager(),
-		handler: &componentHandler{log.New(io.Discard, "", 0)},
+		handler: &componentHandler{log.New(io.Discard, "", 0), vit.NewMemoryClipboard()},
 	}
 
 	doc.manager.SetSource(path)
@@ -87,7 +104,7 @@ func (d *Document) Render(out io.Writer) error {
 		ctx := canvas.NewContext(canv)
 		ctx.SetCoordSystem(canvas.CartesianIV)
 		err := child.Draw(
-			vit.DrawingContext{ctx},
+			vit.DrawingContext{Context: ctx, Resolution: rasterResolution},
 			bounds,
 		)
 		if err != nil {
@@ -0,0 +1,161 @@
+// Package raster renders vit components into images without a window system or GPU.
+// It can be used to create thumbnails and screenshots on servers or to compare the output of components in tests.
+package raster
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"log"
+	"math"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/parse"
+	"github.com/omniskop/vitrum/vit/std"
+	"github.com/omniskop/vitrum/vit/vpath"
+	"github.com/tdewolff/canvas"
+	"github.com/tdewolff/canvas/renderers/rasterizer"
+)
+
+// maxUpdates limits how often the components are updated while waiting for background work before an image is rendered.
+const maxUpdates = 100
+
+// Options control how the components are rendered.
+type Options struct {
+	// Width and Height set the size of the main component. If they are zero the size of the component itself is used.
+	Width, Height float64
+	// Scale is the number of pixels per unit, for example 2 for displays with a high pixel density. If it is zero 1 is used.
+	Scale float64
+	// Background fills the image before the components are drawn. If it is nil the background is transparent.
+	Background color.Color
+}
+
+// Renderer instantiates a vit file without a window and draws its main component into images.
+// The components are kept between renders which allows them to be changed in between, for example by triggering input events through the Handler.
+type Renderer struct {
+	manager     *parse.Manager
+	handler     *std.InputHandler
+	initialized bool
+}
+
+// NewRenderer creates a renderer for the vit file at the given path. The file is instantiated when it is rendered the first time.
+func NewRenderer(source vpath.Path, logger *log.Logger) (*Renderer, error) {
+	if logger == nil {
+		logger = log.New(io.Discard, "", 0)
+	}
+	r := &Renderer{
+		manager: parse.NewManager(),
+		handler: std.NewInputHandler(logger),
+	}
+	err := r.manager.SetSource(source)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Renderer) AddImportPath(path vpath.Path) error {
+	return r.manager.AddImportPath(path)
+}
+
+func (r *Renderer) SetVariable(name string, value interface{}) error {
+	return r.manager.SetVariable(name, value)
+}
+
+// Handler returns the execution environment of the components which can be used to trigger input events.
+func (r *Renderer) Handler() *std.InputHandler {
+	return r.handler
+}
+
+// MainComponent returns the instantiated main component or nil if it hasn't been instantiated yet.
+func (r *Renderer) MainComponent() vit.Component {
+	if !r.initialized {
+		return nil
+	}
+	return r.manager.MainComponent()
+}
+
+// Initialize instantiates the vit file. It is called automatically by the first render but can be used to access the components beforehand.
+func (r *Renderer) Initialize() error {
+	if r.initialized {
+		return nil
+	}
+	err := r.manager.Initialize(r.handler)
+	if err != nil {
+		return err
+	}
+	r.initialized = true
+	return nil
+}
+
+// Update lays out the main component at the given size and updates all components.
+// It waits for work that is done in the background, like images that are loaded asynchronously, so that everything is ready to be drawn.
+func (r *Renderer) Update(width, height float64) error {
+	if err := r.Initialize(); err != nil {
+		return err
+	}
+	main := r.manager.MainComponent()
+	if width > 0 && height > 0 {
+		main.SetProperty("width", width)
+		main.SetProperty("height", height)
+	}
+	for i := 0; i < maxUpdates; i++ {
+		errs := r.manager.UpdateFully()
+		if errs.Failed() {
+			return errs
+		}
+		// the results of background work might change the components again
+		if !r.handler.WaitForBackgroundWork() {
+			return nil
+		}
+	}
+	return fmt.Errorf("update: components are still changing after %d updates", maxUpdates)
+}
+
+// Render updates the components and draws the main component into an image.
+func (r *Renderer) Render(options Options) (*image.RGBA, error) {
+	if err := r.Update(options.Width, options.Height); err != nil {
+		return nil, err
+	}
+	scale := options.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+
+	main := r.manager.MainComponent()
+	bounds := main.Bounds()
+	width, height := bounds.Width(), bounds.Height()
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("main component has no size")
+	}
+	// the size is rounded up to whole pixels to prevent the content from being scaled
+	width = math.Ceil(width*scale) / scale
+	height = math.Ceil(height*scale) / scale
+
+	c := canvas.New(width, height)
+	ctx := canvas.NewContext(c)
+	ctx.SetCoordSystem(canvas.CartesianIV)
+	if options.Background != nil {
+		ctx.SetFillColor(options.Background)
+		ctx.DrawPath(0, 0, canvas.Rectangle(width, height))
+	}
+	err := main.Draw(vit.DrawingContext{Context: ctx, Resolution: canvas.DPMM(scale)}, vit.NewRect(0, 0, width, height))
+	if err != nil {
+		return nil, fmt.Errorf("draw: %w", err)
+	}
+	// redraws requested by animations can't be honored, but the next image starts a new frame
+	r.handler.NextRedraw()
+
+	return rasterizer.Draw(c, canvas.DPMM(scale), canvas.LinearColorSpace{}), nil
+}
+
+// RenderPNG draws the main component like Render and writes it as a png image.
+func (r *Renderer) RenderPNG(out io.Writer, options Options) error {
+	img, err := r.Render(options)
+	if err != nil {
+		return err
+	}
+	return png.Encode(out, img)
+}
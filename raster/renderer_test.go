@@ -0,0 +1,147 @@
+package raster
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"testing/fstest"
+
+	"github.com/omniskop/vitrum/vit/std"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+const rendererSource = `import Vit 1.0
+
+Rectangle {
+    width: 40
+    height: 20
+    color: "red"
+
+    Rectangle {
+        x: parent.width - 10
+        width: 10
+        height: 10
+        color: "blue"
+    }
+    Image {
+        y: 10
+        width: 10
+        height: 10
+        path: "green.png"
+        asynchronous: true
+        fillMode: Image.Fill
+    }
+}
+`
+
+func TestRenderer(t *testing.T) {
+	green := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for i := 0; i < len(green.Pix); i += 4 {
+		copy(green.Pix[i:], []byte{0, 255, 0, 255})
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, green); err != nil {
+		t.Fatal(err)
+	}
+	files := &fstest.MapFS{
+		"Main.vit":  {Data: []byte(rendererSource)},
+		"green.png": {Data: buf.Bytes()},
+	}
+	renderer, err := NewRenderer(vpath.FS(files, "Main.vit"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the component is laid out at a different size and drawn with two pixels per unit
+	img, err := renderer.Render(Options{Width: 60, Height: 30, Scale: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := img.Bounds().Size(); size != image.Pt(120, 60) {
+		t.Fatalf("image has size %v but expected 120x60", size)
+	}
+	tests := []struct {
+		x, y     int
+		expected color.RGBA
+	}{
+		{10, 10, color.RGBA{255, 0, 0, 255}},
+		{110, 10, color.RGBA{0, 0, 255, 255}},
+		{70, 10, color.RGBA{255, 0, 0, 255}},
+		{10, 30, color.RGBA{0, 255, 0, 255}},
+		{10, 50, color.RGBA{255, 0, 0, 255}},
+	}
+	for _, test := range tests {
+		if got := img.RGBAAt(test.x, test.y); got != test.expected {
+			t.Errorf("pixel at %d,%d is %v but expected %v", test.x, test.y, got, test.expected)
+		}
+	}
+
+	// without a size the component keeps the size it has been given last
+	buf.Reset()
+	if err := renderer.RenderPNG(&buf, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := decoded.Bounds().Size(); size != image.Pt(60, 30) {
+		t.Errorf("png has size %v but expected 60x30", size)
+	}
+}
+
+const canvasSource = `import Vit 1.0
+
+Item {
+    id: root
+    width: 20
+    height: 10
+    property string fill: "red"
+
+    Canvas {
+        id: chart
+        anchors.fill: parent
+        onPaint: function(ctx) {
+            ctx.fillStyle = root.fill
+            ctx.fillRect(0, 0, 20, 10)
+        }
+    }
+    MouseArea {
+        anchors.fill: parent
+        onClicked: function() {
+            root.fill = "blue"
+            chart.requestPaint()
+        }
+    }
+}
+`
+
+func TestRendererCanvas(t *testing.T) {
+	files := &fstest.MapFS{
+		"Main.vit": {Data: []byte(canvasSource)},
+	}
+	renderer, err := NewRenderer(vpath.FS(files, "Main.vit"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := renderer.Render(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := img.RGBAAt(5, 5), (color.RGBA{255, 0, 0, 255}); got != expected {
+		t.Errorf("pixel is %v but expected %v", got, expected)
+	}
+
+	// the paint requested by the click is shown in the next image
+	renderer.Handler().TriggerMouseEvent(std.MouseEvent{X: 5, Y: 5, Buttons: std.MouseArea_MouseButtons_leftButton})
+	renderer.Handler().TriggerMouseEvent(std.MouseEvent{X: 5, Y: 5})
+	img, err = renderer.Render(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := img.RGBAAt(5, 5), (color.RGBA{0, 0, 255, 255}); got != expected {
+		t.Errorf("pixel is %v but expected %v after requestPaint", got, expected)
+	}
+}
@@ -0,0 +1,134 @@
+// Package svg exports vit components as scalable vector graphics, for example for documentation or to hand designs over to other tools.
+// Shapes stay vectors, images are embedded and text is either converted into paths or kept as text elements.
+package svg
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/omniskop/vitrum/pdf"
+	"github.com/omniskop/vitrum/raster"
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/vpath"
+	"github.com/tdewolff/canvas"
+	svgrenderer "github.com/tdewolff/canvas/renderers/svg"
+)
+
+// Options control how components are exported.
+type Options struct {
+	// Width and Height set the size of the main component. If they are zero the size of the component itself is used.
+	// They are ignored for the pages of pdf documents which have their own size.
+	Width, Height float64
+	// TextAsText keeps text as text elements and embeds the fonts they use so that it can still be selected and edited.
+	// Otherwise text is converted into paths which look the same everywhere, even where the fonts are not available.
+	TextAsText bool
+	// RasterScale is the number of pixels per unit for content that needs to be rasterized, like effects. If it is zero 2 is used.
+	RasterScale float64
+}
+
+func (o Options) rasterResolution() canvas.Resolution {
+	if o.RasterScale <= 0 {
+		return canvas.DPMM(2)
+	}
+	return canvas.DPMM(o.RasterScale)
+}
+
+// Exporter instantiates a vit file without a window and writes its main component, or each page if it is a pdf document, as svg.
+type Exporter struct {
+	renderer *raster.Renderer
+}
+
+// NewExporter creates an exporter for the vit file at the given path. The file is instantiated when it is exported the first time.
+func NewExporter(source vpath.Path, logger *log.Logger) (*Exporter, error) {
+	renderer, err := raster.NewRenderer(source, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{renderer: renderer}, nil
+}
+
+func (e *Exporter) AddImportPath(path vpath.Path) error {
+	return e.renderer.AddImportPath(path)
+}
+
+func (e *Exporter) SetVariable(name string, value interface{}) error {
+	return e.renderer.SetVariable(name, value)
+}
+
+// Pages updates the components and returns the components that are exported as separate images.
+// These are the pages of a pdf document or otherwise only the main component itself.
+func (e *Exporter) Pages(options Options) ([]vit.Component, error) {
+	if err := e.renderer.Update(options.Width, options.Height); err != nil {
+		return nil, err
+	}
+	main := e.renderer.MainComponent()
+	var doc vit.Component = &pdf.DocumentComponent{}
+	if main.As(&doc) {
+		if len(doc.Children()) == 0 {
+			return nil, fmt.Errorf("document has no pages")
+		}
+		return doc.Children(), nil
+	}
+	return []vit.Component{main}, nil
+}
+
+// Export writes the main component as svg. For pdf documents only the first page is written, ExportPage can be used for the others.
+func (e *Exporter) Export(out io.Writer, options Options) error {
+	return e.ExportPage(out, 0, options)
+}
+
+// ExportPage writes the page with the given index as svg. The index is zero based.
+func (e *Exporter) ExportPage(out io.Writer, index int, options Options) error {
+	pages, err := e.Pages(options)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(pages) {
+		return fmt.Errorf("page %d doesn't exist, there are %d pages", index+1, len(pages))
+	}
+	return Write(out, pages[index], options)
+}
+
+// Write draws a component that has already been updated as svg, for example the root component of a window.
+// The image covers the bounds of the component. Only the size in the options is ignored.
+func Write(out io.Writer, comp vit.Component, options Options) error {
+	bounds := comp.Bounds()
+	width, height := bounds.Width(), bounds.Height()
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("component has no size")
+	}
+
+	c := canvas.New(width, height)
+	ctx := canvas.NewContext(c)
+	ctx.SetCoordSystem(canvas.CartesianIV)
+	// the component is moved to the origin of the image
+	ctx.Translate(-bounds.X1, -bounds.Y1)
+	err := comp.Draw(vit.DrawingContext{Context: ctx, Resolution: options.rasterResolution()}, bounds)
+	if err != nil {
+		return fmt.Errorf("draw: %w", err)
+	}
+
+	s := svgrenderer.New(out, width, height, &svgrenderer.Options{
+		EmbedFonts:    options.TextAsText,
+		ImageEncoding: canvas.Lossless,
+	})
+	if options.TextAsText {
+		c.RenderTo(s)
+	} else {
+		c.RenderTo(textAsPaths{s})
+	}
+	if err := s.Close(); err != nil {
+		return fmt.Errorf("svg: %w", err)
+	}
+	return nil
+}
+
+// textAsPaths is a renderer that converts text into paths before passing it on.
+type textAsPaths struct {
+	canvas.Renderer
+}
+
+func (r textAsPaths) RenderText(text *canvas.Text, m canvas.Matrix) {
+	text.RenderAsPath(r.Renderer, m, canvas.DefaultResolution)
+}
@@ -0,0 +1,140 @@
+package svg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+const exportSource = `import Vit 1.0
+
+Rectangle {
+    width: 40
+    height: 20
+    color: "red"
+
+    FontLoader {
+        source: "font.otf"
+        family: "Export Test"
+    }
+    Text {
+        width: 30
+        text: "Hello"
+        font.family: "Export Test"
+    }
+    Image {
+        x: 30
+        width: 10
+        height: 10
+        path: "green.png"
+    }
+}
+`
+
+const documentSource = `import Vit 1.0
+import PDF 1.0
+
+Document {
+    Page {
+        width: 100
+        height: 50
+        color: "red"
+    }
+    Page {
+        width: 60
+        height: 80
+        color: "blue"
+    }
+}
+`
+
+func testFiles(t *testing.T, source string) *fstest.MapFS {
+	green := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for i := 0; i < len(green.Pix); i += 4 {
+		green.Set(i/4%2, i/8, color.RGBA{0, 255, 0, 255})
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, green); err != nil {
+		t.Fatal(err)
+	}
+	return &fstest.MapFS{
+		"Main.vit":  {Data: []byte(source)},
+		"green.png": {Data: encoded.Bytes()},
+		"font.otf":  {Data: testfont.Data()},
+	}
+}
+
+func export(t *testing.T, options Options) string {
+	exporter, err := NewExporter(vpath.FS(testFiles(t, exportSource), "Main.vit"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := exporter.Export(&out, options); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func TestExport(t *testing.T) {
+	result := export(t, Options{Width: 80, Height: 40})
+	if !strings.HasPrefix(result, "<svg") || !strings.HasSuffix(result, "</svg>") {
+		t.Fatalf("output is not an svg: %q", result)
+	}
+	if !strings.Contains(result, `viewBox="0 0 80 40"`) {
+		t.Errorf("the size of the options has not been applied: %q", result)
+	}
+	if !strings.Contains(result, `<image`) || !strings.Contains(result, "data:image/png;base64,") {
+		t.Errorf("image has not been embedded: %q", result)
+	}
+	// the background and the glyphs
+	if strings.Contains(result, "<text") || strings.Count(result, "<path") < 2 {
+		t.Errorf("text has not been converted into paths: %q", result)
+	}
+
+	result = export(t, Options{TextAsText: true})
+	if !strings.Contains(result, `viewBox="0 0 40 20"`) {
+		t.Errorf("the size of the component has not been used: %q", result)
+	}
+	if !strings.Contains(result, "<text") || !strings.Contains(result, "Hello") {
+		t.Errorf("text has not been kept: %q", result)
+	}
+	if !strings.Contains(result, "@font-face") {
+		t.Errorf("font has not been embedded: %q", result)
+	}
+}
+
+func TestExportPages(t *testing.T) {
+	exporter, err := NewExporter(vpath.FS(testFiles(t, documentSource), "Main.vit"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pages, err := exporter.Pages(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("got %d pages, expected 2", len(pages))
+	}
+
+	for i, size := range []string{"0 0 100 50", "0 0 60 80"} {
+		var out bytes.Buffer
+		if err := exporter.ExportPage(&out, i, Options{}); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), `viewBox="`+size+`"`) {
+			t.Errorf("page %d: expected a viewBox of %q: %q", i, size, out.String())
+		}
+	}
+
+	if err := exporter.ExportPage(&bytes.Buffer{}, 2, Options{}); err == nil {
+		t.Errorf("exporting a page that doesn't exist didn't fail")
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/omniskop/vitrum/svg"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+var (
+	inputPath  string
+	outputPath string
+	width      float64
+	height     float64
+	textAsText bool
+)
+
+func main() {
+	flag.StringVar(&inputPath, "i", "", "Path to the input vit file.")
+	flag.StringVar(&outputPath, "o", "", "Path of the svg file. Pages of documents are numbered like name-1.svg. If it is empty the svg is written to stdout.")
+	flag.Float64Var(&width, "width", 0, "Width of the main component. The component keeps its own width if it is zero.")
+	flag.Float64Var(&height, "height", 0, "Height of the main component. The component keeps its own height if it is zero.")
+	flag.BoolVar(&textAsText, "text", false, "Keep text as text elements instead of converting it into paths.")
+
+	flag.Parse()
+
+	if inputPath == "" {
+		fmt.Fprintln(os.Stderr, "No input path specified.")
+		os.Exit(64)
+	}
+
+	err := export()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func export() error {
+	exporter, err := svg.NewExporter(vpath.Local(inputPath), log.New(os.Stderr, "", 0))
+	if err != nil {
+		return err
+	}
+	options := svg.Options{Width: width, Height: height, TextAsText: textAsText}
+	pages, err := exporter.Pages(options)
+	if err != nil {
+		return err
+	}
+
+	if outputPath == "" {
+		if len(pages) > 1 {
+			return fmt.Errorf("the document has %d pages, an output path is needed to write them", len(pages))
+		}
+		return svg.Write(os.Stdout, pages[0], options)
+	}
+
+	for i, page := range pages {
+		path := outputPath
+		if len(pages) > 1 {
+			ext := filepath.Ext(outputPath)
+			path = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(outputPath, ext), i+1, ext)
+		}
+		err := writeFile(path, func(out io.Writer) error {
+			return svg.Write(out, page, options)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = write(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package vit
+
+import "sync"
+
+// Clipboard gives access to the text stored in a clipboard.
+type Clipboard interface {
+	Text() string                        // returns the text that is currently stored in the clipboard
+	SetText(string)                      // replaces the content of the clipboard
+	AddChangeListener(func(text string)) // registers a function that will be called whenever the text of the clipboard changed
+}
+
+// MemoryClipboard is a Clipboard that only keeps its content in memory.
+// It is used by environments that don't have access to a system clipboard.
+type MemoryClipboard struct {
+	mux       sync.Mutex
+	text      string
+	listeners []func(string)
+}
+
+func NewMemoryClipboard() *MemoryClipboard {
+	return &MemoryClipboard{}
+}
+
+func (c *MemoryClipboard) Text() string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.text
+}
+
+func (c *MemoryClipboard) SetText(text string) {
+	c.mux.Lock()
+	if c.text == text {
+		c.mux.Unlock()
+		return
+	}
+	c.text = text
+	listeners := append([]func(string){}, c.listeners...)
+	c.mux.Unlock()
+
+	// listeners are called without holding the lock so that they can access the clipboard themselves
+	for _, listener := range listeners {
+		listener(text)
+	}
+}
+
+func (c *MemoryClipboard) AddChangeListener(listener func(text string)) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.listeners = append(c.listeners, listener)
+}
+
+// clipboardObject exposes the clipboard of the execution environment to scripts as the global object "Clipboard".
+// Its text can be read in expressions which will be reevaluated when the clipboard changes.
+type clipboardObject struct {
+	clipboard Clipboard
+	text      *StringValue
+}
+
+func newClipboardObject(clipboard Clipboard) *clipboardObject {
+	obj := &clipboardObject{
+		clipboard: clipboard,
+		text:      NewStringValue(clipboard.Text()),
+	}
+	clipboard.AddChangeListener(func(text string) {
+		if obj.text.String() != text {
+			obj.text.SetStringValue(text)
+		}
+	})
+	obj.text.AddDependent(FuncDep(func() {
+		// the text has been changed by a script
+		if text := obj.text.String(); text != clipboard.Text() {
+			clipboard.SetText(text)
+		}
+	}))
+	return obj
+}
+
+func (o *clipboardObject) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "text":
+		return o.text, true
+	default:
+		return nil, false
+	}
+}
@@ -3,6 +3,7 @@ package vit
 import (
 	"image/color"
 
+	"github.com/omniskop/vitrum/vit/script"
 	"github.com/omniskop/vitrum/vit/vcolor"
 )
 
@@ -57,7 +58,7 @@ func (v *ColorValue) Color() color.Color {
 func (v *ColorValue) SetValue(newValue interface{}) error {
 	switch actualValue := newValue.(type) {
 	case color.Color:
-		v.value = actualValue
+		v.value = copyColor(actualValue)
 		v.expression = nil
 		v.notifyDependents(nil)
 		return nil
@@ -106,9 +107,19 @@ func (v *ColorValue) Update(context Component) (bool, error) {
 			return false, err
 		}
 		v.value = c
+	case color.Color:
+		v.value = copyColor(result)
 	default:
 		return false, newTypeError("color string", result)
 	}
 
 	return true, nil
 }
+
+// copyColor makes sure that JavaScript color objects are not shared with the script as they could be modified afterwards.
+func copyColor(c color.Color) color.Color {
+	if _, ok := c.(*script.Color); ok {
+		return color.NRGBAModel.Convert(c)
+	}
+	return c
+}
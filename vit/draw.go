@@ -2,6 +2,7 @@ package vit
 
 import (
 	"image"
+	"math"
 
 	"github.com/tdewolff/canvas"
 	"github.com/tdewolff/canvas/renderers"
@@ -84,8 +85,54 @@ func (r Rect) CenteredIn(o Rect) Rect {
 	}
 }
 
+// Intersection returns the area that is covered by both rectangles. It is empty if they don't overlap.
+func (r Rect) Intersection(o Rect) Rect {
+	out := Rect{
+		X1: math.Max(r.X1, o.X1),
+		Y1: math.Max(r.Y1, o.Y1),
+		X2: math.Min(r.X2, o.X2),
+		Y2: math.Min(r.Y2, o.Y2),
+	}
+	if out.X2 < out.X1 {
+		out.X2 = out.X1
+	}
+	if out.Y2 < out.Y1 {
+		out.Y2 = out.Y1
+	}
+	return out
+}
+
+// Empty returns true if the rectangle has no area.
+func (r Rect) Empty() bool {
+	return r.X2 <= r.X1 || r.Y2 <= r.Y1
+}
+
 type DrawingContext struct {
 	*canvas.Context
+	// Resolution is the resolution of the final output. Components that rasterize parts of their content use it to pick a fitting image size.
+	// If it is zero one pixel per unit is assumed.
+	Resolution canvas.Resolution
+}
+
+// PixelResolution returns the resolution of the output or one pixel per unit if it is not known.
+func (ctx DrawingContext) PixelResolution() canvas.Resolution {
+	if ctx.Resolution <= 0 {
+		return canvas.DPMM(1)
+	}
+	return ctx.Resolution
+}
+
+// Effect changes the way a component is drawn. It is applied through the layer of an item.
+type Effect interface {
+	// DrawEffect draws the component which is done by calling draw with the context that the component should be drawn into.
+	DrawEffect(ctx DrawingContext, bounds Rect, draw func(DrawingContext) error) error
+}
+
+// LayeredComponent is implemented by components that can have an effect applied to them.
+type LayeredComponent interface {
+	Component
+	// LayerEffect returns the effect that should be used to draw the component or nil if it should be drawn normally.
+	LayerEffect() Effect
 }
 
 func Draw(comp Component) error {
@@ -98,7 +145,7 @@ func Draw(comp Component) error {
 	// move origin of the context to the top left corner
 	ctx.SetCoordSystem(canvas.CartesianIV)
 
-	comp.Draw(DrawingContext{ctx}, Rect{0, 0, 1000, 1000})
+	comp.Draw(DrawingContext{Context: ctx}, Rect{0, 0, 1000, 1000})
 
 	// Rasterize the canvas and write to a PNG file with 3.2 dots-per-mm (320x320 px)
 	c.WriteFile("output.png", renderers.PNG())
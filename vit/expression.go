@@ -178,6 +178,8 @@ func (c *AccessCollector) ResolveVariable(key string) (interface{}, bool) {
 		return c.SubContext(actual), true
 	case *Method:
 		return actual, true
+	case script.Function:
+		return actual, true
 	case EventSource:
 		return EventAdapter{actual}, true
 	case Value:
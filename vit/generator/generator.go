@@ -20,6 +20,7 @@ const vitPackage = "github.com/omniskop/vitrum/vit"
 const parsePackage = "github.com/omniskop/vitrum/vit/parse"
 const stdPackage = "github.com/omniskop/vitrum/vit/std"
 const vpathPackage = "github.com/omniskop/vitrum/vit/vpath"
+const scriptPackage = "github.com/omniskop/vitrum/vit/script"
 
 // Indicates that file locations in the source file should not be brought into the generated code
 const hideSourceFiles = true
@@ -34,6 +35,8 @@ const (
 	optionalTag    = "gen-optional"
 	notifyTag      = "gen-notify"
 	specialTag     = "gen-special"
+	referenceTag   = "gen-reference"
+	nativeTag      = "gen-native" // the method is implemented in go by a method with the same name and the signature of script.Function
 )
 
 var functionRegex = regexp.MustCompile(`([a-zA-Z]+)\((.+)\)`)
@@ -249,6 +252,9 @@ func generateComponent(f *jen.File, compName string, comp *vit.ComponentDefiniti
 
 	// setup all method attributes
 	for _, m := range comp.Methods {
+		if m.HasTag(nativeTag) {
+			continue
+		}
 		properties = append(properties, jen.Id(m.Name).Qual(vitPackage, "Method"))
 		propertyInstantiations = append(propertyInstantiations, jen.Line().Id(m.Name).Op(":").Qual(vitPackage, "NewMethod").Call(jen.Lit(m.Name), generateCode(m.Code(), *m.Position, "context")))
 	}
@@ -507,6 +513,12 @@ func generateComponent(f *jen.File, compName string, comp *vit.ComponentDefiniti
 					)
 				}
 				for _, method := range comp.Methods {
+					if method.HasTag(nativeTag) {
+						g.Case(jen.Lit(method.Name)).Block(
+							jen.Return(jen.Qual(scriptPackage, "Function").Call(jen.Id(receiverName).Dot(method.Name)), jen.True()),
+						)
+						continue
+					}
 					g.Case(jen.Lit(method.Name)).Block(
 						jen.Return(jen.Op("&").Id(receiverName).Dot(method.Name), jen.True()),
 					)
@@ -591,6 +603,9 @@ func generateComponent(f *jen.File, compName string, comp *vit.ComponentDefiniti
 			g.Line()
 			g.Comment("methods")
 			for _, m := range comp.Methods {
+				if m.HasTag(nativeTag) {
+					continue
+				}
 				g.If(jen.Id(receiverName).Dot(m.Name).Dot("ShouldEvaluate").Call()).Block(
 					jen.List(jen.Id("_"), jen.Err()).Op(":=").Id(receiverName).Dot(m.Name).Dot("Evaluate").Call(jen.Id(receiverName)),
 					jen.Id("sum").Op("++"),
@@ -75,8 +75,14 @@ func vitTypeInfo(comp *vit.ComponentDefinition, prop vit.PropertyDefinition) (pr
 		propType = jen.Qual(vitPackage, "AnyValue")
 		constructor = standardConstructor(prop, "Any")
 	case "component":
-		propType = jen.Qual(vitPackage, "ComponentDefValue")
-		constructor = jen.Op("*").Qual(vitPackage, "NewEmptyComponentDefValue").Call()
+		if prop.HasTag(referenceTag) {
+			// this property references an existing component instead of holding a definition
+			propType = jen.Qual(vitPackage, "ComponentRefValue")
+			constructor = standardConstructor(prop, "ComponentRef")
+		} else {
+			propType = jen.Qual(vitPackage, "ComponentDefValue")
+			constructor = jen.Op("*").Qual(vitPackage, "NewEmptyComponentDefValue").Call()
+		}
 	case "group":
 		propType, constructor, err = typeInfoForGroup(comp, prop)
 		if err != nil {
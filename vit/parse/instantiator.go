@@ -228,7 +228,12 @@ func populateComponent(instance vit.Component, def *vit.ComponentDefinition, fil
 				switch v := v.(type) {
 				case *vit.GroupValue:
 					// set property of group value
-					err := v.SetCodeOf(prop.Identifier[1], vit.Code{Code: prop.Expression, Position: prop.ValuePos, FileCtx: fileCtx})
+					var err error
+					if len(prop.Components) == 1 {
+						err = v.SetValueOf(prop.Identifier[1], vit.ComponentDefinitionInContext{prop.Components[0], fileCtx})
+					} else {
+						err = v.SetCodeOf(prop.Identifier[1], vit.Code{Code: prop.Expression, Position: prop.ValuePos, FileCtx: fileCtx})
+					}
 					if err != nil {
 						return genericErrorf(prop.Pos, "group-property %q of component %q: %w", prop.Identifier[0], def.BaseName, err)
 					}
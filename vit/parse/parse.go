@@ -697,6 +697,7 @@ func parseMethod(tokens *tokenBuffer, modifiers []string, tags map[string]string
 
 	pos := vit.NewRangeFromStartToEnd(startingPosition, t.position.End())
 	method := vit.NewMethod(name, vit.Code{Code: t.literal, Position: &pos})
+	method.Tags = tags
 
 	return method, nil
 }
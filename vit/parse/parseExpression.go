@@ -194,7 +194,8 @@ func validatePropertyValue(value propertyValue, expressionToken token) (isExpres
 				}
 			}
 		}
-		return false, nil
+		// a list that only contains expressions is just JavaScript code
+		return consistentType == valueTypeExpression, nil
 	case valueTypeExpression:
 		return true, nil
 	}
@@ -338,6 +338,17 @@ func (r *Root) Draw(ctx DrawingContext, area Rect) error {
 func (r *Root) DrawChildren(ctx DrawingContext, area Rect) error {
 
 	for _, child := range r.children {
+		if layered, ok := child.(LayeredComponent); ok {
+			if effect := layered.LayerEffect(); effect != nil {
+				err := effect.DrawEffect(ctx, child.Bounds(), func(ctx DrawingContext) error {
+					return child.Draw(ctx, area)
+				})
+				if err != nil {
+					r.context.Global.Environment.Logger().Printf("%s: layer effect: %v\r\n", child, err)
+				}
+				continue
+			}
+		}
 		child.Draw(ctx, area)
 	}
 
@@ -2,56 +2,104 @@ package script
 
 import (
 	"fmt"
+	"image/color"
 
+	"github.com/dop251/goja"
 	"github.com/lucasb-eyer/go-colorful"
 	"github.com/omniskop/vitrum/vit/vcolor"
 )
 
+// All functions that return colors return color objects (see Color).
+// Functions that accept colors take color objects as well as color strings.
 var builtinFunctions = map[string]interface{}{
-	"rgb": func(r, g, b *int) string {
-		if r == nil || g == nil || b == nil {
-			panic(fmt.Errorf("Vit.rgb: too few arguments"))
-		}
-		return vcolor.RGBAToHex(uint8(*r), uint8(*g), uint8(*b), 255)
+	// rgb takes the red, green and blue components in the range of 0 to 255.
+	"rgb": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		red, green, blue := numberArgument(r, call, "rgb", 0, nil), numberArgument(r, call, "rgb", 1, nil), numberArgument(r, call, "rgb", 2, nil)
+		return NewColor(r, color.NRGBA{uint8(red), uint8(green), uint8(blue), 255})
 	},
-	"rgba": func(r, g, b, a *int) string {
-		if r == nil || g == nil || b == nil {
-			panic(fmt.Errorf("Vit.rgba: too few arguments"))
-		}
-		if a == nil {
-			return vcolor.RGBAToHex(uint8(*r), uint8(*g), uint8(*b), 255)
-		} else {
-			return vcolor.RGBAToHex(uint8(*r), uint8(*g), uint8(*b), uint8(*a))
-		}
+	// rgba takes the red, green, blue and alpha components in the range of 0 to 255.
+	"rgba": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		red, green, blue := numberArgument(r, call, "rgba", 0, nil), numberArgument(r, call, "rgba", 1, nil), numberArgument(r, call, "rgba", 2, nil)
+		alpha := numberArgument(r, call, "rgba", 3, float(255))
+		return NewColor(r, color.NRGBA{uint8(red), uint8(green), uint8(blue), uint8(alpha)})
 	},
-	// TODO: maybe implement hsva, hsla and tint
-	"darker": func(color string, f *float64) string {
-		if f == nil {
-			d := 2.0
-			f = &d
-		}
-		c, err := vcolor.String(color)
-		if err != nil {
-			panic(fmt.Errorf("Vit.darker: %v", err))
-		}
+	// hsla takes the hue, saturation, lightness and alpha in the range of 0 to 1.
+	"hsla": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		h, s, l := numberArgument(r, call, "hsla", 0, nil), numberArgument(r, call, "hsla", 1, nil), numberArgument(r, call, "hsla", 2, nil)
+		a := numberArgument(r, call, "hsla", 3, float(1))
+		return NewColor(r, vcolor.HSLA(h*360, s, l, a))
+	},
+	// hsva takes the hue, saturation, value and alpha in the range of 0 to 1.
+	"hsva": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		h, s, v := numberArgument(r, call, "hsva", 0, nil), numberArgument(r, call, "hsva", 1, nil), numberArgument(r, call, "hsva", 2, nil)
+		a := numberArgument(r, call, "hsva", 3, float(1))
+		return NewColor(r, vcolor.HSVA(h*360, s, v, a))
+	},
+	"darker": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		c := colorFunctionArgument(r, call, "darker", 0)
+		f := numberArgument(r, call, "darker", 1, float(2))
 		col, a := vcolor.ToColorful(c)
 		h, s, v := col.Hsv()
-		v /= *f
-		return vcolor.ColorfulToHex(colorful.Hsv(h, s, v), a)
+		v /= f
+		return NewColor(r, colorfulToNRGBA(colorful.Hsv(h, s, v), a))
 	},
-	"lighter": func(color string, f *float64) string {
-		if f == nil {
-			d := 1.5
-			f = &d
-		}
-		c, err := vcolor.String(color)
-		if err != nil {
-			panic(fmt.Errorf("Vit.darker: %v", err))
-		}
+	"lighter": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		c := colorFunctionArgument(r, call, "lighter", 0)
+		f := numberArgument(r, call, "lighter", 1, float(1.5))
 		col, a := vcolor.ToColorful(c)
 		h, s, v := col.Hsv()
-		fmt.Println(h, s, v)
-		v *= *f
-		return vcolor.ColorfulToHex(colorful.Hsv(h, s, v), a)
+		v *= f
+		return NewColor(r, colorfulToNRGBA(colorful.Hsv(h, s, v), a))
+	},
+	// tint draws the tint color on top of the base color.
+	"tint": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		base, baseAlpha := vcolor.ToColorful(colorFunctionArgument(r, call, "tint", 0))
+		tint, tintAlpha := vcolor.ToColorful(colorFunctionArgument(r, call, "tint", 1))
+		ta, ba := float64(tintAlpha)/255, float64(baseAlpha)/255
+		alpha := ta + ba*(1-ta)
+		if alpha == 0 {
+			return NewColor(r, color.Transparent)
+		}
+		mix := func(t, b float64) float64 {
+			return (t*ta + b*ba*(1-ta)) / alpha
+		}
+		return NewColor(r, vcolor.RGBA(mix(tint.R, base.R), mix(tint.G, base.G), mix(tint.B, base.B), alpha))
+	},
+	// alpha returns the color with the given alpha value in the range of 0 to 1.
+	"alpha": func(call goja.FunctionCall, r *goja.Runtime) goja.Value {
+		col, _ := vcolor.ToColorful(colorFunctionArgument(r, call, "alpha", 0))
+		a := numberArgument(r, call, "alpha", 1, nil)
+		return NewColor(r, vcolor.RGBA(col.R, col.G, col.B, a))
 	},
 }
+
+func float(f float64) *float64 {
+	return &f
+}
+
+// numberArgument returns the argument at the given index. If it is missing the default value is returned.
+// Without a default value a JavaScript exception is thrown.
+func numberArgument(r *goja.Runtime, call goja.FunctionCall, function string, index int, defaultValue *float64) float64 {
+	arg := call.Argument(index)
+	if goja.IsUndefined(arg) {
+		if defaultValue == nil {
+			panic(r.NewGoError(fmt.Errorf("Vit.%s: too few arguments", function)))
+		}
+		return *defaultValue
+	}
+	return arg.ToFloat()
+}
+
+// colorFunctionArgument returns the color at the given index or throws a JavaScript exception.
+func colorFunctionArgument(r *goja.Runtime, call goja.FunctionCall, function string, index int) color.Color {
+	c, err := colorArgument(call.Argument(index))
+	if err != nil {
+		panic(r.NewGoError(fmt.Errorf("Vit.%s: %v", function, err)))
+	}
+	return c
+}
+
+func colorfulToNRGBA(c colorful.Color, a byte) color.NRGBA {
+	c = c.Clamped()
+	return vcolor.RGBA(c.R, c.G, c.B, float64(a)/255)
+}
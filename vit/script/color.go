@@ -0,0 +1,154 @@
+package script
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/dop251/goja"
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/omniskop/vitrum/vit/vcolor"
+)
+
+// Color is the representation of a color in JavaScript.
+// All components are non-premultiplied and in the range of 0 to 1. Hues are in the range of 0 to 1 as well.
+// The color can be read and modified through the properties r, g, b, a, hslHue, hslSaturation, hslLightness, hsvHue, hsvSaturation and hsvValue.
+// Converting it to a string results in a hex code like "#ff0000ff".
+// It implements color.Color which allows it to be assigned to color properties.
+type Color struct {
+	R, G, B, A float64
+	runtime    *goja.Runtime
+}
+
+var colorKeys = []string{"r", "g", "b", "a", "hslHue", "hslSaturation", "hslLightness", "hsvHue", "hsvSaturation", "hsvValue"}
+
+// NewColor converts a Go color into its JavaScript representation.
+func NewColor(r *goja.Runtime, c color.Color) goja.Value {
+	col, a := vcolor.ToColorful(c)
+	return r.NewDynamicObject(&Color{R: col.R, G: col.G, B: col.B, A: float64(a) / 255, runtime: r})
+}
+
+// RGBA implements color.Color.
+func (c *Color) RGBA() (r, g, b, a uint32) {
+	return vcolor.RGBA(c.R, c.G, c.B, c.A).RGBA()
+}
+
+func (c *Color) String() string {
+	n := vcolor.RGBA(c.R, c.G, c.B, c.A)
+	return vcolor.RGBAToHex(n.R, n.G, n.B, n.A)
+}
+
+func (c *Color) colorful() colorful.Color {
+	return colorful.Color{R: c.R, G: c.G, B: c.B}
+}
+
+func (c *Color) Get(key string) goja.Value {
+	h, s, l := c.colorful().Hsl()
+	_, hsvS, v := c.colorful().Hsv()
+	switch key {
+	case "r":
+		return c.runtime.ToValue(c.R)
+	case "g":
+		return c.runtime.ToValue(c.G)
+	case "b":
+		return c.runtime.ToValue(c.B)
+	case "a":
+		return c.runtime.ToValue(c.A)
+	case "hslHue", "hsvHue":
+		return c.runtime.ToValue(h / 360)
+	case "hslSaturation":
+		return c.runtime.ToValue(s)
+	case "hslLightness":
+		return c.runtime.ToValue(l)
+	case "hsvSaturation":
+		return c.runtime.ToValue(hsvS)
+	case "hsvValue":
+		return c.runtime.ToValue(v)
+	case "toString":
+		return c.runtime.ToValue(func(goja.FunctionCall) goja.Value {
+			return c.runtime.ToValue(c.String())
+		})
+	}
+	return goja.Undefined()
+}
+
+func (c *Color) Set(key string, value goja.Value) bool {
+	f := value.ToFloat()
+	if math.IsNaN(f) {
+		panic(c.runtime.NewTypeError(fmt.Sprintf("cannot assign %q to color.%s", value.String(), key)))
+	}
+	h, s, l := c.colorful().Hsl()
+	_, hsvS, v := c.colorful().Hsv()
+	var updated colorful.Color
+	switch key {
+	case "r":
+		c.R = clamp(f)
+		return true
+	case "g":
+		c.G = clamp(f)
+		return true
+	case "b":
+		c.B = clamp(f)
+		return true
+	case "a":
+		c.A = clamp(f)
+		return true
+	case "hslHue":
+		f = math.Mod(math.Mod(f, 1)+1, 1)
+		updated = colorful.Hsl(f*360, s, l)
+	case "hslSaturation":
+		updated = colorful.Hsl(h, clamp(f), l)
+	case "hslLightness":
+		updated = colorful.Hsl(h, s, clamp(f))
+	case "hsvHue":
+		f = math.Mod(math.Mod(f, 1)+1, 1)
+		updated = colorful.Hsv(f*360, hsvS, v)
+	case "hsvSaturation":
+		updated = colorful.Hsv(h, clamp(f), v)
+	case "hsvValue":
+		updated = colorful.Hsv(h, hsvS, clamp(f))
+	default:
+		return false
+	}
+	updated = updated.Clamped()
+	c.R, c.G, c.B = updated.R, updated.G, updated.B
+	return true
+}
+
+func (c *Color) Has(key string) bool {
+	if key == "toString" {
+		return true
+	}
+	for _, k := range colorKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Color) Delete(key string) bool {
+	return false
+}
+
+func (c *Color) Keys() []string {
+	return colorKeys
+}
+
+func clamp(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
+
+// colorArgument converts a JavaScript value that is either a color object or a color string into a color.
+func colorArgument(value goja.Value) (color.Color, error) {
+	if value == nil || goja.IsUndefined(value) || goja.IsNull(value) {
+		return nil, fmt.Errorf("missing color")
+	}
+	switch actual := value.Export().(type) {
+	case color.Color:
+		return actual, nil
+	case string:
+		return vcolor.String(actual)
+	}
+	return nil, fmt.Errorf("%q is not a color", value.String())
+}
@@ -0,0 +1,120 @@
+package script
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+type testVariables map[string]interface{}
+
+func (v testVariables) ResolveVariable(name string) (interface{}, bool) {
+	value, ok := v[name]
+	return value, ok
+}
+
+type testVariable struct {
+	value interface{}
+}
+
+func (v *testVariable) GetValue() interface{} {
+	return v.value
+}
+
+func (v *testVariable) SetValue(value interface{}) error {
+	v.value = value
+	return nil
+}
+
+func TestColorFunctions(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected color.NRGBA
+	}{
+		{"Vit.rgb(255, 0, 128)", color.NRGBA{255, 0, 128, 255}},
+		{"Vit.rgba(255, 0, 128, 64)", color.NRGBA{255, 0, 128, 64}},
+		{"Vit.hsla(1/3, 1, 0.5, 0.5)", color.NRGBA{0, 255, 0, 128}},
+		{"Vit.hsva(2/3, 1, 1)", color.NRGBA{0, 0, 255, 255}},
+		{"Vit.darker('#ff0000')", color.NRGBA{128, 0, 0, 255}},
+		{"Vit.lighter(Vit.rgb(100, 0, 0), 2)", color.NRGBA{200, 0, 0, 255}},
+		{"Vit.alpha('red', 0.5)", color.NRGBA{255, 0, 0, 128}},
+		{"Vit.tint('white', Vit.alpha('black', 0.5))", color.NRGBA{127, 127, 127, 255}}, // the alpha value is stored as a byte
+		{"Vit.tint('red', 'blue')", color.NRGBA{0, 0, 255, 255}},
+		{"Vit.tint('red', 'transparent')", color.NRGBA{255, 0, 0, 255}},
+	}
+	for _, test := range tests {
+		result, err := Run(test.code, testVariables{})
+		if err != nil {
+			t.Errorf("%s: %v", test.code, err)
+			continue
+		}
+		c, ok := result.(color.Color)
+		if !ok {
+			t.Errorf("%s: expected a color, got %T", test.code, result)
+			continue
+		}
+		if n := color.NRGBAModel.Convert(c).(color.NRGBA); n != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.code, test.expected, n)
+		}
+	}
+
+	if _, err := Run("Vit.darker('notacolor')", testVariables{}); err == nil {
+		t.Errorf("expected an error for an invalid color")
+	}
+}
+
+func TestColorObjects(t *testing.T) {
+	item := testVariables{
+		"color": &testVariable{color.RGBA{255, 0, 0, 255}},
+	}
+	variables := testVariables{
+		"red":  item["color"],
+		"item": item,
+	}
+
+	tests := []struct {
+		code     string
+		expected interface{}
+	}{
+		{"red.r", 1.0},
+		{"red.g", 0.0},
+		{"red.hslLightness", 0.5},
+		{"Vit.hsla(0.25, 1, 0.5).hslHue", 0.25},
+		{"red.toString()", "#ff0000ff"},
+		{"'' + Vit.rgb(0, 0, 255)", "#0000ffff"},
+	}
+	for _, test := range tests {
+		result, err := Run(test.code, variables)
+		if err != nil {
+			t.Errorf("%s: %v", test.code, err)
+			continue
+		}
+		if f, ok := test.expected.(float64); ok {
+			if actual, ok := toFloat(result); !ok || math.Abs(actual-f) > 0.005 {
+				t.Errorf("%s: expected %v, got %v", test.code, f, result)
+			}
+		} else if result != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.code, test.expected, result)
+		}
+	}
+
+	// modified colors can be assigned back to color values
+	_, err := Run("var c = item.color; c.hsvValue = 0.5; c.a = 0.5; item.color = c", variables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := item["color"].(*testVariable).value.(color.Color)
+	if n := color.NRGBAModel.Convert(c).(color.NRGBA); n != (color.NRGBA{128, 0, 0, 128}) {
+		t.Errorf("unexpected modified color %v", n)
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
@@ -3,6 +3,7 @@ package script
 import (
 	"errors"
 	"fmt"
+	"image/color"
 	"sync"
 
 	"github.com/dop251/goja"
@@ -83,7 +84,7 @@ func (s *Script) Call(variables VariableSource, arguments ...interface{}) (inter
 	var argumentValues = make([]goja.Value, len(arguments))
 	for i, arg := range arguments {
 		// TODO: figure out if these values have to be deleted form the runtime manually afterwards
-		argumentValues[i] = runtime.ToValue(arg)
+		argumentValues[i] = toValue(arg)
 	}
 	result, err := f(goja.Undefined(), argumentValues...)
 	if err != nil {
@@ -141,6 +142,44 @@ type Variable interface {
 	SetValue(interface{}) error
 }
 
+// Function is implemented in Go and can be called from JavaScript.
+// The arguments are exported to Go values. If an error is returned it will be thrown as an exception.
+type Function func(args ...interface{}) (interface{}, error)
+
+// value returns the function as a JavaScript function.
+func (f Function) value() goja.Value {
+	return runtime.ToValue(func(call goja.FunctionCall) goja.Value {
+		args := make([]interface{}, len(call.Arguments))
+		for i, arg := range call.Arguments {
+			args[i] = arg.Export()
+		}
+		result, err := f(args...)
+		if err != nil {
+			panic(runtime.NewGoError(err))
+		}
+		if result == nil {
+			return goja.Undefined()
+		}
+		return toValue(result)
+	})
+}
+
+// toValue converts a Go value into a JavaScript value.
+// Variable sources become objects whose properties are resolved through the source.
+func toValue(value interface{}) goja.Value {
+	switch actual := value.(type) {
+	case goja.Value:
+		return actual
+	case Function:
+		return actual.value()
+	case VariableSource:
+		return runtime.NewDynamicObject(&VariableBridge{actual})
+	case color.Color:
+		return NewColor(runtime, actual)
+	}
+	return runtime.ToValue(value)
+}
+
 type VariableBridge struct {
 	Source VariableSource
 }
@@ -165,7 +204,12 @@ func (b *VariableBridge) Get(key string) goja.Value {
 	case VariableSource:
 		// fmt.Printf("[VariableBridge] get %q: dynamic object\n", key)
 		return runtime.NewDynamicObject(&VariableBridge{actual})
+	case Function:
+		return actual.value()
 	case Variable:
+		if c, ok := actual.GetValue().(color.Color); ok {
+			return NewColor(runtime, c)
+		}
 		return runtime.ToValue(actual.GetValue())
 	}
 	return runtime.ToValue(val)
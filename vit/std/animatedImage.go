@@ -0,0 +1,100 @@
+package std
+
+import (
+	"time"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+// animationClock returns the current time for animations. Tests replace it to control the time.
+var animationClock = time.Now
+
+// animationState keeps track of the frame that is shown by an AnimatedImage.
+type animationState struct {
+	data         *img      // the image the state belongs to
+	frameStarted time.Time // when the current frame has been shown first
+	repetitions  int       // how often the animation has been completed
+	advancing    bool      // set while the image changes the current frame itself
+}
+
+// restartFrame is called when the current frame or the playback has been changed from the outside.
+func (a *AnimatedImage) restartFrame() {
+	if a.animation.advancing {
+		return
+	}
+	a.animation.frameStarted = animationClock()
+	a.animation.repetitions = 0
+}
+
+// syncImage resets the animation if another image has been loaded.
+func (a *AnimatedImage) syncImage() {
+	if a.imageData == a.animation.data {
+		return
+	}
+	a.animation = animationState{data: a.imageData, frameStarted: animationClock()}
+	count := 0
+	if a.imageData != nil {
+		count = a.imageData.frameCount()
+	}
+	a.animation.advancing = true
+	a.frameCount.SetIntValue(count)
+	a.currentFrame.SetIntValue(0)
+	a.animation.advancing = false
+}
+
+// advance moves on to the frame that should be shown now.
+// If the animation is still running the time at which the next frame is due is returned.
+func (a *AnimatedImage) advance(now time.Time) (time.Time, bool) {
+	a.syncImage()
+	data := a.imageData
+	if data == nil || len(data.frames) < 2 || !a.playing.Bool() || a.paused.Bool() || a.speed.Float64() <= 0 {
+		return time.Time{}, false
+	}
+	frame := a.currentFrame.Int()
+	if frame < 0 || frame >= len(data.frames) {
+		frame = 0
+	}
+
+	var total time.Duration
+	for _, delay := range data.delays {
+		total += delay
+	}
+	if now.Sub(a.animation.frameStarted) > time.Duration(float64(total)/a.speed.Float64()) {
+		// the image hasn't been drawn for a long time, there is no point in catching up
+		a.animation.frameStarted = now
+	}
+
+	a.animation.advancing = true
+	defer func() { a.animation.advancing = false }()
+	for {
+		due := a.animation.frameStarted.Add(time.Duration(float64(data.delays[frame]) / a.speed.Float64()))
+		if now.Before(due) {
+			if frame != a.currentFrame.Int() {
+				a.currentFrame.SetIntValue(frame)
+			}
+			return due, true
+		}
+		a.animation.frameStarted = due
+		frame++
+		if frame < len(data.frames) {
+			continue
+		}
+		// like in gif files a negative loop count means that the animation is shown once and zero means that it loops forever
+		a.animation.repetitions++
+		if data.loops < 0 || (data.loops > 0 && a.animation.repetitions > data.loops) {
+			a.currentFrame.SetIntValue(len(data.frames) - 1)
+			a.playing.SetBoolValue(false)
+			return time.Time{}, false
+		}
+		frame = 0
+	}
+}
+
+func (a *AnimatedImage) Draw(ctx vit.DrawingContext, area vit.Rect) error {
+	a.refreshProvided()
+	if due, ok := a.advance(animationClock()); ok {
+		a.Context().Global.Environment.ScheduleRedraw(due)
+	}
+	a.drawFrame(ctx, a.currentFrame.Int())
+	return a.Root.DrawChildren(ctx, a.Bounds())
+}
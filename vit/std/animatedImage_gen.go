@@ -0,0 +1,234 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForAnimatedImage(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type AnimatedImage struct {
+	*Image
+	id string
+
+	playing      vit.BoolValue
+	paused       vit.BoolValue
+	currentFrame vit.IntValue
+	frameCount   vit.IntValue
+	speed        vit.FloatValue
+	animation    animationState
+}
+
+// newAnimatedImageInGlobal creates an appropriate file context for the component and then returns a new AnimatedImage instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newAnimatedImageInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*AnimatedImage, error) {
+	fileCtx, err := newFileContextForAnimatedImage(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewAnimatedImage(id, fileCtx), nil
+}
+func NewAnimatedImage(id string, context *vit.FileContext) *AnimatedImage {
+	a := &AnimatedImage{
+		Image:        NewImage("", context),
+		id:           id,
+		playing:      *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		paused:       *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		currentFrame: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		frameCount:   *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		speed:        *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "1", Position: nil}),
+		animation:    animationState{},
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	a.playing.AddDependent(vit.FuncDep(a.restartFrame))
+	a.paused.AddDependent(vit.FuncDep(a.restartFrame))
+	a.currentFrame.AddDependent(vit.FuncDep(a.restartFrame))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", a)
+
+	return a
+}
+
+func (a *AnimatedImage) String() string {
+	return fmt.Sprintf("AnimatedImage(%s)", a.id)
+}
+
+func (a *AnimatedImage) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "playing":
+		return &a.playing, true
+	case "paused":
+		return &a.paused, true
+	case "currentFrame":
+		return &a.currentFrame, true
+	case "frameCount":
+		return &a.frameCount, true
+	case "speed":
+		return &a.speed, true
+	default:
+		return a.Image.Property(key)
+	}
+}
+
+func (a *AnimatedImage) MustProperty(key string) vit.Value {
+	v, ok := a.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (a *AnimatedImage) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "playing":
+		err = a.playing.SetValue(value)
+	case "paused":
+		err = a.paused.SetValue(value)
+	case "currentFrame":
+		err = a.currentFrame.SetValue(value)
+	case "frameCount":
+		err = a.frameCount.SetValue(value)
+	case "speed":
+		err = a.speed.SetValue(value)
+	default:
+		return a.Image.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("AnimatedImage", key, a.id, err)
+	}
+	return nil
+}
+
+func (a *AnimatedImage) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "playing":
+		a.playing.SetCode(code)
+	case "paused":
+		a.paused.SetCode(code)
+	case "currentFrame":
+		a.currentFrame.SetCode(code)
+	case "frameCount":
+		a.frameCount.SetCode(code)
+	case "speed":
+		a.speed.SetCode(code)
+	default:
+		return a.Image.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (a *AnimatedImage) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return a.Image.Event(name)
+	}
+}
+
+func (a *AnimatedImage) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "playing":
+		return &a.playing, true
+	case "paused":
+		return &a.paused, true
+	case "currentFrame":
+		return &a.currentFrame, true
+	case "frameCount":
+		return &a.frameCount, true
+	case "speed":
+		return &a.speed, true
+	default:
+		return a.Image.ResolveVariable(key)
+	}
+}
+
+func (a *AnimatedImage) AddChild(child vit.Component) {
+	child.SetParent(a)
+	a.AddChildButKeepParent(child)
+}
+
+func (a *AnimatedImage) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range a.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(a)
+			a.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	a.AddChild(addThis)
+}
+
+func (a *AnimatedImage) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = a
+	}
+	// properties
+	if changed, err := a.playing.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("AnimatedImage", "playing", a.id, err))
+		}
+	}
+	if changed, err := a.paused.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("AnimatedImage", "paused", a.id, err))
+		}
+	}
+	if changed, err := a.currentFrame.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("AnimatedImage", "currentFrame", a.id, err))
+		}
+	}
+	if changed, err := a.frameCount.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("AnimatedImage", "frameCount", a.id, err))
+		}
+	}
+	if changed, err := a.speed.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("AnimatedImage", "speed", a.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := a.Image.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (a *AnimatedImage) As(target *vit.Component) bool {
+	if _, ok := (*target).(*AnimatedImage); ok {
+		*target = a
+		return true
+	}
+	return a.Item.As(target)
+}
+
+func (a *AnimatedImage) ID() string {
+	return a.id
+}
+
+func (a *AnimatedImage) Finish() error {
+	return a.RootC().FinishInContext(a)
+}
@@ -0,0 +1,98 @@
+package std
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+const animatedImageSource = `import Vit 1.0
+
+Item {
+    width: 10
+    height: 10
+
+    AnimatedImage {
+        path: "animation.gif"
+        width: 10
+        height: 10
+    }
+}
+`
+
+func TestAnimatedImage(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	palette := color.Palette{red, blue}
+	animation := &gif.GIF{LoopCount: -1} // played once
+	for _, index := range []uint8{0, 1} {
+		frame := image.NewPaletted(image.Rect(0, 0, 10, 10), palette)
+		for i := range frame.Pix {
+			frame.Pix[i] = index
+		}
+		animation.Image = append(animation.Image, frame)
+		animation.Delay = append(animation.Delay, 10) // 100ms
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, animation); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	animationClock = func() time.Time { return now }
+	defer func() { animationClock = time.Now }()
+
+	manager, handler := loadTestFiles(t, fstest.MapFS{
+		"Main.vit":      {Data: []byte(animatedImageSource)},
+		"animation.gif": {Data: buf.Bytes()},
+	})
+	image := findComponents[*AnimatedImage](manager.MainComponent())[0]
+
+	draw := func() color.RGBA {
+		return renderComponent(t, image, 10, 10).RGBAAt(5, 5)
+	}
+
+	if got := draw(); !similarColor(got, red, 2) {
+		t.Errorf("first frame shows %v but expected red", got)
+	}
+	if image.frameCount.Int() != 2 {
+		t.Errorf("frameCount is %d but expected 2", image.frameCount.Int())
+	}
+	if at, ok := handler.NextRedraw(); !ok || !at.Equal(now.Add(100*time.Millisecond)) {
+		t.Errorf("redraw has been scheduled at %v (%v) but expected after 100ms", at, ok)
+	}
+
+	now = now.Add(150 * time.Millisecond)
+	if got := draw(); !similarColor(got, blue, 2) {
+		t.Errorf("second frame shows %v but expected blue", got)
+	}
+	if image.currentFrame.Int() != 1 {
+		t.Errorf("currentFrame is %d but expected 1", image.currentFrame.Int())
+	}
+	if at, ok := handler.NextRedraw(); !ok || !at.Equal(now.Add(50*time.Millisecond)) {
+		t.Errorf("redraw has been scheduled at %v (%v) but expected at the end of the second frame", at, ok)
+	}
+
+	// the animation is only played once and stops at the last frame
+	now = now.Add(100 * time.Millisecond)
+	if got := draw(); !similarColor(got, blue, 2) {
+		t.Errorf("last frame shows %v but expected blue", got)
+	}
+	if image.playing.Bool() {
+		t.Error("animation is still playing after the last frame")
+	}
+	if _, ok := handler.NextRedraw(); ok {
+		t.Error("redraw has been scheduled after the animation stopped")
+	}
+
+	// jumping to a frame restarts the animation from there
+	image.playing.SetBoolValue(true)
+	image.currentFrame.SetIntValue(0)
+	if got := draw(); !similarColor(got, red, 2) {
+		t.Errorf("frame after restart shows %v but expected red", got)
+	}
+}
@@ -0,0 +1,27 @@
+package std
+
+import (
+	"runtime"
+	"sync"
+)
+
+// backgroundQueue feeds the goroutines that do work for all InputHandlers, like decoding images.
+var (
+	backgroundQueue = make(chan func(), 64)
+	backgroundStart sync.Once
+)
+
+// runInBackground runs the function on one of the background goroutines which are started on first use.
+// Their number is limited to the number of CPUs as the work is expected to be CPU bound.
+func runInBackground(work func()) {
+	backgroundStart.Do(func() {
+		for i := 0; i < runtime.NumCPU(); i++ {
+			go func() {
+				for work := range backgroundQueue {
+					work()
+				}
+			}()
+		}
+	})
+	backgroundQueue <- work
+}
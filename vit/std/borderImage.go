@@ -0,0 +1,90 @@
+package std
+
+import (
+	"math"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+func (b *BorderImage) Draw(ctx vit.DrawingContext, area vit.Rect) error {
+	b.refreshProvided()
+	b.drawPatches(ctx)
+	return b.Root.DrawChildren(ctx, b.Bounds())
+}
+
+// drawPatches draws the nine parts of the image that are divided by the border.
+func (b *BorderImage) drawPatches(ctx vit.DrawingContext) {
+	data := b.imageData
+	if data == nil || data.width <= 0 || data.height <= 0 {
+		return
+	}
+	b.scaledParts.begin(data)
+	border := func(side string) float64 {
+		return math.Max(0, float64(b.border.MustGet(side).GetValue().(int)))
+	}
+	bounds := b.Bounds()
+	left, right := fitInsets(border("left"), border("right"), data.width)
+	top, bottom := fitInsets(border("top"), border("bottom"), data.height)
+	// the corners are shrunk if the component is too small for them
+	targetLeft, targetRight := fitInsets(left, right, bounds.Width())
+	targetTop, targetBottom := fitInsets(top, bottom, bounds.Height())
+
+	srcX := [4]float64{0, left, data.width - right, data.width}
+	srcY := [4]float64{0, top, data.height - bottom, data.height}
+	targetX := [4]float64{bounds.X1, bounds.X1 + targetLeft, bounds.X2 - targetRight, bounds.X2}
+	targetY := [4]float64{bounds.Y1, bounds.Y1 + targetTop, bounds.Y2 - targetBottom, bounds.Y2}
+	for row := 0; row < 3; row++ {
+		for column := 0; column < 3; column++ {
+			// only the edges and the center are tiled, corners are always stretched to their target
+			horizontal, vertical := BorderImage_TileMode_Stretch, BorderImage_TileMode_Stretch
+			if column == 1 {
+				horizontal = BorderImage_TileMode(b.horizontalTileMode.Int())
+			}
+			if row == 1 {
+				vertical = BorderImage_TileMode(b.verticalTileMode.Int())
+			}
+			data.drawTiled(ctx,
+				vit.Rect{X1: srcX[column], Y1: srcY[row], X2: srcX[column+1], Y2: srcY[row+1]},
+				vit.Rect{X1: targetX[column], Y1: targetY[row], X2: targetX[column+1], Y2: targetY[row+1]},
+				horizontal, vertical, &b.scaledParts,
+			)
+		}
+	}
+}
+
+// fitInsets scales both insets down proportionally if they don't fit into the size.
+func fitInsets(a, b, size float64) (float64, float64) {
+	if a+b <= size {
+		return a, b
+	}
+	if a+b <= 0 || size <= 0 {
+		return 0, 0
+	}
+	scale := size / (a + b)
+	return a * scale, b * scale
+}
+
+// drawTiled fills the target with the part src of the first frame according to the tile modes.
+// Like the tiles of an Image the number of tiles is limited to maxImageTiles.
+func (d *img) drawTiled(ctx vit.DrawingContext, src vit.Rect, target vit.Rect, horizontal, vertical BorderImage_TileMode, cache *partCache) {
+	if src.Empty() || target.Empty() {
+		return
+	}
+	width := tileSize(src.Width(), target.Width(), horizontal)
+	height := tileSize(src.Height(), target.Height(), vertical)
+	for _, tile := range tiles(vit.NewRect(target.X1, target.Y1, width, height), target) {
+		d.draw(ctx, 0, src, tile, target, cache)
+	}
+}
+
+// tileSize returns the size of a single tile of a part with the natural size that fills the space.
+func tileSize(natural, space float64, mode BorderImage_TileMode) float64 {
+	switch mode {
+	case BorderImage_TileMode_Repeat:
+		return natural
+	case BorderImage_TileMode_Round:
+		return space / math.Max(1, math.Round(space/natural))
+	default:
+		return space
+	}
+}
@@ -0,0 +1,242 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForBorderImage(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type BorderImage_TileMode uint
+
+const (
+	BorderImage_TileMode_Stretch BorderImage_TileMode = 0
+	BorderImage_TileMode_Repeat  BorderImage_TileMode = 1
+	BorderImage_TileMode_Round   BorderImage_TileMode = 2
+)
+
+func (enum BorderImage_TileMode) String() string {
+	switch enum {
+	case BorderImage_TileMode_Stretch:
+		return "Stretch"
+	case BorderImage_TileMode_Repeat:
+		return "Repeat"
+	case BorderImage_TileMode_Round:
+		return "Round"
+	default:
+		return "<unknownTileMode>"
+	}
+}
+
+type BorderImage struct {
+	*Image
+	id string
+
+	border             vit.GroupValue
+	horizontalTileMode vit.IntValue
+	verticalTileMode   vit.IntValue
+}
+
+// newBorderImageInGlobal creates an appropriate file context for the component and then returns a new BorderImage instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newBorderImageInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*BorderImage, error) {
+	fileCtx, err := newFileContextForBorderImage(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewBorderImage(id, fileCtx), nil
+}
+func NewBorderImage(id string, context *vit.FileContext) *BorderImage {
+	b := &BorderImage{
+		Image: NewImage("", context),
+		id:    id,
+		border: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"left":   vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+			"right":  vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+			"top":    vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+			"bottom": vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		}),
+		horizontalTileMode: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "TileMode.Stretch", Position: nil}),
+		verticalTileMode:   *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "TileMode.Stretch", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	// register event listeners
+	// register enumerations
+	b.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "TileMode",
+		Position: nil,
+		Values:   map[string]int{"Stretch": 0, "Repeat": 1, "Round": 2},
+	})
+	// add child components
+
+	context.RegisterComponent("", b)
+
+	return b
+}
+
+func (b *BorderImage) String() string {
+	return fmt.Sprintf("BorderImage(%s)", b.id)
+}
+
+func (b *BorderImage) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "border":
+		return &b.border, true
+	case "horizontalTileMode":
+		return &b.horizontalTileMode, true
+	case "verticalTileMode":
+		return &b.verticalTileMode, true
+	default:
+		return b.Image.Property(key)
+	}
+}
+
+func (b *BorderImage) MustProperty(key string) vit.Value {
+	v, ok := b.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (b *BorderImage) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "border":
+		err = b.border.SetValue(value)
+	case "horizontalTileMode":
+		err = b.horizontalTileMode.SetValue(value)
+	case "verticalTileMode":
+		err = b.verticalTileMode.SetValue(value)
+	default:
+		return b.Image.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("BorderImage", key, b.id, err)
+	}
+	return nil
+}
+
+func (b *BorderImage) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "border":
+		b.border.SetCode(code)
+	case "horizontalTileMode":
+		b.horizontalTileMode.SetCode(code)
+	case "verticalTileMode":
+		b.verticalTileMode.SetCode(code)
+	default:
+		return b.Image.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (b *BorderImage) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return b.Image.Event(name)
+	}
+}
+
+func (b *BorderImage) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "border":
+		return &b.border, true
+	case "horizontalTileMode":
+		return &b.horizontalTileMode, true
+	case "verticalTileMode":
+		return &b.verticalTileMode, true
+	default:
+		return b.Image.ResolveVariable(key)
+	}
+}
+
+func (b *BorderImage) AddChild(child vit.Component) {
+	child.SetParent(b)
+	b.AddChildButKeepParent(child)
+}
+
+func (b *BorderImage) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range b.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(b)
+			b.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	b.AddChild(addThis)
+}
+
+func (b *BorderImage) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = b
+	}
+	// properties
+	if changed, err := b.border.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("BorderImage", "border", b.id, err))
+		}
+	}
+	if changed, err := b.horizontalTileMode.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("BorderImage", "horizontalTileMode", b.id, err))
+		}
+	}
+	if changed, err := b.verticalTileMode.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("BorderImage", "verticalTileMode", b.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := b.Image.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (b *BorderImage) As(target *vit.Component) bool {
+	if _, ok := (*target).(*BorderImage); ok {
+		*target = b
+		return true
+	}
+	return b.Item.As(target)
+}
+
+func (b *BorderImage) ID() string {
+	return b.id
+}
+
+func (b *BorderImage) Finish() error {
+	return b.RootC().FinishInContext(b)
+}
+
+func (b *BorderImage) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "Stretch":
+		return uint(BorderImage_TileMode_Stretch), true
+	case "Repeat":
+		return uint(BorderImage_TileMode_Repeat), true
+	case "Round":
+		return uint(BorderImage_TileMode_Round), true
+	default:
+		return nil, false
+	}
+}
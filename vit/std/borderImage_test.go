@@ -0,0 +1,181 @@
+package std
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"testing/fstest"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/tdewolff/canvas"
+)
+
+const borderImageSource = `import Vit 1.0
+
+Item {
+    width: 100
+    height: 60
+
+    BorderImage {
+        path: "patch.png"
+        width: 30
+        height: 20
+        border.left: 2
+        border.right: 2
+        border.top: 2
+        border.bottom: 2
+    }
+    BorderImage {
+        path: "patch.png"
+        x: 40
+        width: 27
+        height: 20
+        border.left: 2
+        border.right: 2
+        border.top: 2
+        border.bottom: 2
+        horizontalTileMode: BorderImage.Repeat
+    }
+    BorderImage {
+        path: "patch.svg"
+        y: 30
+        width: 60
+        height: 30
+        border.left: 10
+        border.right: 10
+        border.top: 10
+        border.bottom: 10
+    }
+}
+`
+
+// patchSVG has red corners, a blue center and transparent edges.
+const patchSVG = `<svg xmlns="http://www.w3.org/2000/svg" width="30" height="30">
+    <rect x="0" y="0" width="30" height="30" fill="#ff0000"/>
+    <rect x="10" y="0" width="10" height="30" fill="#0000ff"/>
+    <rect x="0" y="10" width="30" height="10" fill="#0000ff"/>
+</svg>`
+
+func TestBorderImage(t *testing.T) {
+	var (
+		transparent = color.RGBA{0, 0, 0, 0}
+		green       = color.RGBA{0, 255, 0, 255}
+		red         = color.RGBA{255, 0, 0, 255}
+		yellow      = color.RGBA{255, 255, 0, 255}
+		blue        = color.RGBA{0, 0, 255, 255}
+	)
+	// a 6x6 image with green corners of 2x2 pixels, a blue center and edges that alternate between red and yellow
+	patch := image.NewRGBA(image.Rect(0, 0, 6, 6))
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 6; x++ {
+			edgeX, edgeY := x < 2 || x >= 4, y < 2 || y >= 4
+			switch {
+			case edgeX && edgeY:
+				patch.SetRGBA(x, y, green)
+			case edgeX:
+				patch.SetRGBA(x, y, []color.RGBA{red, yellow}[y%2])
+			case edgeY:
+				patch.SetRGBA(x, y, []color.RGBA{red, yellow}[x%2])
+			default:
+				patch.SetRGBA(x, y, blue)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, patch); err != nil {
+		t.Fatal(err)
+	}
+	manager, _ := loadTestFiles(t, fstest.MapFS{
+		"Main.vit":  {Data: []byte(borderImageSource)},
+		"patch.png": {Data: buf.Bytes()},
+		"patch.svg": {Data: []byte(patchSVG)},
+	})
+
+	img := renderComponent(t, manager.MainComponent(), 100, 60)
+	checkPixels(t, img, []pixelTest{
+		// stretched: the corners keep their size
+		{0, 0, green},
+		{1, 1, green},
+		{28, 18, green},
+		{29, 0, green},
+		{15, 10, blue},
+		{3, 10, blue},
+		{31, 10, transparent},
+		// repeated: the top edge alternates between red and yellow
+		{42, 0, red},
+		{43, 0, yellow},
+		{44, 0, red},
+		{63, 0, yellow},
+		{64, 0, red},
+		{65, 0, green},
+		{50, 10, blue},
+		// vector image: the corners stay red while the center is stretched
+		{5, 35, red},
+		{55, 55, red},
+		{15, 35, blue},
+		{45, 35, blue},
+		{30, 45, blue},
+	}, 10)
+}
+
+func TestScaledPartCache(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	data := rasterImage(src)
+	part := image.Rect(0, 0, 2, 2)
+	var cache partCache
+	scale := func(size image.Point) *image.RGBA {
+		scaled, ok := cache.scaledPart(0, src, part, size).(*image.RGBA)
+		if !ok || scaled == src {
+			t.Fatalf("part has not been scaled")
+		}
+		return scaled
+	}
+
+	cache.begin(data)
+	first := scale(image.Pt(8, 8))
+	if scale(image.Pt(8, 8)) != first {
+		t.Errorf("part has been scaled again within the same drawing")
+	}
+	cache.begin(data)
+	if scale(image.Pt(8, 8)) != first {
+		t.Errorf("part has been scaled again in the next drawing")
+	}
+
+	// a different size replaces the part
+	cache.begin(data)
+	second := scale(image.Pt(10, 10))
+	cache.begin(data)
+	if scale(image.Pt(8, 8)) == first {
+		t.Errorf("part that hasn't been used in the last drawing has been kept")
+	}
+	if len(cache.previous) != 1 || cache.previous[partKey{0, part, image.Pt(10, 10)}] != image.Image(second) {
+		t.Errorf("expected only the part of the last drawing to be kept, got %d parts", len(cache.previous))
+	}
+
+	// a different image clears the cache
+	cache.begin(rasterImage(src))
+	if len(cache.previous) != 0 || len(cache.parts) != 0 {
+		t.Errorf("parts of the previous image have been kept")
+	}
+}
+
+func TestTileLimit(t *testing.T) {
+	if count := len(tiles(vit.NewRect(0, 0, 1, 1), vit.NewRect(0, 0, 100, 100))); count != maxImageTiles {
+		t.Errorf("got %d tiles but expected them to be limited to %d", count, maxImageTiles)
+	}
+	if count := len(tiles(vit.NewRect(0, 0, 10, 10), vit.NewRect(0, 0, 100, 100))); count != 100 {
+		t.Errorf("got %d tiles but expected 100", count)
+	}
+
+	// border images stop at the same limit instead of drawing nothing
+	recorder := &recordingRenderer{}
+	ctx := canvas.NewContext(recorder)
+	ctx.SetCoordSystem(canvas.CartesianIV)
+	data := rasterImage(image.NewRGBA(image.Rect(0, 0, 1, 1)))
+	data.drawTiled(vit.DrawingContext{Context: ctx}, vit.NewRect(0, 0, 1, 1), vit.NewRect(0, 0, 100, 100), BorderImage_TileMode_Repeat, BorderImage_TileMode_Repeat, nil)
+	if recorder.images != maxImageTiles {
+		t.Errorf("border image has drawn %d tiles but expected %d", recorder.images, maxImageTiles)
+	}
+}
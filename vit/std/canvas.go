@@ -0,0 +1,81 @@
+package std
+
+import (
+	"time"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+// getContext returns the drawing context of the canvas. Like in HTML nothing is returned for unsupported context types.
+func (c *Canvas) getContext(args ...interface{}) (interface{}, error) {
+	if len(args) > 0 {
+		if contextType, ok := args[0].(string); ok && contextType != "2d" {
+			return nil, nil
+		}
+	}
+	return c.context, nil
+}
+
+// requestPaint emits onPaint during the next update.
+// Requests made after the canvas has been painted in the current frame, for example from within onPaint, wait until the next frame.
+func (c *Canvas) requestPaint(args ...interface{}) (interface{}, error) {
+	if c.painter.painted {
+		c.painter.deferred = true
+	} else {
+		c.painter.requested = true
+	}
+	c.Context().Global.Environment.ScheduleRedraw(time.Now())
+	return nil, nil
+}
+
+// wasCompleted registers the painter so that it is evaluated together with the event listeners of the canvas.
+func (c *Canvas) wasCompleted(*struct{}) {
+	c.painter.canvas = c
+	c.AddListenerFunction(&c.painter)
+}
+
+// boundsChanged clears the canvas and requests a new paint if its size changed.
+func (c *Canvas) boundsChanged() {
+	bounds := c.Bounds()
+	if bounds.Width() == c.context.width && bounds.Height() == c.context.height {
+		return
+	}
+	c.context.resize(bounds.Width(), bounds.Height())
+	c.painter.requested = true
+}
+
+func (c *Canvas) Draw(ctx vit.DrawingContext, area vit.Rect) error {
+	rect := c.Bounds()
+	c.context.replay(ctx, rect.X1, rect.Y1)
+	return c.Root.DrawChildren(ctx, rect)
+}
+
+// canvasPainter emits onPaint of a canvas during an update if a paint has been requested.
+type canvasPainter struct {
+	canvas    *Canvas
+	requested bool // onPaint is emitted during the next update
+	painted   bool // onPaint has been emitted in the current frame
+	deferred  bool // a paint has been requested for the next frame
+}
+
+func (p *canvasPainter) ShouldEvaluate() bool {
+	return p.requested
+}
+
+// Evaluate clears the display list and emits onPaint. The handler is evaluated later in the same update.
+func (p *canvasPainter) Evaluate(vit.Component) (interface{}, error) {
+	p.requested = false
+	p.painted = true
+	p.canvas.context.clear()
+	p.canvas.onPaint.Fire(p.canvas.context)
+	return nil, nil
+}
+
+// nextFrame is called by the environment after a frame has been drawn and turns deferred requests into pending ones.
+func (p *canvasPainter) nextFrame() {
+	p.painted = false
+	if p.deferred {
+		p.deferred = false
+		p.requested = true
+	}
+}
@@ -0,0 +1,1032 @@
+package std
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/script"
+	"github.com/omniskop/vitrum/vit/vcolor"
+	"github.com/omniskop/vitrum/vit/vfont"
+	"github.com/tdewolff/canvas"
+)
+
+// canvasTolerance is used to flatten paths before parts of them are cut away, for example by clearRect or the edges of the canvas.
+const canvasTolerance = 0.05
+
+// CanvasContext is the drawing context of a Canvas. It follows the CanvasRenderingContext2D of HTML.
+// Everything that is painted is recorded into a display list of filled paths which is replayed every time the canvas is drawn.
+// The content stays until it is cleared with clearRect, the canvas is resized or it is painted again.
+type CanvasContext struct {
+	width, height float64
+	commands      []gradientPiece // the display list in the coordinate system of the canvas
+	path          *canvas.Path    // the current path in the coordinate system of the canvas
+	state         canvasState
+	stack         []canvasState
+
+	// the face of the current font is cached as long as the font doesn't change
+	font     string
+	fontFace *canvas.FontFace
+}
+
+// canvasState contains everything that is saved and restored by save and restore.
+type canvasState struct {
+	transform      canvas.Matrix
+	fillStyle      canvasStyle
+	strokeStyle    canvasStyle
+	lineWidth      float64
+	lineCap        string
+	lineJoin       string
+	miterLimit     float64
+	lineDash       []float64
+	lineDashOffset float64
+	globalAlpha    float64
+	font           string
+	textAlign      string
+	textBaseline   string
+}
+
+// canvasStyle is used to fill or stroke. It's either a color or a gradient.
+type canvasStyle struct {
+	color    color.RGBA
+	gradient *CanvasGradient
+}
+
+func newCanvasContext() *CanvasContext {
+	return &CanvasContext{
+		path:  &canvas.Path{},
+		state: defaultCanvasState(),
+	}
+}
+
+func defaultCanvasState() canvasState {
+	black := color.RGBA{0, 0, 0, 255}
+	return canvasState{
+		transform:    canvas.Identity,
+		fillStyle:    canvasStyle{color: black},
+		strokeStyle:  canvasStyle{color: black},
+		lineWidth:    1,
+		lineCap:      "butt",
+		lineJoin:     "miter",
+		miterLimit:   10,
+		globalAlpha:  1,
+		font:         "10px sans-serif",
+		textAlign:    "start",
+		textBaseline: "alphabetic",
+	}
+}
+
+// resize changes the size of the canvas. Like in HTML this clears the canvas and resets the state.
+func (c *CanvasContext) resize(width, height float64) {
+	c.width, c.height = width, height
+	c.reset()
+}
+
+// reset clears the canvas, the current path and the state.
+func (c *CanvasContext) reset(args ...interface{}) (interface{}, error) {
+	c.commands = nil
+	c.path = &canvas.Path{}
+	c.state = defaultCanvasState()
+	c.stack = nil
+	return nil, nil
+}
+
+// clear removes everything that has been painted and the current path. The state is kept.
+func (c *CanvasContext) clear() {
+	c.commands = nil
+	c.path = &canvas.Path{}
+}
+
+// replay draws the recorded display list with the top left corner of the canvas at the given position.
+func (c *CanvasContext) replay(ctx vit.DrawingContext, x, y float64) {
+	if len(c.commands) == 0 {
+		return
+	}
+	offset := canvas.Identity.Translate(x, y)
+	ctx.Push()
+	defer ctx.Pop()
+	ctx.SetStrokeWidth(0)
+	ctx.SetStrokeColor(canvas.Transparent)
+	for _, command := range c.commands {
+		if command.gradient != nil {
+			// gradients are not moved together with the path
+			ctx.SetFillGradient(command.gradient.SetView(offset))
+		} else {
+			ctx.SetFillColor(command.color)
+		}
+		ctx.DrawPath(x, y, command.path)
+	}
+}
+
+func (c *CanvasContext) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "canvas":
+		return map[string]interface{}{"width": c.width, "height": c.height}, true
+	case "fillStyle":
+		return canvasProperty{
+			get: func() interface{} { return c.state.fillStyle.value() },
+			set: func(v interface{}) {
+				if style, ok := parseCanvasStyle(v); ok {
+					c.state.fillStyle = style
+				}
+			},
+		}, true
+	case "strokeStyle":
+		return canvasProperty{
+			get: func() interface{} { return c.state.strokeStyle.value() },
+			set: func(v interface{}) {
+				if style, ok := parseCanvasStyle(v); ok {
+					c.state.strokeStyle = style
+				}
+			},
+		}, true
+	case "lineWidth":
+		return numberProperty(&c.state.lineWidth, func(v float64) bool { return v > 0 }), true
+	case "miterLimit":
+		return numberProperty(&c.state.miterLimit, func(v float64) bool { return v > 0 }), true
+	case "lineDashOffset":
+		return numberProperty(&c.state.lineDashOffset, func(v float64) bool { return true }), true
+	case "globalAlpha":
+		return numberProperty(&c.state.globalAlpha, func(v float64) bool { return v >= 0 && v <= 1 }), true
+	case "lineCap":
+		return keywordProperty(&c.state.lineCap, "butt", "round", "square"), true
+	case "lineJoin":
+		return keywordProperty(&c.state.lineJoin, "miter", "round", "bevel"), true
+	case "textAlign":
+		return keywordProperty(&c.state.textAlign, "start", "end", "left", "right", "center"), true
+	case "textBaseline":
+		return keywordProperty(&c.state.textBaseline, "top", "hanging", "middle", "alphabetic", "ideographic", "bottom"), true
+	case "font":
+		return canvasProperty{
+			get: func() interface{} { return c.state.font },
+			set: func(v interface{}) {
+				if font, ok := v.(string); ok {
+					if _, _, err := parseCanvasFont(font); err == nil {
+						c.state.font = font
+					}
+				}
+			},
+		}, true
+
+	case "save":
+		return script.Function(c.save), true
+	case "restore":
+		return script.Function(c.restore), true
+	case "reset":
+		return script.Function(c.reset), true
+	case "scale":
+		return script.Function(c.scale), true
+	case "rotate":
+		return script.Function(c.rotate), true
+	case "translate":
+		return script.Function(c.translate), true
+	case "transform":
+		return script.Function(c.transform), true
+	case "setTransform":
+		return script.Function(c.setTransform), true
+	case "resetTransform":
+		return script.Function(c.resetTransform), true
+	case "beginPath":
+		return script.Function(c.beginPath), true
+	case "closePath":
+		return script.Function(c.closePath), true
+	case "moveTo":
+		return script.Function(c.moveTo), true
+	case "lineTo":
+		return script.Function(c.lineTo), true
+	case "quadraticCurveTo":
+		return script.Function(c.quadraticCurveTo), true
+	case "bezierCurveTo":
+		return script.Function(c.bezierCurveTo), true
+	case "arc":
+		return script.Function(c.arc), true
+	case "ellipse":
+		return script.Function(c.ellipse), true
+	case "rect":
+		return script.Function(c.rect), true
+	case "fill":
+		return script.Function(c.fill), true
+	case "stroke":
+		return script.Function(c.stroke), true
+	case "fillRect":
+		return script.Function(c.fillRect), true
+	case "strokeRect":
+		return script.Function(c.strokeRect), true
+	case "clearRect":
+		return script.Function(c.clearRect), true
+	case "fillText":
+		return script.Function(c.fillText), true
+	case "strokeText":
+		return script.Function(c.strokeText), true
+	case "measureText":
+		return script.Function(c.measureText), true
+	case "setLineDash":
+		return script.Function(c.setLineDash), true
+	case "getLineDash":
+		return script.Function(c.getLineDash), true
+	case "createLinearGradient":
+		return script.Function(c.createLinearGradient), true
+	case "createRadialGradient":
+		return script.Function(c.createRadialGradient), true
+	case "createConicGradient":
+		return script.Function(c.createConicGradient), true
+	}
+	return nil, false
+}
+
+// canvasProperty exposes a property of the drawing context to scripts.
+// Like in HTML invalid values are ignored silently.
+type canvasProperty struct {
+	get func() interface{}
+	set func(interface{})
+}
+
+func (p canvasProperty) GetValue() interface{} {
+	return p.get()
+}
+
+func (p canvasProperty) SetValue(value interface{}) error {
+	p.set(value)
+	return nil
+}
+
+// numberProperty returns a property that only accepts finite numbers for which valid returns true.
+func numberProperty(target *float64, valid func(float64) bool) canvasProperty {
+	return canvasProperty{
+		get: func() interface{} { return *target },
+		set: func(v interface{}) {
+			if number, ok := toNumber(v); ok && valid(number) {
+				*target = number
+			}
+		},
+	}
+}
+
+// keywordProperty returns a property that only accepts one of the given keywords.
+func keywordProperty(target *string, keywords ...string) canvasProperty {
+	return canvasProperty{
+		get: func() interface{} { return *target },
+		set: func(v interface{}) {
+			keyword, ok := v.(string)
+			if !ok {
+				return
+			}
+			for _, k := range keywords {
+				if k == keyword {
+					*target = keyword
+					return
+				}
+			}
+		},
+	}
+}
+
+// toNumber converts a value from a script into a finite number.
+func toNumber(value interface{}) (float64, bool) {
+	var number float64
+	switch value := value.(type) {
+	case float64:
+		number = value
+	case int64:
+		number = float64(value)
+	case int:
+		number = float64(value)
+	default:
+		return 0, false
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+	return number, true
+}
+
+// numbers converts the first n arguments into numbers.
+// Like in HTML calls with missing or non-finite arguments are ignored, which is indicated by returning false.
+func numbers(args []interface{}, n int) ([]float64, bool) {
+	if len(args) < n {
+		return nil, false
+	}
+	values := make([]float64, n)
+	for i := range values {
+		var ok bool
+		values[i], ok = toNumber(args[i])
+		if !ok {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// unwrapScriptValue returns the Go value behind objects that have been passed to a script.
+func unwrapScriptValue(value interface{}) interface{} {
+	if bridge, ok := value.(*script.VariableBridge); ok {
+		return bridge.Source
+	}
+	return value
+}
+
+func parseCanvasStyle(value interface{}) (canvasStyle, bool) {
+	switch value := unwrapScriptValue(value).(type) {
+	case *CanvasGradient:
+		return canvasStyle{gradient: value}, true
+	case color.Color:
+		return canvasStyle{color: color.RGBAModel.Convert(value).(color.RGBA)}, true
+	case string:
+		col, err := vcolor.String(value)
+		if err != nil {
+			return canvasStyle{}, false
+		}
+		return canvasStyle{color: color.RGBAModel.Convert(col).(color.RGBA)}, true
+	}
+	return canvasStyle{}, false
+}
+
+func (s canvasStyle) value() interface{} {
+	if s.gradient != nil {
+		return s.gradient
+	}
+	return s.color
+}
+
+// state
+
+func (c *CanvasContext) save(args ...interface{}) (interface{}, error) {
+	state := c.state
+	state.lineDash = append([]float64(nil), c.state.lineDash...)
+	c.stack = append(c.stack, state)
+	return nil, nil
+}
+
+func (c *CanvasContext) restore(args ...interface{}) (interface{}, error) {
+	if len(c.stack) == 0 {
+		return nil, nil
+	}
+	c.state = c.stack[len(c.stack)-1]
+	c.stack = c.stack[:len(c.stack)-1]
+	return nil, nil
+}
+
+func (c *CanvasContext) setLineDash(args ...interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	dashes := floatList(args[0])
+	for _, dash := range dashes {
+		if dash < 0 || math.IsNaN(dash) || math.IsInf(dash, 0) {
+			return nil, nil
+		}
+	}
+	if len(dashes)%2 == 1 {
+		dashes = append(dashes, dashes...)
+	}
+	c.state.lineDash = dashes
+	return nil, nil
+}
+
+func (c *CanvasContext) getLineDash(args ...interface{}) (interface{}, error) {
+	dashes := make([]interface{}, len(c.state.lineDash))
+	for i, dash := range c.state.lineDash {
+		dashes[i] = dash
+	}
+	return dashes, nil
+}
+
+// transformations
+
+func (c *CanvasContext) scale(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 2); ok {
+		c.state.transform = c.state.transform.Scale(v[0], v[1])
+	}
+	return nil, nil
+}
+
+// rotate rotates by an angle in radians. Because the y axis points down positive angles rotate clockwise.
+func (c *CanvasContext) rotate(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 1); ok {
+		c.state.transform = c.state.transform.Rotate(v[0] * 180 / math.Pi)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) translate(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 2); ok {
+		c.state.transform = c.state.transform.Translate(v[0], v[1])
+	}
+	return nil, nil
+}
+
+// transform multiplies the current transformation with the matrix [a c e; b d f].
+func (c *CanvasContext) transform(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 6); ok {
+		c.state.transform = c.state.transform.Mul(canvas.Matrix{{v[0], v[2], v[4]}, {v[1], v[3], v[5]}})
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) setTransform(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 6); ok {
+		c.state.transform = canvas.Matrix{{v[0], v[2], v[4]}, {v[1], v[3], v[5]}}
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) resetTransform(args ...interface{}) (interface{}, error) {
+	c.state.transform = canvas.Identity
+	return nil, nil
+}
+
+// paths
+
+// point transforms a point into the coordinate system of the canvas.
+func (c *CanvasContext) point(x, y float64) canvas.Point {
+	return c.state.transform.Dot(canvas.Point{X: x, Y: y})
+}
+
+// hasCurrentPoint returns true if the current path has been started. Unlike Path.Empty this is also the case if it only contains a MoveTo.
+func (c *CanvasContext) hasCurrentPoint() bool {
+	return len(c.path.Data()) > 0
+}
+
+// ensureSubpath starts a new subpath at the given point if there is no current point.
+func (c *CanvasContext) ensureSubpath(x, y float64) {
+	if !c.hasCurrentPoint() {
+		p := c.point(x, y)
+		c.path.MoveTo(p.X, p.Y)
+	}
+}
+
+func (c *CanvasContext) beginPath(args ...interface{}) (interface{}, error) {
+	c.path = &canvas.Path{}
+	return nil, nil
+}
+
+func (c *CanvasContext) closePath(args ...interface{}) (interface{}, error) {
+	c.path.Close()
+	return nil, nil
+}
+
+func (c *CanvasContext) moveTo(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 2); ok {
+		p := c.point(v[0], v[1])
+		c.path.MoveTo(p.X, p.Y)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) lineTo(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 2); ok {
+		c.ensureSubpath(v[0], v[1])
+		p := c.point(v[0], v[1])
+		c.path.LineTo(p.X, p.Y)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) quadraticCurveTo(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 4); ok {
+		c.ensureSubpath(v[0], v[1])
+		cp, p := c.point(v[0], v[1]), c.point(v[2], v[3])
+		c.path.QuadTo(cp.X, cp.Y, p.X, p.Y)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) bezierCurveTo(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 6); ok {
+		c.ensureSubpath(v[0], v[1])
+		cp1, cp2, p := c.point(v[0], v[1]), c.point(v[2], v[3]), c.point(v[4], v[5])
+		c.path.CubeTo(cp1.X, cp1.Y, cp2.X, cp2.Y, p.X, p.Y)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) rect(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 4); ok {
+		c.path = c.path.Append(c.rectPath(v[0], v[1], v[2], v[3]))
+		// like in HTML a new subpath starts at the origin of the rectangle
+		p := c.point(v[0], v[1])
+		c.path.MoveTo(p.X, p.Y)
+	}
+	return nil, nil
+}
+
+// rectPath returns a closed rectangle in the coordinate system of the canvas.
+func (c *CanvasContext) rectPath(x, y, w, h float64) *canvas.Path {
+	path := &canvas.Path{}
+	path.MoveTo(x, y)
+	path.LineTo(x+w, y)
+	path.LineTo(x+w, y+h)
+	path.LineTo(x, y+h)
+	path.Close()
+	return path.Transform(c.state.transform)
+}
+
+func (c *CanvasContext) arc(args ...interface{}) (interface{}, error) {
+	v, ok := numbers(args, 5)
+	if !ok {
+		return nil, nil
+	}
+	return c.ellipse(v[0], v[1], v[2], v[2], 0.0, v[3], v[4], optionalArgument(args, 5))
+}
+
+// ellipse adds an elliptical arc around (x, y) from startAngle to endAngle, both in radians.
+// A straight line connects the current point with the start of the arc.
+func (c *CanvasContext) ellipse(args ...interface{}) (interface{}, error) {
+	v, ok := numbers(args, 7)
+	if !ok {
+		return nil, nil
+	}
+	x, y, rx, ry, rotation, start, end := v[0], v[1], v[2], v[3], v[4], v[5], v[6]
+	if rx < 0 || ry < 0 {
+		return nil, errors.New("IndexSizeError: the radius is negative")
+	}
+	counterclockwise, _ := optionalArgument(args, 7).(bool)
+	end = normalizeArcEnd(start, end, counterclockwise)
+
+	segment := &canvas.Path{}
+	p := canvas.EllipsePos(rx, ry, rotation, x, y, start)
+	segment.MoveTo(p.X, p.Y)
+	if rx > 0 && ry > 0 {
+		segment.Arc(rx, ry, rotation*180/math.Pi, start*180/math.Pi, end*180/math.Pi)
+	} else {
+		p = canvas.EllipsePos(rx, ry, rotation, x, y, end)
+		segment.LineTo(p.X, p.Y)
+	}
+	c.appendSegment(segment.Transform(c.state.transform))
+	return nil, nil
+}
+
+// optionalArgument returns the argument at the given index or nil if it hasn't been passed.
+func optionalArgument(args []interface{}, index int) interface{} {
+	if index < len(args) {
+		return args[index]
+	}
+	return nil
+}
+
+// normalizeArcEnd adjusts the end angle like HTML does.
+// Arcs that cover at least a full turn are drawn as a full circle, otherwise the arc runs less than one turn in the given direction.
+func normalizeArcEnd(start, end float64, counterclockwise bool) float64 {
+	const turn = 2 * math.Pi
+	if !counterclockwise {
+		if end-start >= turn {
+			return start + turn
+		}
+		return start + positiveMod(end-start, turn)
+	}
+	if start-end >= turn {
+		return start - turn
+	}
+	return start - positiveMod(start-end, turn)
+}
+
+func positiveMod(a, b float64) float64 {
+	m := math.Mod(a, b)
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+// appendSegment adds a path that starts with a single MoveTo to the current path.
+// If the current path isn't empty a straight line connects its current point with the start of the segment.
+func (c *CanvasContext) appendSegment(segment *canvas.Path) {
+	scanner := segment.Scanner()
+	for scanner.Scan() {
+		end := scanner.End()
+		switch scanner.Cmd() {
+		case canvas.MoveToCmd:
+			if !c.hasCurrentPoint() {
+				c.path.MoveTo(end.X, end.Y)
+			} else {
+				c.path.LineTo(end.X, end.Y)
+			}
+		case canvas.LineToCmd:
+			c.path.LineTo(end.X, end.Y)
+		case canvas.QuadToCmd:
+			cp := scanner.CP1()
+			c.path.QuadTo(cp.X, cp.Y, end.X, end.Y)
+		case canvas.CubeToCmd:
+			cp1, cp2 := scanner.CP1(), scanner.CP2()
+			c.path.CubeTo(cp1.X, cp1.Y, cp2.X, cp2.Y, end.X, end.Y)
+		case canvas.ArcToCmd:
+			rx, ry, rot, large, sweep := scanner.Arc()
+			c.path.ArcTo(rx, ry, rot, large, sweep, end.X, end.Y)
+		case canvas.CloseCmd:
+			c.path.Close()
+		}
+	}
+}
+
+// painting
+
+func (c *CanvasContext) fill(args ...interface{}) (interface{}, error) {
+	path := closedSubpaths(c.path)
+	if rule, _ := optionalArgument(args, 0).(string); rule == "evenodd" {
+		// not all renderers support the even-odd fill rule, that's why overlapping parts are removed beforehand
+		path = path.Flatten(canvasTolerance).Settle(canvas.EvenOdd)
+	}
+	c.fillPath(path, c.state.fillStyle)
+	return nil, nil
+}
+
+func (c *CanvasContext) stroke(args ...interface{}) (interface{}, error) {
+	c.strokePath(c.path)
+	return nil, nil
+}
+
+func (c *CanvasContext) fillRect(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 4); ok && v[2] != 0 && v[3] != 0 {
+		c.fillPath(c.rectPath(v[0], v[1], v[2], v[3]), c.state.fillStyle)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) strokeRect(args ...interface{}) (interface{}, error) {
+	if v, ok := numbers(args, 4); ok {
+		c.strokePath(c.rectPath(v[0], v[1], v[2], v[3]))
+	}
+	return nil, nil
+}
+
+// clearRect makes the rectangle transparent by removing it from everything that has been painted so far.
+func (c *CanvasContext) clearRect(args ...interface{}) (interface{}, error) {
+	v, ok := numbers(args, 4)
+	if !ok || v[2] == 0 || v[3] == 0 || c.state.transform.Det() == 0 {
+		return nil, nil
+	}
+	if c.coversCanvas(v[0], v[1], v[2], v[3]) {
+		c.commands = nil
+		return nil, nil
+	}
+	area := c.rectPath(v[0], v[1], v[2], v[3])
+	commands := c.commands[:0]
+	for _, command := range c.commands {
+		command.path = command.path.Flatten(canvasTolerance).Not(area)
+		if !command.path.Empty() {
+			commands = append(commands, command)
+		}
+	}
+	c.commands = commands
+	return nil, nil
+}
+
+// coversCanvas returns true if the rectangle in the current coordinate system contains the whole canvas.
+func (c *CanvasContext) coversCanvas(x, y, w, h float64) bool {
+	x1, x2 := math.Min(x, x+w), math.Max(x, x+w)
+	y1, y2 := math.Min(y, y+h), math.Max(y, y+h)
+	inverse := c.state.transform.Inv()
+	for _, corner := range []canvas.Point{{X: 0, Y: 0}, {X: c.width, Y: 0}, {X: c.width, Y: c.height}, {X: 0, Y: c.height}} {
+		p := inverse.Dot(corner)
+		if p.X < x1-canvas.Epsilon || p.X > x2+canvas.Epsilon || p.Y < y1-canvas.Epsilon || p.Y > y2+canvas.Epsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// strokePath records the outline of a stroke along the path, which is in the coordinate system of the canvas.
+// The stroke is created in the current coordinate system so that transformations also apply to the line width and dashes.
+func (c *CanvasContext) strokePath(path *canvas.Path) {
+	m := c.state.transform
+	if path.Empty() || m.Det() == 0 {
+		return
+	}
+	path = path.Transform(m.Inv())
+	if dashes := c.state.lineDash; len(dashes) > 0 && !allZero(dashes) {
+		path = path.Dash(c.state.lineDashOffset, dashes...)
+	}
+	outline := path.Stroke(c.state.lineWidth, c.capper(), c.joiner(), canvas.Tolerance)
+	c.fillPath(outline.Transform(m), c.state.strokeStyle)
+}
+
+func allZero(values []float64) bool {
+	for _, v := range values {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *CanvasContext) capper() canvas.Capper {
+	switch c.state.lineCap {
+	case "round":
+		return canvas.RoundCap
+	case "square":
+		return canvas.SquareCap
+	}
+	return canvas.ButtCap
+}
+
+func (c *CanvasContext) joiner() canvas.Joiner {
+	switch c.state.lineJoin {
+	case "round":
+		return canvas.RoundJoin
+	case "bevel":
+		return canvas.BevelJoin
+	}
+	// HTML defines the miter limit relative to the line width while canvas expects it relative to half of the line width
+	return canvas.MiterJoiner{GapJoiner: canvas.BevelJoin, Limit: c.state.miterLimit / 2}
+}
+
+// fillPath records the path, which is in the coordinate system of the canvas, filled with the style.
+// Parts that lie outside of the canvas are removed.
+func (c *CanvasContext) fillPath(path *canvas.Path, style canvasStyle) {
+	if path.Empty() || c.state.globalAlpha == 0 {
+		return
+	}
+	if b := path.Bounds(); b.X < 0 || b.Y < 0 || b.X+b.W > c.width || b.Y+b.H > c.height {
+		path = path.Flatten(canvasTolerance).And(canvas.Rectangle(c.width, c.height))
+		if path.Empty() {
+			return
+		}
+	}
+	if style.gradient != nil {
+		c.commands = append(c.commands, style.gradient.pieces(path.Flatten(canvasTolerance), c.state.transform, c.state.globalAlpha)...)
+		return
+	}
+	col := withAlpha(style.color, c.state.globalAlpha)
+	if col.A == 0 {
+		return
+	}
+	c.commands = append(c.commands, gradientPiece{path: path, color: col})
+}
+
+// withAlpha multiplies the premultiplied color with the alpha value.
+func withAlpha(col color.RGBA, alpha float64) color.RGBA {
+	if alpha == 1 {
+		return col
+	}
+	return color.RGBA{
+		R: uint8(math.Round(float64(col.R) * alpha)),
+		G: uint8(math.Round(float64(col.G) * alpha)),
+		B: uint8(math.Round(float64(col.B) * alpha)),
+		A: uint8(math.Round(float64(col.A) * alpha)),
+	}
+}
+
+// text
+
+func (c *CanvasContext) fillText(args ...interface{}) (interface{}, error) {
+	if path, ok := c.textPath(args); ok {
+		c.fillPath(path, c.state.fillStyle)
+	}
+	return nil, nil
+}
+
+func (c *CanvasContext) strokeText(args ...interface{}) (interface{}, error) {
+	if path, ok := c.textPath(args); ok {
+		c.strokePath(path)
+	}
+	return nil, nil
+}
+
+// textPath returns the outline of the text in the coordinate system of the canvas.
+// The arguments are the text, the position of the text and optionally the maximum width the text is condensed to.
+func (c *CanvasContext) textPath(args []interface{}) (*canvas.Path, bool) {
+	if len(args) < 3 {
+		return nil, false
+	}
+	v, ok := numbers(args[1:], 2)
+	if !ok {
+		return nil, false
+	}
+	x, y := v[0], v[1]
+	face, err := c.face()
+	if err != nil {
+		return nil, false
+	}
+	glyphs, width, err := face.ToPath(fmt.Sprint(args[0]))
+	if err != nil || glyphs.Empty() {
+		return nil, false
+	}
+
+	var dx, dy float64
+	switch c.state.textAlign {
+	case "center":
+		dx = -width / 2
+	case "right", "end":
+		dx = -width
+	}
+	metrics := face.Metrics()
+	switch c.state.textBaseline {
+	case "top", "hanging":
+		dy = metrics.Ascent
+	case "middle":
+		dy = (metrics.Ascent - metrics.Descent) / 2
+	case "bottom", "ideographic":
+		dy = -metrics.Descent
+	}
+	scaleX := 1.0
+	if maxWidth, ok := toNumber(optionalArgument(args, 3)); ok {
+		if maxWidth <= 0 {
+			return nil, false
+		}
+		if width > maxWidth {
+			scaleX = maxWidth / width
+		}
+	}
+	// glyphs are created with the y axis pointing up
+	m := c.state.transform.Translate(x, y).Scale(scaleX, 1).Translate(dx, dy).ReflectY()
+	return glyphs.Transform(m), true
+}
+
+func (c *CanvasContext) measureText(args ...interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, errors.New("measureText: missing text")
+	}
+	face, err := c.face()
+	if err != nil {
+		return nil, err
+	}
+	metrics := face.Metrics()
+	return map[string]interface{}{
+		"width":                  face.TextWidth(fmt.Sprint(args[0])),
+		"fontBoundingBoxAscent":  metrics.Ascent,
+		"fontBoundingBoxDescent": metrics.Descent,
+	}, nil
+}
+
+// face returns the face of the current font.
+func (c *CanvasContext) face() (*canvas.FontFace, error) {
+	if c.fontFace != nil && c.font == c.state.font {
+		return c.fontFace, nil
+	}
+	family, style, err := parseCanvasFont(c.state.font)
+	if err != nil {
+		return nil, err
+	}
+	face, err := vfont.LoadFontFace(family, style)
+	if err != nil {
+		return nil, err
+	}
+	c.font, c.fontFace = c.state.font, face
+	return face, nil
+}
+
+// parseCanvasFont parses a font in the CSS shorthand syntax, for example "italic bold 16px Helvetica, sans-serif".
+// Only the first family is used. Sizes in px are relative to the coordinate system of the canvas, like all other lengths.
+func parseCanvasFont(font string) (string, vfont.Style, error) {
+	style := vfont.Style{Color: color.Black, Weight: vfont.Normal}
+	fields := strings.Fields(font)
+	for i, field := range fields {
+		switch field {
+		case "normal", "small-caps":
+			continue
+		case "italic", "oblique":
+			style.Italic = true
+			continue
+		case "bold", "bolder":
+			style.Weight = vfont.Bold
+			continue
+		case "lighter":
+			style.Weight = vfont.Light
+			continue
+		}
+		if weight, err := strconv.Atoi(field); err == nil && weight >= 1 && weight <= 1000 {
+			style.Weight = vfont.Weight(math.Round(float64(weight)/100) * 100)
+			continue
+		}
+
+		size, err := parseFontSize(field)
+		if err != nil {
+			return "", style, fmt.Errorf("invalid font %q: %w", font, err)
+		}
+		style.PointSize = size
+		families := strings.Join(fields[i+1:], " ")
+		family := strings.Trim(strings.TrimSpace(strings.Split(families, ",")[0]), `"'`)
+		if family == "" {
+			return "", style, fmt.Errorf("invalid font %q: missing family", font)
+		}
+		return family, style, nil
+	}
+	return "", style, fmt.Errorf("invalid font %q: missing size", font)
+}
+
+// parseFontSize parses a size in px or pt and returns it in points. A line height after a slash is ignored.
+func parseFontSize(size string) (float64, error) {
+	size, _, _ = strings.Cut(size, "/")
+	var unit float64
+	switch {
+	case strings.HasSuffix(size, "px"):
+		// one unit in the coordinate system is a millimeter for the renderer
+		unit = 72 / 25.4
+	case strings.HasSuffix(size, "pt"):
+		unit = 1
+	default:
+		return 0, fmt.Errorf("unsupported size %q", size)
+	}
+	value, err := strconv.ParseFloat(size[:len(size)-2], 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid size %q", size)
+	}
+	return value * unit, nil
+}
+
+// gradients
+
+type canvasGradientType int
+
+const (
+	linearCanvasGradient canvasGradientType = iota
+	radialCanvasGradient
+	conicCanvasGradient
+)
+
+// CanvasGradient is a gradient that has been created by the drawing context of a Canvas. Colors are added with addColorStop.
+type CanvasGradient struct {
+	kind canvasGradientType
+	// the start and end point of linear gradients, the inner and outer circle of radial gradients
+	// and for conic gradients the start angle in r0 and the center in x0 and y0
+	x0, y0, r0 float64
+	x1, y1, r1 float64
+	stops      canvas.Stops
+}
+
+func (c *CanvasContext) createLinearGradient(args ...interface{}) (interface{}, error) {
+	v, ok := numbers(args, 4)
+	if !ok {
+		return nil, errors.New("createLinearGradient: expected 4 numbers")
+	}
+	return &CanvasGradient{kind: linearCanvasGradient, x0: v[0], y0: v[1], x1: v[2], y1: v[3]}, nil
+}
+
+// createRadialGradient creates a gradient between two circles.
+// It is approximated by a gradient around the center of the outer circle that starts at the radius of the inner circle.
+func (c *CanvasContext) createRadialGradient(args ...interface{}) (interface{}, error) {
+	v, ok := numbers(args, 6)
+	if !ok {
+		return nil, errors.New("createRadialGradient: expected 6 numbers")
+	}
+	if v[2] < 0 || v[5] < 0 {
+		return nil, errors.New("IndexSizeError: the radius is negative")
+	}
+	return &CanvasGradient{kind: radialCanvasGradient, x0: v[0], y0: v[1], r0: v[2], x1: v[3], y1: v[4], r1: v[5]}, nil
+}
+
+// createConicGradient creates a gradient around a center that starts at the given angle in radians.
+func (c *CanvasContext) createConicGradient(args ...interface{}) (interface{}, error) {
+	v, ok := numbers(args, 3)
+	if !ok {
+		return nil, errors.New("createConicGradient: expected 3 numbers")
+	}
+	return &CanvasGradient{kind: conicCanvasGradient, r0: v[0], x0: v[1], y0: v[2]}, nil
+}
+
+func (g *CanvasGradient) ResolveVariable(key string) (interface{}, bool) {
+	if key == "addColorStop" {
+		return script.Function(g.addColorStop), true
+	}
+	return nil, false
+}
+
+// addColorStop adds a color at an offset between 0 and 1. Stops at the same offset are kept in the order they have been added.
+func (g *CanvasGradient) addColorStop(args ...interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, errors.New("addColorStop: expected an offset and a color")
+	}
+	offset, ok := toNumber(args[0])
+	if !ok || offset < 0 || offset > 1 {
+		return nil, errors.New("IndexSizeError: the offset is outside of the range 0 to 1")
+	}
+	style, ok := parseCanvasStyle(args[1])
+	if !ok || style.gradient != nil {
+		return nil, fmt.Errorf("SyntaxError: invalid color %v", args[1])
+	}
+	g.stops = append(g.stops, canvas.Stop{Offset: offset, Color: style.color})
+	sort.SliceStable(g.stops, func(i, j int) bool { return g.stops[i].Offset < g.stops[j].Offset })
+	return nil, nil
+}
+
+// pieces splits the flattened path into parts that are filled with the gradient.
+// The path is in the coordinate system of the canvas while the gradient is transformed by m.
+func (g *CanvasGradient) pieces(path *canvas.Path, m canvas.Matrix, alpha float64) []gradientPiece {
+	stops := make(canvas.Stops, len(g.stops))
+	for i, stop := range g.stops {
+		stops[i] = canvas.Stop{Offset: stop.Offset, Color: withAlpha(stop.Color, alpha)}
+	}
+	switch g.kind {
+	case linearCanvasGradient:
+		return linearGradientPieces(path, m.Dot(canvas.Point{X: g.x0, Y: g.y0}), m.Dot(canvas.Point{X: g.x1, Y: g.y1}), stops)
+	case radialCanvasGradient:
+		if g.r1 == 0 {
+			return nil
+		}
+		inner := math.Min(1, g.r0/g.r1)
+		for i := range stops {
+			stops[i].Offset = inner + stops[i].Offset*(1-inner)
+		}
+		r := g.r1 * math.Sqrt(math.Abs(m.Det()))
+		return radialGradientPieces(path, m.Dot(canvas.Point{X: g.x1, Y: g.y1}), r, r, stops)
+	case conicCanvasGradient:
+		angle := g.r0 + math.Atan2(m[1][0], m[0][0])
+		return conicalGradientPieces(path, m.Dot(canvas.Point{X: g.x0, Y: g.y0}), angle, stops)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	script "github.com/omniskop/vitrum/vit/script"
+)
+
+func newFileContextForCanvas(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type Canvas struct {
+	*Item
+	id string
+
+	context *CanvasContext
+	painter canvasPainter
+
+	onPaint vit.EventAttribute[CanvasContext]
+}
+
+// newCanvasInGlobal creates an appropriate file context for the component and then returns a new Canvas instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newCanvasInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*Canvas, error) {
+	fileCtx, err := newFileContextForCanvas(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewCanvas(id, fileCtx), nil
+}
+func NewCanvas(id string, context *vit.FileContext) *Canvas {
+	c := &Canvas{
+		Item:    NewItem("", context),
+		id:      id,
+		context: newCanvasContext(),
+		painter: canvasPainter{},
+		onPaint: *vit.NewEventAttribute[CanvasContext](),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	c.Item.AddBoundsDependency(vit.FuncDep(c.boundsChanged))
+	// register event listeners
+	var event vit.Listenable
+	var listener vit.Evaluater
+	event, _ = c.Root.Event("onCompleted")
+	listener = event.CreateListener(vit.Code{FileCtx: context, Code: "function() {}", Position: nil})
+	c.AddListenerFunction(listener)
+	event.(*vit.EventAttribute[struct{}]).AddListener(vit.ListenerCB[struct{}](c.wasCompleted))
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", c)
+
+	return c
+}
+
+func (c *Canvas) String() string {
+	return fmt.Sprintf("Canvas(%s)", c.id)
+}
+
+func (c *Canvas) Property(key string) (vit.Value, bool) {
+	switch key {
+	default:
+		return c.Item.Property(key)
+	}
+}
+
+func (c *Canvas) MustProperty(key string) vit.Value {
+	v, ok := c.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (c *Canvas) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	default:
+		return c.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("Canvas", key, c.id, err)
+	}
+	return nil
+}
+
+func (c *Canvas) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	default:
+		return c.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (c *Canvas) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	case "onPaint":
+		return &c.onPaint, true
+	default:
+		return c.Item.Event(name)
+	}
+}
+
+func (c *Canvas) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "getContext":
+		return script.Function(c.getContext), true
+	case "requestPaint":
+		return script.Function(c.requestPaint), true
+	case "onPaint":
+		return &c.onPaint, true
+	default:
+		return c.Item.ResolveVariable(key)
+	}
+}
+
+func (c *Canvas) AddChild(child vit.Component) {
+	child.SetParent(c)
+	c.AddChildButKeepParent(child)
+}
+
+func (c *Canvas) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range c.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(c)
+			c.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	c.AddChild(addThis)
+}
+
+func (c *Canvas) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = c
+	}
+	// properties
+
+	// methods
+
+	n, err := c.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (c *Canvas) As(target *vit.Component) bool {
+	if _, ok := (*target).(*Canvas); ok {
+		*target = c
+		return true
+	}
+	return c.Item.As(target)
+}
+
+func (c *Canvas) ID() string {
+	return c.id
+}
+
+func (c *Canvas) Finish() error {
+	return c.RootC().FinishInContext(c)
+}
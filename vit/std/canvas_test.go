@@ -0,0 +1,193 @@
+package std
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+const canvasSource = `import Vit 1.0
+
+Item {
+    id: root
+    width: 400
+    height: 100
+    property int level: 50
+
+    Canvas {
+        id: chart
+        anchors.fill: parent
+
+        onPaint: function(ctx) {
+            ctx.clearRect(0, 0, width, height)
+
+            ctx.fillStyle = "red"
+            ctx.fillRect(10, 10, 80, 80)
+            ctx.clearRect(20, 20, 10, 10)
+
+            ctx.fillStyle = "black"
+            ctx.fillRect(0, 100 - root.level, 5, root.level)
+
+            ctx.save()
+            ctx.translate(150, 50)
+            ctx.rotate(Math.PI / 4)
+            ctx.fillStyle = "blue"
+            ctx.fillRect(-20, -20, 40, 40)
+            ctx.restore()
+
+            ctx.beginPath()
+            ctx.arc(250, 50, 30, 0, 2 * Math.PI)
+            ctx.fillStyle = "#00ff00"
+            ctx.fill()
+
+            var gradient = ctx.createLinearGradient(310, 0, 390, 0)
+            gradient.addColorStop(0, "black")
+            gradient.addColorStop(1, "white")
+            ctx.fillStyle = gradient
+            ctx.fillRect(310, 10, 80, 80)
+        }
+    }
+
+    MouseArea {
+        anchors.fill: parent
+        onClicked: function() {
+            root.level = 90
+            chart.requestPaint()
+        }
+    }
+}
+`
+
+func TestCanvas(t *testing.T) {
+	manager, handler := loadTestComponent(t, canvasSource)
+
+	draw := func() *image.RGBA {
+		return renderComponent(t, manager.MainComponent(), 400, 100)
+	}
+
+	var (
+		transparent = color.RGBA{0, 0, 0, 0}
+		black       = color.RGBA{0, 0, 0, 255}
+		white       = color.RGBA{255, 255, 255, 255}
+		red         = color.RGBA{255, 0, 0, 255}
+		green       = color.RGBA{0, 255, 0, 255}
+		blue        = color.RGBA{0, 0, 255, 255}
+	)
+	img := draw()
+	checkPixels(t, img, []pixelTest{
+		// rectangle with a cleared hole
+		{50, 50, red},
+		{25, 25, transparent},
+		// bar that depends on a property
+		{2, 60, black},
+		{2, 30, transparent},
+		// rotated square
+		{150, 25, blue},
+		{131, 31, transparent},
+		// circle
+		{250, 50, green},
+		{226, 26, transparent},
+		// gradient
+		{311, 50, black},
+		{389, 50, white},
+	}, 12)
+	if middle := img.RGBAAt(350, 50); middle.R < 80 || middle.R > 200 {
+		t.Errorf("expected the middle of the gradient to be gray, got %v", middle)
+	}
+
+	// backends start a new frame after drawing
+	handler.NextRedraw()
+
+	// the content is only painted again after requestPaint has been called
+	handler.TriggerMouseEvent(MouseEvent{X: 10, Y: 10, Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: 10, Y: 10})
+	img = draw()
+	if actual := img.RGBAAt(2, 30); actual != transparent {
+		t.Errorf("expected drawing to only show the previous content, got %v", actual)
+	}
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(errs)
+	}
+	if _, ok := handler.NextRedraw(); !ok {
+		t.Errorf("requestPaint didn't schedule a redraw")
+	}
+	// onPaint is handled during the update so the new content is shown in the same frame
+	img = draw()
+	if actual := img.RGBAAt(2, 30); actual != black {
+		t.Errorf("expected the canvas to be painted again, got %v", actual)
+	}
+	// painting again doesn't add to the content that has been painted before
+	if count := len(manager.MainComponent().Children()[0].(*Canvas).context.commands); count != 5 {
+		t.Errorf("expected 5 painted paths, got %d", count)
+	}
+}
+
+func TestCanvasRepaintFromOnPaint(t *testing.T) {
+	manager, handler := loadTestComponent(t, `import Vit 1.0
+
+Item {
+    id: root
+    property int paints: 0
+
+    Canvas {
+        width: 10
+        height: 10
+
+        onPaint: function(ctx) {
+            root.paints++
+            ctx.fillRect(0, 0, 10, 10)
+            requestPaint()
+        }
+    }
+}
+`)
+	root := manager.MainComponent()
+	canvas := root.Children()[0].(*Canvas)
+	for frame := 1; frame <= 3; frame++ {
+		renderComponent(t, root, 10, 10)
+		if _, ok := handler.NextRedraw(); !ok {
+			t.Fatalf("frame %d: no redraw has been scheduled", frame)
+		}
+		if errs := manager.UpdateFully(); errs.Failed() {
+			t.Fatal(errs)
+		}
+		if paints := root.MustProperty("paints").(*vit.IntValue).Int(); paints != frame+1 {
+			t.Errorf("frame %d: expected %d paints, got %d", frame, frame+1, paints)
+		}
+		if count := len(canvas.context.commands); count != 1 {
+			t.Errorf("frame %d: expected 1 painted path, got %d", frame, count)
+		}
+	}
+}
+
+func TestParseCanvasFont(t *testing.T) {
+	tests := []struct {
+		font   string
+		family string
+		italic bool
+		bold   bool
+		size   float64
+		valid  bool
+	}{
+		{"10px sans-serif", "sans-serif", false, false, 10 * 72 / 25.4, true},
+		{"italic bold 12pt \"DejaVu Sans\", serif", "DejaVu Sans", true, true, 12, true},
+		{"700 16px/20px Arial", "Arial", false, true, 16 * 72 / 25.4, true},
+		{"bold Arial", "", false, false, 0, false},
+		{"12px", "", false, false, 0, false},
+	}
+	for _, test := range tests {
+		family, style, err := parseCanvasFont(test.font)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: unexpected error %v", test.font, err)
+			continue
+		}
+		if !test.valid {
+			continue
+		}
+		if family != test.family || style.Italic != test.italic || (style.Weight == 700) != test.bold || style.PointSize != test.size {
+			t.Errorf("%q: got family %q and style %+v", test.font, family, style)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package std
+
+import "testing"
+
+const clipboardSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 400
+    property string pasted: Clipboard.text
+
+    MouseArea {
+        anchors.fill: parent
+        onClicked: function() {
+            Clipboard.text = "copied"
+        }
+    }
+}
+`
+
+func TestClipboard(t *testing.T) {
+	manager, handler := loadTestComponent(t, clipboardSource)
+	root := manager.MainComponent()
+
+	// expressions are reevaluated when the clipboard changes
+	handler.Clipboard().SetText("hello")
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(errs)
+	}
+	if pasted := root.MustProperty("pasted").GetValue(); pasted != "hello" {
+		t.Errorf("expected pasted text to be %q, got %q", "hello", pasted)
+	}
+
+	// scripts can write to the clipboard
+	var notified string
+	handler.Clipboard().AddChangeListener(func(text string) { notified = text })
+	handler.TriggerMouseEvent(MouseEvent{X: 10, Y: 10, Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: 10, Y: 10})
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(errs)
+	}
+	if text := handler.Clipboard().Text(); text != "copied" {
+		t.Errorf("expected clipboard to contain %q, got %q", "copied", text)
+	}
+	if notified != "copied" {
+		t.Errorf("change listener has not been notified")
+	}
+	if pasted := root.MustProperty("pasted").GetValue(); pasted != "copied" {
+		t.Errorf("expected pasted text to be %q, got %q", "copied", pasted)
+	}
+}
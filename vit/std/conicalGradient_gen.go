@@ -0,0 +1,197 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForConicalGradient(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type ConicalGradient struct {
+	*Item
+	id string
+
+	centerX vit.FloatValue
+	centerY vit.FloatValue
+	angle   vit.FloatValue
+}
+
+// newConicalGradientInGlobal creates an appropriate file context for the component and then returns a new ConicalGradient instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newConicalGradientInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*ConicalGradient, error) {
+	fileCtx, err := newFileContextForConicalGradient(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewConicalGradient(id, fileCtx), nil
+}
+func NewConicalGradient(id string, context *vit.FileContext) *ConicalGradient {
+	c := &ConicalGradient{
+		Item:    NewItem("", context),
+		id:      id,
+		centerX: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0.5", Position: nil}),
+		centerY: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0.5", Position: nil}),
+		angle:   *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", c)
+
+	return c
+}
+
+func (c *ConicalGradient) String() string {
+	return fmt.Sprintf("ConicalGradient(%s)", c.id)
+}
+
+func (c *ConicalGradient) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "centerX":
+		return &c.centerX, true
+	case "centerY":
+		return &c.centerY, true
+	case "angle":
+		return &c.angle, true
+	default:
+		return c.Item.Property(key)
+	}
+}
+
+func (c *ConicalGradient) MustProperty(key string) vit.Value {
+	v, ok := c.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (c *ConicalGradient) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "centerX":
+		err = c.centerX.SetValue(value)
+	case "centerY":
+		err = c.centerY.SetValue(value)
+	case "angle":
+		err = c.angle.SetValue(value)
+	default:
+		return c.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("ConicalGradient", key, c.id, err)
+	}
+	return nil
+}
+
+func (c *ConicalGradient) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "centerX":
+		c.centerX.SetCode(code)
+	case "centerY":
+		c.centerY.SetCode(code)
+	case "angle":
+		c.angle.SetCode(code)
+	default:
+		return c.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (c *ConicalGradient) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return c.Item.Event(name)
+	}
+}
+
+func (c *ConicalGradient) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "centerX":
+		return &c.centerX, true
+	case "centerY":
+		return &c.centerY, true
+	case "angle":
+		return &c.angle, true
+	default:
+		return c.Item.ResolveVariable(key)
+	}
+}
+
+func (c *ConicalGradient) AddChild(child vit.Component) {
+	child.SetParent(c)
+	c.AddChildButKeepParent(child)
+}
+
+func (c *ConicalGradient) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range c.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(c)
+			c.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	c.AddChild(addThis)
+}
+
+func (c *ConicalGradient) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = c
+	}
+	// properties
+	if changed, err := c.centerX.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("ConicalGradient", "centerX", c.id, err))
+		}
+	}
+	if changed, err := c.centerY.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("ConicalGradient", "centerY", c.id, err))
+		}
+	}
+	if changed, err := c.angle.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("ConicalGradient", "angle", c.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := c.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (c *ConicalGradient) As(target *vit.Component) bool {
+	if _, ok := (*target).(*ConicalGradient); ok {
+		*target = c
+		return true
+	}
+	return c.Item.As(target)
+}
+
+func (c *ConicalGradient) ID() string {
+	return c.id
+}
+
+func (c *ConicalGradient) Finish() error {
+	return c.RootC().FinishInContext(c)
+}
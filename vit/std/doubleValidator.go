@@ -0,0 +1,56 @@
+package std
+
+import (
+	"strconv"
+	"strings"
+)
+
+func (v *DoubleValidator) onRulesChanged(changed func()) {
+	v.rules.changed = changed
+}
+
+func (v *DoubleValidator) rulesChanged() {
+	v.rules.notify()
+}
+
+// Validate accepts floating point numbers between bottom and top with at most the configured number of decimals.
+func (v *DoubleValidator) Validate(text string) ValidationState {
+	bottom, top := v.bottom.Float64(), v.top.Float64()
+	number, negative := splitSign(text)
+	if number == "" {
+		if negative && bottom >= 0 || text != "" && !negative && top < 0 {
+			return InvalidInput
+		}
+		return IntermediateInput
+	}
+
+	mantissa, exponent, scientific := strings.Cut(number, "e")
+	if !scientific {
+		mantissa, exponent, scientific = strings.Cut(number, "E")
+	}
+	if scientific && DoubleValidator_Notation(v.notation.Int()) != DoubleValidator_Notation_ScientificNotation {
+		return InvalidInput
+	}
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	exponent, _ = splitSign(exponent)
+	if strings.IndexFunc(integer, isNotDigit) >= 0 || strings.IndexFunc(fraction, isNotDigit) >= 0 || strings.IndexFunc(exponent, isNotDigit) >= 0 {
+		return InvalidInput
+	}
+	if len(fraction) > v.decimals.Int() {
+		return InvalidInput
+	}
+
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		// something like "1." or "1e" that is still missing digits
+		return IntermediateInput
+	}
+	if value >= bottom && value <= top {
+		return AcceptableInput
+	}
+	if scientific {
+		// the exponent can still change the magnitude arbitrarily
+		return IntermediateInput
+	}
+	return numberRangeState(negative, len(strings.TrimLeft(integer, "0")), bottom, top)
+}
@@ -0,0 +1,254 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForDoubleValidator(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type DoubleValidator_Notation uint
+
+const (
+	DoubleValidator_Notation_StandardNotation   DoubleValidator_Notation = 0
+	DoubleValidator_Notation_ScientificNotation DoubleValidator_Notation = 1
+)
+
+func (enum DoubleValidator_Notation) String() string {
+	switch enum {
+	case DoubleValidator_Notation_StandardNotation:
+		return "StandardNotation"
+	case DoubleValidator_Notation_ScientificNotation:
+		return "ScientificNotation"
+	default:
+		return "<unknownNotation>"
+	}
+}
+
+type DoubleValidator struct {
+	*Item
+	id string
+
+	bottom   vit.FloatValue
+	top      vit.FloatValue
+	decimals vit.IntValue
+	notation vit.IntValue
+	rules    rulesListener
+}
+
+// newDoubleValidatorInGlobal creates an appropriate file context for the component and then returns a new DoubleValidator instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newDoubleValidatorInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*DoubleValidator, error) {
+	fileCtx, err := newFileContextForDoubleValidator(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewDoubleValidator(id, fileCtx), nil
+}
+func NewDoubleValidator(id string, context *vit.FileContext) *DoubleValidator {
+	d := &DoubleValidator{
+		Item:     NewItem("", context),
+		id:       id,
+		bottom:   *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "-Infinity", Position: nil}),
+		top:      *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "Infinity", Position: nil}),
+		decimals: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "1000", Position: nil}),
+		notation: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Notation.ScientificNotation", Position: nil}),
+		rules:    rulesListener{},
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	d.bottom.AddDependent(vit.FuncDep(d.rulesChanged))
+	d.top.AddDependent(vit.FuncDep(d.rulesChanged))
+	d.decimals.AddDependent(vit.FuncDep(d.rulesChanged))
+	d.notation.AddDependent(vit.FuncDep(d.rulesChanged))
+	// register event listeners
+	// register enumerations
+	d.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "Notation",
+		Position: nil,
+		Values:   map[string]int{"StandardNotation": 0, "ScientificNotation": 1},
+	})
+	// add child components
+
+	context.RegisterComponent("", d)
+
+	return d
+}
+
+func (d *DoubleValidator) String() string {
+	return fmt.Sprintf("DoubleValidator(%s)", d.id)
+}
+
+func (d *DoubleValidator) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "bottom":
+		return &d.bottom, true
+	case "top":
+		return &d.top, true
+	case "decimals":
+		return &d.decimals, true
+	case "notation":
+		return &d.notation, true
+	default:
+		return d.Item.Property(key)
+	}
+}
+
+func (d *DoubleValidator) MustProperty(key string) vit.Value {
+	v, ok := d.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (d *DoubleValidator) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "bottom":
+		err = d.bottom.SetValue(value)
+	case "top":
+		err = d.top.SetValue(value)
+	case "decimals":
+		err = d.decimals.SetValue(value)
+	case "notation":
+		err = d.notation.SetValue(value)
+	default:
+		return d.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("DoubleValidator", key, d.id, err)
+	}
+	return nil
+}
+
+func (d *DoubleValidator) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "bottom":
+		d.bottom.SetCode(code)
+	case "top":
+		d.top.SetCode(code)
+	case "decimals":
+		d.decimals.SetCode(code)
+	case "notation":
+		d.notation.SetCode(code)
+	default:
+		return d.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (d *DoubleValidator) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return d.Item.Event(name)
+	}
+}
+
+func (d *DoubleValidator) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "bottom":
+		return &d.bottom, true
+	case "top":
+		return &d.top, true
+	case "decimals":
+		return &d.decimals, true
+	case "notation":
+		return &d.notation, true
+	default:
+		return d.Item.ResolveVariable(key)
+	}
+}
+
+func (d *DoubleValidator) AddChild(child vit.Component) {
+	child.SetParent(d)
+	d.AddChildButKeepParent(child)
+}
+
+func (d *DoubleValidator) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range d.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(d)
+			d.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	d.AddChild(addThis)
+}
+
+func (d *DoubleValidator) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = d
+	}
+	// properties
+	if changed, err := d.bottom.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DoubleValidator", "bottom", d.id, err))
+		}
+	}
+	if changed, err := d.top.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DoubleValidator", "top", d.id, err))
+		}
+	}
+	if changed, err := d.decimals.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DoubleValidator", "decimals", d.id, err))
+		}
+	}
+	if changed, err := d.notation.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DoubleValidator", "notation", d.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := d.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (d *DoubleValidator) As(target *vit.Component) bool {
+	if _, ok := (*target).(*DoubleValidator); ok {
+		*target = d
+		return true
+	}
+	return d.Item.As(target)
+}
+
+func (d *DoubleValidator) ID() string {
+	return d.id
+}
+
+func (d *DoubleValidator) Finish() error {
+	return d.RootC().FinishInContext(d)
+}
+
+func (d *DoubleValidator) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "StandardNotation":
+		return uint(DoubleValidator_Notation_StandardNotation), true
+	case "ScientificNotation":
+		return uint(DoubleValidator_Notation_ScientificNotation), true
+	default:
+		return nil, false
+	}
+}
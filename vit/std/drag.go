@@ -0,0 +1,78 @@
+package std
+
+import (
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+// DragEvent describes a drag operation in relation to a DropArea.
+type DragEvent struct {
+	X, Y     float64                // position of the hot spot of the drag
+	Keys     []string               // keys that have been set on the drag
+	MimeData map[string]interface{} // data that is carried by the drag
+	Source   vit.Component          // the component that is being dragged
+}
+
+// attachedDrag returns the Drag that is attached to the given component or nil if there is none.
+func attachedDrag(comp vit.Component) *Drag {
+	if comp == nil {
+		return nil
+	}
+	for _, child := range comp.Children() {
+		if d, ok := child.(*Drag); ok {
+			return d
+		}
+	}
+	return nil
+}
+
+func (d *Drag) activeChanged() {
+	if d.handler != nil {
+		d.handler.dragActiveChanged(d)
+	}
+}
+
+// dragSource returns the component that is being dragged.
+// If no source is set explicitly the component the drag is attached to will be used.
+func (d *Drag) dragSource() vit.Component {
+	if source := d.source.Component(); source != nil {
+		return source
+	}
+	return d.Parent()
+}
+
+// event creates a DragEvent that describes the current state of the drag.
+func (d *Drag) event() DragEvent {
+	var x, y float64
+	if source := d.dragSource(); source != nil {
+		bounds := source.Bounds()
+		x, y = bounds.X1, bounds.Y1
+	}
+	mimeData, _ := d.mimeData.GetValue().(map[string]interface{})
+	return DragEvent{
+		X:        x + d.hotSpotX.Float64(),
+		Y:        y + d.hotSpotY.Float64(),
+		Keys:     stringList(d.keys.GetValue()),
+		MimeData: mimeData,
+		Source:   d.dragSource(),
+	}
+}
+
+// stringList converts a list of arbitrary values into a list of strings, skipping all values that aren't strings.
+// A single string will be converted into a list with one element.
+func stringList(value interface{}) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []interface{}:
+		var out []string
+		for _, v := range value {
+			if str, ok := v.(string); ok {
+				out = append(out, str)
+			}
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package std
+
+import (
+	"log"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/parse"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+const dragDropSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 400
+
+    Rectangle {
+        id: card
+        x: 10
+        y: 10
+        width: 50
+        height: 50
+
+        Drag {
+            keys: ["card"]
+            hotSpotX: 25
+            hotSpotY: 25
+        }
+
+        MouseArea {
+            anchors.fill: parent
+            drag.target: card
+            drag.axis: Drag.XAndYAxis
+            drag.maximumX: 250
+        }
+    }
+
+    DropArea {
+        x: 200
+        y: 200
+        width: 100
+        height: 100
+        keys: ["card"]
+    }
+
+    DropArea {
+        x: 0
+        y: 200
+        width: 100
+        height: 100
+        keys: ["other"]
+    }
+}
+`
+
+// loadTestComponent instantiates the given vit source using an InputHandler as the execution environment.
+func loadTestComponent(t *testing.T, source string) (*parse.Manager, *InputHandler) {
+	t.Helper()
+	return loadTestFiles(t, fstest.MapFS{"Main.vit": {Data: []byte(source)}})
+}
+
+// loadTestFiles is like loadTestComponent but allows additional files next to Main.vit.
+func loadTestFiles(t *testing.T, files fstest.MapFS) (*parse.Manager, *InputHandler) {
+	t.Helper()
+	manager := parse.NewManager()
+	// a pointer is used because positions compare file paths and maps aren't comparable
+	err := manager.SetSource(vpath.FS(&files, "Main.vit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := NewInputHandler(log.New(os.Stderr, "", 0))
+	err = manager.Initialize(handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(parse.FormatError(errs))
+	}
+	return manager, handler
+}
+
+// findComponents returns all components in the tree that can be converted to T.
+func findComponents[T vit.Component](comp vit.Component) []T {
+	var found []T
+	if c, ok := comp.(T); ok {
+		found = append(found, c)
+	}
+	for _, child := range comp.Children() {
+		found = append(found, findComponents[T](child)...)
+	}
+	return found
+}
+
+func TestDragAndDrop(t *testing.T) {
+	manager, handler := loadTestComponent(t, dragDropSource)
+	root := manager.MainComponent()
+
+	mouseArea := findComponents[*MouseArea](root)[0]
+	drag := findComponents[*Drag](root)[0]
+	dropAreas := findComponents[*DropArea](root)
+	target, other := dropAreas[0], dropAreas[1]
+
+	var entered, dropped int
+	var droppedEvent DragEvent
+	target.onEntered.AddListener(vit.ListenerCB(func(e *DragEvent) { entered++ }))
+	target.onDropped.AddListener(vit.ListenerCB(func(e *DragEvent) {
+		dropped++
+		droppedEvent = *e
+	}))
+	other.onEntered.AddListener(vit.ListenerCB(func(e *DragEvent) {
+		t.Errorf("drop area with non matching keys has been entered")
+	}))
+
+	var clicked bool
+	mouseArea.onClicked.AddListener(vit.ListenerCB(func(e *MouseEvent) { clicked = true }))
+
+	// a move below the threshold doesn't start a drag
+	handler.TriggerMouseEvent(MouseEvent{X: 20, Y: 20, Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: 22, Y: 21, Buttons: MouseArea_MouseButtons_leftButton})
+	if mouseArea.drag.MustGet("active").(*vit.BoolValue).Bool() || drag.active.Bool() {
+		t.Fatalf("drag became active below the threshold")
+	}
+
+	// moving over the drop area
+	handler.TriggerMouseEvent(MouseEvent{X: 240, Y: 230, Buttons: MouseArea_MouseButtons_leftButton})
+	if !drag.active.Bool() {
+		t.Fatalf("drag did not become active")
+	}
+	if bounds := drag.Parent().Bounds(); bounds.X1 != 230 || bounds.Y1 != 220 {
+		t.Errorf("expected target to be moved to (230, 220), got (%v, %v)", bounds.X1, bounds.Y1)
+	}
+	if !target.containsDrag.Bool() || entered != 1 {
+		t.Errorf("drop area has not been entered")
+	}
+
+	// the maximum constrains the movement
+	handler.TriggerMouseEvent(MouseEvent{X: 300, Y: 230, Buttons: MouseArea_MouseButtons_leftButton})
+	if bounds := drag.Parent().Bounds(); bounds.X1 != 250 {
+		t.Errorf("expected target to be constrained to x = 250, got %v", bounds.X1)
+	}
+
+	// dropping
+	handler.TriggerMouseEvent(MouseEvent{X: 300, Y: 230})
+	if drag.active.Bool() || mouseArea.drag.MustGet("active").(*vit.BoolValue).Bool() {
+		t.Errorf("drag is still active after release")
+	}
+	if dropped != 1 {
+		t.Fatalf("expected one drop, got %d", dropped)
+	}
+	if droppedEvent.X != 275 || droppedEvent.Y != 245 {
+		t.Errorf("expected drop at (275, 245), got (%v, %v)", droppedEvent.X, droppedEvent.Y)
+	}
+	if len(droppedEvent.Keys) != 1 || droppedEvent.Keys[0] != "card" {
+		t.Errorf("unexpected keys %v", droppedEvent.Keys)
+	}
+	if target.containsDrag.Bool() {
+		t.Errorf("drop area still contains the drag after the drop")
+	}
+	if clicked {
+		t.Errorf("a drag must not emit a click")
+	}
+}
@@ -0,0 +1,304 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForDrag(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type Drag_Axis uint
+
+const (
+	Drag_Axis_XAxis     Drag_Axis = 1
+	Drag_Axis_YAxis     Drag_Axis = 2
+	Drag_Axis_XAndYAxis Drag_Axis = 3
+)
+
+func (enum Drag_Axis) String() string {
+	switch enum {
+	case Drag_Axis_XAxis:
+		return "XAxis"
+	case Drag_Axis_YAxis:
+		return "YAxis"
+	case Drag_Axis_XAndYAxis:
+		return "XAndYAxis"
+	default:
+		return "<unknownAxis>"
+	}
+}
+
+type Drag struct {
+	*Item
+	id string
+
+	active   vit.BoolValue
+	keys     vit.AnyValue
+	mimeData vit.AnyValue
+	hotSpotX vit.FloatValue
+	hotSpotY vit.FloatValue
+	source   vit.ComponentRefValue
+	target   vit.ComponentRefValue
+	handler  *InputHandler
+}
+
+// newDragInGlobal creates an appropriate file context for the component and then returns a new Drag instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newDragInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*Drag, error) {
+	fileCtx, err := newFileContextForDrag(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewDrag(id, fileCtx), nil
+}
+func NewDrag(id string, context *vit.FileContext) *Drag {
+	d := &Drag{
+		Item:     NewItem("", context),
+		id:       id,
+		active:   *vit.NewEmptyBoolValue(),
+		keys:     *vit.NewEmptyAnyValue(),
+		mimeData: *vit.NewEmptyAnyValue(),
+		hotSpotX: *vit.NewEmptyFloatValue(),
+		hotSpotY: *vit.NewEmptyFloatValue(),
+		source:   *vit.NewEmptyComponentRefValue(),
+		target:   *vit.NewEmptyComponentRefValue(),
+		handler:  nil,
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	d.active.AddDependent(vit.FuncDep(d.activeChanged))
+	// register event listeners
+	// register enumerations
+	d.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "Axis",
+		Position: nil,
+		Values:   map[string]int{"XAxis": 1, "YAxis": 2, "XAndYAxis": 3},
+	})
+	// add child components
+
+	context.RegisterComponent("", d)
+
+	return d
+}
+
+func (d *Drag) String() string {
+	return fmt.Sprintf("Drag(%s)", d.id)
+}
+
+func (d *Drag) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "active":
+		return &d.active, true
+	case "keys":
+		return &d.keys, true
+	case "mimeData":
+		return &d.mimeData, true
+	case "hotSpotX":
+		return &d.hotSpotX, true
+	case "hotSpotY":
+		return &d.hotSpotY, true
+	case "source":
+		return &d.source, true
+	case "target":
+		return &d.target, true
+	default:
+		return d.Item.Property(key)
+	}
+}
+
+func (d *Drag) MustProperty(key string) vit.Value {
+	v, ok := d.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (d *Drag) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "active":
+		err = d.active.SetValue(value)
+	case "keys":
+		err = d.keys.SetValue(value)
+	case "mimeData":
+		err = d.mimeData.SetValue(value)
+	case "hotSpotX":
+		err = d.hotSpotX.SetValue(value)
+	case "hotSpotY":
+		err = d.hotSpotY.SetValue(value)
+	case "source":
+		err = d.source.SetValue(value)
+	case "target":
+		err = d.target.SetValue(value)
+	default:
+		return d.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("Drag", key, d.id, err)
+	}
+	return nil
+}
+
+func (d *Drag) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "active":
+		d.active.SetCode(code)
+	case "keys":
+		d.keys.SetCode(code)
+	case "mimeData":
+		d.mimeData.SetCode(code)
+	case "hotSpotX":
+		d.hotSpotX.SetCode(code)
+	case "hotSpotY":
+		d.hotSpotY.SetCode(code)
+	case "source":
+		d.source.SetCode(code)
+	case "target":
+		d.target.SetCode(code)
+	default:
+		return d.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (d *Drag) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return d.Item.Event(name)
+	}
+}
+
+func (d *Drag) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "active":
+		return &d.active, true
+	case "keys":
+		return &d.keys, true
+	case "mimeData":
+		return &d.mimeData, true
+	case "hotSpotX":
+		return &d.hotSpotX, true
+	case "hotSpotY":
+		return &d.hotSpotY, true
+	case "source":
+		return &d.source, true
+	case "target":
+		return &d.target, true
+	default:
+		return d.Item.ResolveVariable(key)
+	}
+}
+
+func (d *Drag) AddChild(child vit.Component) {
+	child.SetParent(d)
+	d.AddChildButKeepParent(child)
+}
+
+func (d *Drag) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range d.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(d)
+			d.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	d.AddChild(addThis)
+}
+
+func (d *Drag) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = d
+	}
+	// properties
+	if changed, err := d.active.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "active", d.id, err))
+		}
+	}
+	if changed, err := d.keys.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "keys", d.id, err))
+		}
+	}
+	if changed, err := d.mimeData.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "mimeData", d.id, err))
+		}
+	}
+	if changed, err := d.hotSpotX.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "hotSpotX", d.id, err))
+		}
+	}
+	if changed, err := d.hotSpotY.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "hotSpotY", d.id, err))
+		}
+	}
+	if changed, err := d.source.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "source", d.id, err))
+		}
+	}
+	if changed, err := d.target.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Drag", "target", d.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := d.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (d *Drag) As(target *vit.Component) bool {
+	if _, ok := (*target).(*Drag); ok {
+		*target = d
+		return true
+	}
+	return d.Item.As(target)
+}
+
+func (d *Drag) ID() string {
+	return d.id
+}
+
+func (d *Drag) Finish() error {
+	return d.RootC().FinishInContext(d)
+}
+
+func (d *Drag) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "XAxis":
+		return uint(Drag_Axis_XAxis), true
+	case "YAxis":
+		return uint(Drag_Axis_YAxis), true
+	case "XAndYAxis":
+		return uint(Drag_Axis_XAndYAxis), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,58 @@
+package std
+
+func (a *DropArea) enableDisable() {
+	if !a.enabled.Bool() {
+		// DropArea was just disabled
+		a.containsDrag.SetBoolValue(false)
+	}
+}
+
+// accepts returns true if a drag with the given keys can be dropped on this area.
+// An area without keys accepts all drags.
+func (a *DropArea) accepts(keys []string) bool {
+	if !a.enabled.Bool() {
+		return false
+	}
+	ownKeys := stringList(a.keys.GetValue())
+	if len(ownKeys) == 0 {
+		return true
+	}
+	for _, own := range ownKeys {
+		for _, key := range keys {
+			if own == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *DropArea) updateDrag(e DragEvent) {
+	a.drag.MustGet("x").SetValue(e.X)
+	a.drag.MustGet("y").SetValue(e.Y)
+	if e.Source != nil {
+		a.drag.MustGet("source").SetValue(e.Source)
+	}
+}
+
+func (a *DropArea) dragEntered(e DragEvent) {
+	a.containsDrag.SetBoolValue(true)
+	a.updateDrag(e)
+	a.onEntered.Fire(&e)
+}
+
+func (a *DropArea) dragMoved(e DragEvent) {
+	a.updateDrag(e)
+	a.onPositionChanged.Fire(&e)
+}
+
+func (a *DropArea) dragExited(e DragEvent) {
+	a.containsDrag.SetBoolValue(false)
+	a.onExited.Fire(&e)
+}
+
+func (a *DropArea) dragDropped(e DragEvent) {
+	a.updateDrag(e)
+	a.containsDrag.SetBoolValue(false)
+	a.onDropped.Fire(&e)
+}
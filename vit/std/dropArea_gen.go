@@ -0,0 +1,243 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForDropArea(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type DropArea struct {
+	*Item
+	id string
+
+	enabled      vit.BoolValue
+	keys         vit.AnyValue
+	containsDrag vit.BoolValue
+	drag         vit.GroupValue
+
+	onEntered         vit.EventAttribute[DragEvent]
+	onExited          vit.EventAttribute[DragEvent]
+	onPositionChanged vit.EventAttribute[DragEvent]
+	onDropped         vit.EventAttribute[DragEvent]
+}
+
+// newDropAreaInGlobal creates an appropriate file context for the component and then returns a new DropArea instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newDropAreaInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*DropArea, error) {
+	fileCtx, err := newFileContextForDropArea(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewDropArea(id, fileCtx), nil
+}
+func NewDropArea(id string, context *vit.FileContext) *DropArea {
+	d := &DropArea{
+		Item:         NewItem("", context),
+		id:           id,
+		enabled:      *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		keys:         *vit.NewEmptyAnyValue(),
+		containsDrag: *vit.NewEmptyBoolValue(),
+		drag: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"x":      vit.NewEmptyFloatValue(),
+			"y":      vit.NewEmptyFloatValue(),
+			"source": vit.NewEmptyComponentRefValue(),
+		}),
+		onEntered:         *vit.NewEventAttribute[DragEvent](),
+		onExited:          *vit.NewEventAttribute[DragEvent](),
+		onPositionChanged: *vit.NewEventAttribute[DragEvent](),
+		onDropped:         *vit.NewEventAttribute[DragEvent](),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	d.enabled.AddDependent(vit.FuncDep(d.enableDisable))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", d)
+
+	return d
+}
+
+func (d *DropArea) String() string {
+	return fmt.Sprintf("DropArea(%s)", d.id)
+}
+
+func (d *DropArea) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "enabled":
+		return &d.enabled, true
+	case "keys":
+		return &d.keys, true
+	case "containsDrag":
+		return &d.containsDrag, true
+	case "drag":
+		return &d.drag, true
+	default:
+		return d.Item.Property(key)
+	}
+}
+
+func (d *DropArea) MustProperty(key string) vit.Value {
+	v, ok := d.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (d *DropArea) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "enabled":
+		err = d.enabled.SetValue(value)
+	case "keys":
+		err = d.keys.SetValue(value)
+	case "containsDrag":
+		err = d.containsDrag.SetValue(value)
+	case "drag":
+		err = d.drag.SetValue(value)
+	default:
+		return d.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("DropArea", key, d.id, err)
+	}
+	return nil
+}
+
+func (d *DropArea) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "enabled":
+		d.enabled.SetCode(code)
+	case "keys":
+		d.keys.SetCode(code)
+	case "containsDrag":
+		d.containsDrag.SetCode(code)
+	case "drag":
+		d.drag.SetCode(code)
+	default:
+		return d.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (d *DropArea) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	case "onEntered":
+		return &d.onEntered, true
+	case "onExited":
+		return &d.onExited, true
+	case "onPositionChanged":
+		return &d.onPositionChanged, true
+	case "onDropped":
+		return &d.onDropped, true
+	default:
+		return d.Item.Event(name)
+	}
+}
+
+func (d *DropArea) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "enabled":
+		return &d.enabled, true
+	case "keys":
+		return &d.keys, true
+	case "containsDrag":
+		return &d.containsDrag, true
+	case "drag":
+		return &d.drag, true
+	case "onEntered":
+		return &d.onEntered, true
+	case "onExited":
+		return &d.onExited, true
+	case "onPositionChanged":
+		return &d.onPositionChanged, true
+	case "onDropped":
+		return &d.onDropped, true
+	default:
+		return d.Item.ResolveVariable(key)
+	}
+}
+
+func (d *DropArea) AddChild(child vit.Component) {
+	child.SetParent(d)
+	d.AddChildButKeepParent(child)
+}
+
+func (d *DropArea) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range d.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(d)
+			d.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	d.AddChild(addThis)
+}
+
+func (d *DropArea) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = d
+	}
+	// properties
+	if changed, err := d.enabled.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropArea", "enabled", d.id, err))
+		}
+	}
+	if changed, err := d.keys.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropArea", "keys", d.id, err))
+		}
+	}
+	if changed, err := d.containsDrag.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropArea", "containsDrag", d.id, err))
+		}
+	}
+	if changed, err := d.drag.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropArea", "drag", d.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := d.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (d *DropArea) As(target *vit.Component) bool {
+	if _, ok := (*target).(*DropArea); ok {
+		*target = d
+		return true
+	}
+	return d.Item.As(target)
+}
+
+func (d *DropArea) ID() string {
+	return d.id
+}
+
+func (d *DropArea) Finish() error {
+	return d.RootC().FinishInContext(d)
+}
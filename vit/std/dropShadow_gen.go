@@ -0,0 +1,229 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForDropShadow(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type DropShadow struct {
+	*Item
+	id string
+
+	horizontalOffset vit.FloatValue
+	verticalOffset   vit.FloatValue
+	radius           vit.FloatValue
+	color            vit.ColorValue
+	spread           vit.FloatValue
+}
+
+// newDropShadowInGlobal creates an appropriate file context for the component and then returns a new DropShadow instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newDropShadowInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*DropShadow, error) {
+	fileCtx, err := newFileContextForDropShadow(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewDropShadow(id, fileCtx), nil
+}
+func NewDropShadow(id string, context *vit.FileContext) *DropShadow {
+	d := &DropShadow{
+		Item:             NewItem("", context),
+		id:               id,
+		horizontalOffset: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		verticalOffset:   *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		radius:           *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "4", Position: nil}),
+		color:            *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"#00000080\"", Position: nil}),
+		spread:           *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", d)
+
+	return d
+}
+
+func (d *DropShadow) String() string {
+	return fmt.Sprintf("DropShadow(%s)", d.id)
+}
+
+func (d *DropShadow) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "horizontalOffset":
+		return &d.horizontalOffset, true
+	case "verticalOffset":
+		return &d.verticalOffset, true
+	case "radius":
+		return &d.radius, true
+	case "color":
+		return &d.color, true
+	case "spread":
+		return &d.spread, true
+	default:
+		return d.Item.Property(key)
+	}
+}
+
+func (d *DropShadow) MustProperty(key string) vit.Value {
+	v, ok := d.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (d *DropShadow) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "horizontalOffset":
+		err = d.horizontalOffset.SetValue(value)
+	case "verticalOffset":
+		err = d.verticalOffset.SetValue(value)
+	case "radius":
+		err = d.radius.SetValue(value)
+	case "color":
+		err = d.color.SetValue(value)
+	case "spread":
+		err = d.spread.SetValue(value)
+	default:
+		return d.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("DropShadow", key, d.id, err)
+	}
+	return nil
+}
+
+func (d *DropShadow) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "horizontalOffset":
+		d.horizontalOffset.SetCode(code)
+	case "verticalOffset":
+		d.verticalOffset.SetCode(code)
+	case "radius":
+		d.radius.SetCode(code)
+	case "color":
+		d.color.SetCode(code)
+	case "spread":
+		d.spread.SetCode(code)
+	default:
+		return d.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (d *DropShadow) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return d.Item.Event(name)
+	}
+}
+
+func (d *DropShadow) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "horizontalOffset":
+		return &d.horizontalOffset, true
+	case "verticalOffset":
+		return &d.verticalOffset, true
+	case "radius":
+		return &d.radius, true
+	case "color":
+		return &d.color, true
+	case "spread":
+		return &d.spread, true
+	default:
+		return d.Item.ResolveVariable(key)
+	}
+}
+
+func (d *DropShadow) AddChild(child vit.Component) {
+	child.SetParent(d)
+	d.AddChildButKeepParent(child)
+}
+
+func (d *DropShadow) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range d.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(d)
+			d.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	d.AddChild(addThis)
+}
+
+func (d *DropShadow) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = d
+	}
+	// properties
+	if changed, err := d.horizontalOffset.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropShadow", "horizontalOffset", d.id, err))
+		}
+	}
+	if changed, err := d.verticalOffset.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropShadow", "verticalOffset", d.id, err))
+		}
+	}
+	if changed, err := d.radius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropShadow", "radius", d.id, err))
+		}
+	}
+	if changed, err := d.color.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropShadow", "color", d.id, err))
+		}
+	}
+	if changed, err := d.spread.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("DropShadow", "spread", d.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := d.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (d *DropShadow) As(target *vit.Component) bool {
+	if _, ok := (*target).(*DropShadow); ok {
+		*target = d
+		return true
+	}
+	return d.Item.As(target)
+}
+
+func (d *DropShadow) ID() string {
+	return d.id
+}
+
+func (d *DropShadow) Finish() error {
+	return d.RootC().FinishInContext(d)
+}
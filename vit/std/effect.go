@@ -0,0 +1,293 @@
+package std
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	"github.com/tdewolff/canvas"
+	"github.com/tdewolff/canvas/renderers/rasterizer"
+)
+
+// Effects rasterize the item they are applied to into an offscreen image which is then processed and drawn instead of or in addition to the item.
+// This works with every renderer but the result is always a raster image, even in vector based outputs like PDF.
+
+// maxLayerPixels limits the size of offscreen images. Larger layers are rasterized with a lower resolution.
+const maxLayerPixels = 4096 * 4096
+
+// LayerEffect returns the effect that has been assigned to layer.effect or nil if there is none or the layer is disabled.
+func (i *Item) LayerEffect() vit.Effect {
+	if !i.layer.MustGet("enabled").(*vit.BoolValue).Bool() {
+		return nil
+	}
+	return i.layerEffect
+}
+
+// updateLayerEffect instantiates the effect that has been assigned to layer.effect and updates its properties like those of a child.
+// The effect is only instantiated again if a different component has been assigned.
+func (i *Item) updateLayerEffect() (int, vit.ErrorGroup) {
+	value := i.layer.MustGet("effect").(*vit.OptionalValue[*vit.ComponentDefValue])
+	def := value.Value().ComponentDefinition()
+	if !value.IsSet() || def == nil {
+		i.layerEffect, i.layerEffectDefinition = nil, nil
+		return 0, vit.ErrorGroup{}
+	}
+	if def != i.layerEffectDefinition {
+		i.layerEffect, i.layerEffectDefinition = nil, def
+		instance, err := parse.InstantiateComponent(def, value.Value().Context())
+		if err != nil {
+			i.Context().Global.Environment.Logger().Printf("layer.effect: %s\r\n", err)
+			return 0, vit.ErrorGroup{}
+		}
+		effect, ok := instance.(vit.Effect)
+		if !ok {
+			i.Context().Global.Environment.Logger().Printf("layer.effect: %s is not an effect\r\n", instance.ID())
+			return 0, vit.ErrorGroup{}
+		}
+		i.layerEffect = effect
+	}
+	if i.layerEffect == nil {
+		return 0, vit.ErrorGroup{}
+	}
+	// only expressions that have changed are evaluated again
+	return i.layerEffect.(vit.Component).UpdateExpressions(nil)
+}
+
+func (d *DropShadow) DrawEffect(ctx vit.DrawingContext, bounds vit.Rect, draw func(vit.DrawingContext) error) error {
+	radius := math.Max(0, d.radius.Float64())
+	spread := math.Max(0, d.spread.Float64())
+	layer, err := rasterizeLayer(ctx, draw, blurExtent(radius)+spread)
+	if err != nil {
+		return err
+	}
+	if layer != nil {
+		dpmm := layer.resolution.DPMM()
+		shadow := layer.shadow(d.color.RGBAColor(), int(math.Round(spread*dpmm)), radius/2*dpmm)
+		ctx.DrawImage(layer.x+d.horizontalOffset.Float64(), layer.y+d.verticalOffset.Float64(), shadow, layer.resolution)
+	}
+	// the item itself is drawn normally on top of the shadow
+	return draw(ctx)
+}
+
+func (g *GaussianBlur) DrawEffect(ctx vit.DrawingContext, bounds vit.Rect, draw func(vit.DrawingContext) error) error {
+	radius := math.Max(0, g.radius.Float64())
+	if radius == 0 {
+		return draw(ctx)
+	}
+	layer, err := rasterizeLayer(ctx, draw, blurExtent(radius))
+	if err != nil {
+		return err
+	}
+	if layer != nil {
+		blur(layer.image.Pix, layer.image.Rect.Dx(), layer.image.Rect.Dy(), layer.image.Stride, 4, radius/2*layer.resolution.DPMM())
+		ctx.DrawImage(layer.x, layer.y, layer.image, layer.resolution)
+	}
+	return nil
+}
+
+// blurExtent returns how far a blur with the given radius reaches.
+// Like in CSS the standard deviation of the blur is half of the radius. The blur is cut off at three times the standard deviation.
+func blurExtent(radius float64) float64 {
+	return radius * 1.5
+}
+
+// rasterLayer is an item that has been drawn into an image.
+type rasterLayer struct {
+	image      *image.RGBA
+	x, y       float64 // the position of the top left corner in the coordinate system of the context the item would have been drawn into
+	resolution canvas.Resolution
+}
+
+// rasterizeLayer draws the item into an image that contains everything the item draws with the given margin on all sides.
+// If the item doesn't draw anything nil is returned.
+func rasterizeLayer(ctx vit.DrawingContext, draw func(vit.DrawingContext) error, margin float64) (*rasterLayer, error) {
+	c := canvas.New(0, 0)
+	layerCtx := canvas.NewContext(c)
+	layerCtx.SetCoordSystem(canvas.CartesianIV)
+	err := draw(vit.DrawingContext{Context: layerCtx, Resolution: ctx.Resolution})
+	if err != nil {
+		return nil, err
+	}
+	b := &boundsRenderer{}
+	c.RenderTo(b)
+	if !b.drawn {
+		return nil, nil
+	}
+
+	resolution := ctx.PixelResolution()
+	rect := canvas.Rect{X: b.rect.X - margin, Y: b.rect.Y - margin, W: b.rect.W + 2*margin, H: b.rect.H + 2*margin}
+	if pixels := rect.W * rect.H * resolution.DPMM() * resolution.DPMM(); pixels > maxLayerPixels {
+		resolution = canvas.DPMM(resolution.DPMM() * math.Sqrt(maxLayerPixels/pixels))
+	}
+	// the size of the canvas is rounded up to whole pixels to prevent the image from being scaled
+	rect.W = math.Ceil(rect.W*resolution.DPMM()) / resolution.DPMM()
+	rect.H = math.Ceil(rect.H*resolution.DPMM()) / resolution.DPMM()
+	c.Clip(rect)
+	return &rasterLayer{
+		image: rasterizer.Draw(c, resolution, canvas.DefaultColorSpace),
+		// the canvas has a height of 0 which means that the coordinate system is only flipped
+		x:          rect.X,
+		y:          -(rect.Y + rect.H),
+		resolution: resolution,
+	}, nil
+}
+
+// shadow returns an image that contains the shape of the layer filled with the color.
+// The shape is enlarged by spread and blurred with the standard deviation sigma, both in pixels.
+func (l *rasterLayer) shadow(col color.RGBA, spread int, sigma float64) *image.RGBA {
+	width, height := l.image.Rect.Dx(), l.image.Rect.Dy()
+	mask := image.NewAlpha(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mask.Pix[y*mask.Stride+x] = l.image.Pix[y*l.image.Stride+x*4+3]
+		}
+	}
+	dilate(mask.Pix, width, height, mask.Stride, spread)
+	blur(mask.Pix, width, height, mask.Stride, 1, sigma)
+
+	shadow := image.NewRGBA(mask.Rect)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			a := uint32(mask.Pix[y*mask.Stride+x])
+			i := y*shadow.Stride + x*4
+			// the color is already premultiplied
+			shadow.Pix[i+0] = uint8((uint32(col.R)*a + 127) / 255)
+			shadow.Pix[i+1] = uint8((uint32(col.G)*a + 127) / 255)
+			shadow.Pix[i+2] = uint8((uint32(col.B)*a + 127) / 255)
+			shadow.Pix[i+3] = uint8((uint32(col.A)*a + 127) / 255)
+		}
+	}
+	return shadow
+}
+
+// boundsRenderer is a renderer that only collects the area that is drawn to.
+type boundsRenderer struct {
+	rect  canvas.Rect
+	drawn bool
+}
+
+func (r *boundsRenderer) Size() (float64, float64) {
+	return 0, 0
+}
+
+func (r *boundsRenderer) RenderPath(path *canvas.Path, style canvas.Style, m canvas.Matrix) {
+	bounds := path.Bounds()
+	if style.HasStroke() {
+		bounds.X -= style.StrokeWidth / 2
+		bounds.Y -= style.StrokeWidth / 2
+		bounds.W += style.StrokeWidth
+		bounds.H += style.StrokeWidth
+	}
+	r.add(bounds.Transform(m))
+}
+
+func (r *boundsRenderer) RenderText(text *canvas.Text, m canvas.Matrix) {
+	r.add(text.Bounds().Transform(m))
+}
+
+func (r *boundsRenderer) RenderImage(img image.Image, m canvas.Matrix) {
+	size := img.Bounds().Size()
+	r.add(canvas.Rect{W: float64(size.X), H: float64(size.Y)}.Transform(m))
+}
+
+func (r *boundsRenderer) add(rect canvas.Rect) {
+	if !r.drawn {
+		r.rect = rect
+		r.drawn = true
+		return
+	}
+	x1, y1 := math.Min(r.rect.X, rect.X), math.Min(r.rect.Y, rect.Y)
+	x2, y2 := math.Max(r.rect.X+r.rect.W, rect.X+rect.W), math.Max(r.rect.Y+r.rect.H, rect.Y+rect.H)
+	r.rect = canvas.Rect{X: x1, Y: y1, W: x2 - x1, H: y2 - y1}
+}
+
+// blur applies a gaussian blur with the standard deviation sigma in pixels to an image with the given number of channels per pixel.
+// The blur is approximated by three box blurs. Pixels outside of the image are treated as transparent.
+func blur(pix []uint8, width, height, stride, channels int, sigma float64) {
+	if sigma <= 0 || width == 0 || height == 0 {
+		return
+	}
+	tmp := make([]uint8, len(pix))
+	for _, size := range boxSizes(sigma, 3) {
+		r := (size - 1) / 2
+		if r == 0 {
+			continue
+		}
+		for y := 0; y < height; y++ {
+			for c := 0; c < channels; c++ {
+				boxBlur(tmp[y*stride+c:], pix[y*stride+c:], width, channels, r)
+			}
+		}
+		for x := 0; x < width; x++ {
+			for c := 0; c < channels; c++ {
+				boxBlur(pix[x*channels+c:], tmp[x*channels+c:], height, stride, r)
+			}
+		}
+	}
+}
+
+// boxSizes returns the sizes of n box blurs that approximate a gaussian blur with the standard deviation sigma.
+// See "Fast Almost-Gaussian Filtering" by Peter Kovesi.
+func boxSizes(sigma float64, n int) []int {
+	ideal := math.Sqrt(12*sigma*sigma/float64(n) + 1)
+	lower := int(math.Floor(ideal))
+	if lower%2 == 0 {
+		lower--
+	}
+	upper := lower + 2
+	m := int(math.Round((12*sigma*sigma - float64(n*lower*lower) - float64(4*n*lower) - float64(3*n)) / float64(-4*lower-4)))
+	sizes := make([]int, n)
+	for i := range sizes {
+		if i < m {
+			sizes[i] = lower
+		} else {
+			sizes[i] = upper
+		}
+	}
+	return sizes
+}
+
+// boxBlur averages n values that are step bytes apart over a window that reaches r values in both directions.
+func boxBlur(dst, src []uint8, n, step, r int) {
+	size := 2*r + 1
+	sum := 0
+	for i := 0; i < r && i < n; i++ {
+		sum += int(src[i*step])
+	}
+	for i := 0; i < n; i++ {
+		if j := i + r; j < n {
+			sum += int(src[j*step])
+		}
+		dst[i*step] = uint8((sum + size/2) / size)
+		if j := i - r; j >= 0 {
+			sum -= int(src[j*step])
+		}
+	}
+}
+
+// dilate enlarges the shape in a single channel image by r pixels.
+func dilate(pix []uint8, width, height, stride, r int) {
+	if r <= 0 {
+		return
+	}
+	tmp := make([]uint8, len(pix))
+	maxLine := func(dst, src []uint8, n, step int) {
+		for i := 0; i < n; i++ {
+			var value uint8
+			for j := i - r; j <= i+r; j++ {
+				if j >= 0 && j < n && src[j*step] > value {
+					value = src[j*step]
+				}
+			}
+			dst[i*step] = value
+		}
+	}
+	for y := 0; y < height; y++ {
+		maxLine(tmp[y*stride:], pix[y*stride:], width, 1)
+	}
+	for x := 0; x < width; x++ {
+		maxLine(pix[x:], tmp[x:], height, stride)
+	}
+}
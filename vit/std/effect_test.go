@@ -0,0 +1,135 @@
+package std
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/tdewolff/canvas"
+)
+
+const effectSource = `import Vit 1.0
+
+Item {
+    id: root
+    property bool blurEnabled: true
+    property int shadowOffset: 10
+    width: 300
+    height: 100
+
+    Rectangle {
+        x: 20
+        y: 20
+        width: 50
+        height: 50
+        color: "red"
+        layer.effect: DropShadow {
+            horizontalOffset: root.shadowOffset
+            verticalOffset: 10
+            radius: 0
+            color: "black"
+        }
+    }
+
+    Rectangle {
+        x: 120
+        y: 20
+        width: 50
+        height: 50
+        color: "red"
+        layer.effect: DropShadow {
+            radius: 8
+            spread: 4
+            color: "blue"
+        }
+    }
+
+    Rectangle {
+        x: 220
+        y: 20
+        width: 50
+        height: 50
+        color: "red"
+        layer.enabled: root.blurEnabled
+        layer.effect: GaussianBlur {
+            radius: 10
+        }
+    }
+}
+`
+
+func TestEffects(t *testing.T) {
+	manager, _ := loadTestComponent(t, effectSource)
+
+	draw := func(resolution canvas.Resolution) func(x, y int) color.RGBA {
+		img := renderComponentAt(t, manager.MainComponent(), 300, 100, resolution)
+		// returns the pixel in the center of the unit
+		return func(x, y int) color.RGBA {
+			dpmm := resolution.DPMM()
+			return img.RGBAAt(int(float64(x)*dpmm+dpmm/2), int(float64(y)*dpmm+dpmm/2))
+		}
+	}
+
+	var (
+		transparent = color.RGBA{0, 0, 0, 0}
+		red         = color.RGBA{255, 0, 0, 255}
+		black       = color.RGBA{0, 0, 0, 255}
+	)
+	for _, resolution := range []canvas.Resolution{canvas.DPMM(1), canvas.DPMM(2)} {
+		at := draw(resolution)
+		tests := []struct {
+			x, y     int
+			expected color.RGBA
+		}{
+			// sharp shadow with an offset
+			{40, 40, red},
+			{75, 75, black},
+			{25, 75, transparent},
+			{81, 50, transparent},
+			// blurred shadow with spread
+			{145, 45, red},
+			{145, 71, color.RGBA{0, 0, 186, 186}},
+			{145, 96, transparent},
+			// blurred item
+			{245, 45, red},
+			{245, 10, transparent},
+		}
+		for _, test := range tests {
+			actual := at(test.x, test.y)
+			if !similarColor(actual, test.expected, 12) {
+				t.Errorf("%v dpmm (%d, %d): expected %v, got %v", resolution.DPMM(), test.x, test.y, test.expected, actual)
+			}
+		}
+		// edges of the blurred item are soft
+		if a := at(220, 45).A; a < 60 || a > 200 {
+			t.Errorf("%v dpmm: expected a partially transparent edge of the blurred item, got alpha %d", resolution.DPMM(), a)
+		}
+	}
+
+	// disabling the layer draws the item without the effect
+	err := manager.MainComponent().SetProperty("blurEnabled", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager.UpdateFully()
+	at := draw(canvas.DPMM(1))
+	if actual := at(221, 45); actual != red {
+		t.Errorf("expected a sharp edge with a disabled layer, got %v", actual)
+	}
+
+	// properties of effects are updated when the properties they depend on change
+	if err := manager.MainComponent().SetProperty("shadowOffset", 20); err != nil {
+		t.Fatal(err)
+	}
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(errs)
+	}
+	// the effect is updated together with the components instead of while drawing
+	shadow := findComponents[*Rectangle](manager.MainComponent())[0].layerEffect.(*DropShadow)
+	if offset := shadow.horizontalOffset.Float64(); offset != 20 {
+		t.Errorf("expected the offset of the shadow to be updated, got %v", offset)
+	}
+	at = draw(canvas.DPMM(1))
+	if actual := at(85, 75); actual != black {
+		t.Errorf("expected the shadow to have moved, got %v", actual)
+	}
+}
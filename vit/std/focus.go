@@ -0,0 +1,354 @@
+package std
+
+import (
+	"sort"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+// FocusReason describes why the active focus has changed.
+type FocusReason int
+
+const (
+	OtherFocusReason   FocusReason = iota // focus was changed programmatically
+	MouseFocusReason                      // an item has been clicked
+	TabFocusReason                        // the tab key has been pressed
+	BacktabFocusReason                    // the tab key has been pressed together with shift
+)
+
+// FocusEvent is fired when an item gains or loses the active focus.
+type FocusEvent struct {
+	ActiveFocus bool
+	Reason      FocusReason
+}
+
+// TabOrder defines in which order items will be visited when navigating using the tab key.
+type TabOrder int
+
+const (
+	TabOrderDeclaration TabOrder = iota // items are visited in the order they have been declared in
+	TabOrderVisual                      // items are visited from top to bottom and left to right
+)
+
+// focusHandler is implemented by execution environments that keep track of the focus of items.
+type focusHandler interface {
+	itemFocusChanged(*Item)
+	forceActiveFocus(*Item, FocusReason)
+}
+
+func (i *Item) focusHandler() (focusHandler, bool) {
+	ctx := i.Context()
+	if ctx == nil || ctx.Global == nil {
+		return nil, false
+	}
+	handler, ok := ctx.Global.Environment.(focusHandler)
+	return handler, ok
+}
+
+func (i *Item) focusChanged() {
+	if handler, ok := i.focusHandler(); ok {
+		handler.itemFocusChanged(i)
+	}
+}
+
+// HasActiveFocus returns true if this item or one of its children is receiving key events.
+func (i *Item) HasActiveFocus() bool {
+	return i.activeFocus.Bool()
+}
+
+// ForceActiveFocus gives the active focus to this item by setting the focus on it and all focus scopes it is contained in.
+func (i *Item) ForceActiveFocus(reason FocusReason) {
+	if handler, ok := i.focusHandler(); ok {
+		handler.forceActiveFocus(i, reason)
+	}
+}
+
+// setActiveFocus is called by the focus handler to update the activeFocus property.
+func (i *Item) setActiveFocus(active bool, reason FocusReason) {
+	if i.activeFocus.Bool() == active {
+		return
+	}
+	i.activeFocus.SetBoolValue(active)
+	i.onActiveFocusChanged.Fire(&FocusEvent{ActiveFocus: active, Reason: reason})
+}
+
+// asItem returns the item a component is based on.
+func asItem(comp vit.Component) (*Item, bool) {
+	if comp == nil {
+		return nil, false
+	}
+	var target vit.Component = (*Item)(nil)
+	if !comp.As(&target) {
+		return nil, false
+	}
+	return target.(*Item), true
+}
+
+func isFocusScope(comp vit.Component) bool {
+	var target vit.Component = (*FocusScope)(nil)
+	return comp.As(&target)
+}
+
+// enclosingFocusScopes returns all focus scopes the component is contained in, starting with the closest one.
+func enclosingFocusScopes(comp vit.Component) []vit.Component {
+	var scopes []vit.Component
+	for parent := comp.RootC().Parent(); parent != nil; parent = parent.RootC().Parent() {
+		if isFocusScope(parent) {
+			scopes = append(scopes, parent)
+		}
+	}
+	return scopes
+}
+
+// rootComponent returns the top most parent of the component.
+func rootComponent(comp vit.Component) vit.Component {
+	for comp.RootC().Parent() != nil {
+		comp = comp.RootC().Parent()
+	}
+	return comp
+}
+
+// component returns the outermost component that is based on the given item.
+func (h *InputHandler) component(item *Item) vit.Component {
+	if comp, ok := h.components[item.RootC()]; ok {
+		return comp
+	}
+	return item
+}
+
+// itemFocusChanged will be called by an item when its focus property changed.
+func (h *InputHandler) itemFocusChanged(item *Item) {
+	if h.updatingFocus {
+		return
+	}
+	comp := h.component(item)
+	if item.focus.Bool() {
+		h.updatingFocus = true
+		h.clearFocusInScope(comp)
+		h.updatingFocus = false
+		if h.scopesFocused(comp) {
+			h.setActiveFocus(h.focusTarget(comp), OtherFocusReason)
+		}
+	} else if item.activeFocus.Bool() {
+		// the item itself or a scope that contains the active focus lost its focus
+		if scopes := enclosingFocusScopes(comp); len(scopes) > 0 {
+			h.setActiveFocus(scopes[0], OtherFocusReason)
+		} else {
+			h.setActiveFocus(nil, OtherFocusReason)
+		}
+	}
+}
+
+// forceActiveFocus sets the focus on the item and all of its enclosing scopes and then makes it the active focus item.
+func (h *InputHandler) forceActiveFocus(item *Item, reason FocusReason) {
+	comp := h.component(item)
+	h.updatingFocus = true
+	for _, c := range append([]vit.Component{comp}, enclosingFocusScopes(comp)...) {
+		if i, ok := asItem(c); ok {
+			i.focus.SetBoolValue(true)
+		}
+		h.clearFocusInScope(c)
+	}
+	h.updatingFocus = false
+	h.setActiveFocus(h.focusTarget(comp), reason)
+}
+
+// clearFocusInScope removes the focus from all other items in the focus scope of the given component.
+func (h *InputHandler) clearFocusInScope(comp vit.Component) {
+	// without an enclosing scope the root acts as one
+	var scope = rootComponent(comp)
+	if scopes := enclosingFocusScopes(comp); len(scopes) > 0 {
+		scope = scopes[0]
+	}
+	var clear func(vit.Component)
+	clear = func(parent vit.Component) {
+		for _, child := range parent.Children() {
+			if child.RootC() == comp.RootC() {
+				continue
+			}
+			if item, ok := asItem(child); ok {
+				item.focus.SetBoolValue(false)
+			}
+			if !isFocusScope(child) {
+				clear(child)
+			}
+		}
+	}
+	clear(scope)
+}
+
+// scopesFocused returns true if all focus scopes that contain the component have the focus.
+func (h *InputHandler) scopesFocused(comp vit.Component) bool {
+	for _, scope := range enclosingFocusScopes(comp) {
+		if item, ok := asItem(scope); ok && !item.focus.Bool() {
+			return false
+		}
+	}
+	return true
+}
+
+// focusTarget returns the component that should receive the active focus when the given one gets it.
+// For focus scopes this is the item inside the scope that has the focus.
+func (h *InputHandler) focusTarget(comp vit.Component) vit.Component {
+	if !isFocusScope(comp) {
+		return comp
+	}
+	var find func(vit.Component) vit.Component
+	find = func(parent vit.Component) vit.Component {
+		for _, child := range parent.Children() {
+			if item, ok := asItem(child); ok && item.focus.Bool() {
+				return child
+			}
+			if !isFocusScope(child) {
+				if found := find(child); found != nil {
+					return found
+				}
+			}
+		}
+		return nil
+	}
+	if child := find(comp); child != nil {
+		return h.focusTarget(child)
+	}
+	return comp
+}
+
+// setActiveFocus makes the given component the one that receives key events and updates the activeFocus property of all affected items.
+// The component may be nil.
+func (h *InputHandler) setActiveFocus(comp vit.Component, reason FocusReason) {
+	var chain []vit.Component
+	if comp != nil {
+		chain = append([]vit.Component{comp}, enclosingFocusScopes(comp)...)
+	}
+	contains := func(list []vit.Component, comp vit.Component) bool {
+		for _, c := range list {
+			if c.RootC() == comp.RootC() {
+				return true
+			}
+		}
+		return false
+	}
+
+	oldChain := h.activeFocusChain
+	h.activeFocus = comp
+	h.activeFocusChain = chain
+	for _, c := range oldChain {
+		if contains(chain, c) {
+			continue
+		}
+		if item, ok := asItem(c); ok {
+			item.setActiveFocus(false, reason)
+		}
+		if focusable, ok := c.(vit.FocusableComponent); ok {
+			focusable.Blur()
+		}
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		if contains(oldChain, chain[i]) {
+			continue
+		}
+		if item, ok := asItem(chain[i]); ok {
+			item.setActiveFocus(true, reason)
+		}
+		if focusable, ok := chain[i].(vit.FocusableComponent); ok {
+			focusable.Focus()
+		}
+	}
+}
+
+// ActiveFocus returns the component that currently receives key events. It returns nil if there is none.
+func (h *InputHandler) ActiveFocus() vit.Component {
+	return h.activeFocus
+}
+
+// SetTabOrder sets the order in which items will be visited when navigating using the tab key.
+func (h *InputHandler) SetTabOrder(order TabOrder) {
+	h.tabOrder = order
+}
+
+// deliverKeyEvent passes the event to the active focus item and then up through its parents until it has been accepted.
+func (h *InputHandler) deliverKeyEvent(e *KeyEvent) {
+	for comp := h.activeFocus; comp != nil && !e.Accepted; comp = comp.RootC().Parent() {
+		var target vit.Component = (*KeyArea)(nil)
+		if comp.As(&target) {
+			target.(*KeyArea).TriggerEvent(e)
+		} else if input, ok := h.keyInputs[comp.RootC()]; ok {
+			input.handleKeyEvent(e)
+		}
+	}
+}
+
+// tabCandidates returns all items that can be focused using the tab key in the configured order.
+func (h *InputHandler) tabCandidates() []vit.Component {
+	var root vit.Component
+	if h.activeFocus != nil {
+		root = rootComponent(h.activeFocus)
+	} else {
+		for _, comp := range h.components {
+			root = rootComponent(comp)
+			break
+		}
+	}
+	if root == nil {
+		return nil
+	}
+
+	var candidates []vit.Component
+	var collect func(vit.Component)
+	collect = func(comp vit.Component) {
+		if item, ok := asItem(comp); ok && item.activeFocusOnTab.Bool() {
+			candidates = append(candidates, comp)
+		}
+		for _, child := range comp.Children() {
+			collect(child)
+		}
+	}
+	collect(root)
+
+	if h.tabOrder == TabOrderVisual {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			a, b := candidates[i].Bounds(), candidates[j].Bounds()
+			if a.Y1 != b.Y1 {
+				return a.Y1 < b.Y1
+			}
+			return a.X1 < b.X1
+		})
+	}
+	return candidates
+}
+
+// moveFocusByTab moves the active focus to the next or previous item that accepts focus through the tab key.
+// It returns false if there is no such item.
+func (h *InputHandler) moveFocusByTab(backwards bool) bool {
+	candidates := h.tabCandidates()
+	if len(candidates) == 0 {
+		return false
+	}
+
+	// the current item is the innermost candidate that contains the active focus
+	current := -1
+	for i, candidate := range candidates {
+		if isAncestorOf(candidate, h.activeFocus) && (current == -1 || isAncestorOf(candidates[current], candidate)) {
+			current = i
+		}
+	}
+
+	var next int
+	var reason FocusReason
+	if backwards {
+		reason = BacktabFocusReason
+		if current <= 0 {
+			next = len(candidates) - 1
+		} else {
+			next = current - 1
+		}
+	} else {
+		reason = TabFocusReason
+		next = (current + 1) % len(candidates)
+	}
+
+	if item, ok := asItem(candidates[next]); ok {
+		h.forceActiveFocus(item, reason)
+	}
+	return true
+}
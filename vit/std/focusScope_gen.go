@@ -0,0 +1,148 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForFocusScope(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type FocusScope struct {
+	*Item
+	id string
+}
+
+// newFocusScopeInGlobal creates an appropriate file context for the component and then returns a new FocusScope instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newFocusScopeInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*FocusScope, error) {
+	fileCtx, err := newFileContextForFocusScope(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewFocusScope(id, fileCtx), nil
+}
+func NewFocusScope(id string, context *vit.FileContext) *FocusScope {
+	f := &FocusScope{
+		Item: NewItem("", context),
+		id:   id,
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", f)
+
+	return f
+}
+
+func (f *FocusScope) String() string {
+	return fmt.Sprintf("FocusScope(%s)", f.id)
+}
+
+func (f *FocusScope) Property(key string) (vit.Value, bool) {
+	switch key {
+	default:
+		return f.Item.Property(key)
+	}
+}
+
+func (f *FocusScope) MustProperty(key string) vit.Value {
+	v, ok := f.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (f *FocusScope) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	default:
+		return f.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("FocusScope", key, f.id, err)
+	}
+	return nil
+}
+
+func (f *FocusScope) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	default:
+		return f.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (f *FocusScope) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return f.Item.Event(name)
+	}
+}
+
+func (f *FocusScope) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	default:
+		return f.Item.ResolveVariable(key)
+	}
+}
+
+func (f *FocusScope) AddChild(child vit.Component) {
+	child.SetParent(f)
+	f.AddChildButKeepParent(child)
+}
+
+func (f *FocusScope) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range f.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(f)
+			f.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	f.AddChild(addThis)
+}
+
+func (f *FocusScope) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = f
+	}
+	// properties
+
+	// methods
+
+	n, err := f.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (f *FocusScope) As(target *vit.Component) bool {
+	if _, ok := (*target).(*FocusScope); ok {
+		*target = f
+		return true
+	}
+	return f.Item.As(target)
+}
+
+func (f *FocusScope) ID() string {
+	return f.id
+}
+
+func (f *FocusScope) Finish() error {
+	return f.RootC().FinishInContext(f)
+}
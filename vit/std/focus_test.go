@@ -0,0 +1,130 @@
+package std
+
+import (
+	"testing"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+const focusSource = `import Vit 1.0
+
+KeyArea {
+    width: 400
+    height: 400
+    propagateEvents: true
+
+    FocusScope {
+        y: 100
+        width: 100
+        height: 50
+        activeFocusOnTab: true
+
+        KeyArea {
+            focus: true
+            propagateEvents: true
+        }
+    }
+
+    FocusScope {
+        y: 0
+        width: 100
+        height: 50
+        focus: true
+        activeFocusOnTab: true
+
+        KeyArea {
+            focus: true
+        }
+    }
+}
+`
+
+func TestFocus(t *testing.T) {
+	manager, handler := loadTestComponent(t, focusSource)
+	root := manager.MainComponent()
+
+	scopes := findComponents[*FocusScope](root)
+	first, second := scopes[0], scopes[1]
+	keyAreas := findComponents[*KeyArea](root)
+	firstKeys, secondKeys := keyAreas[0], keyAreas[1]
+	var rootComp vit.Component = (*KeyArea)(nil)
+	if !root.As(&rootComp) {
+		t.Fatalf("root is not a key area")
+	}
+	rootKeys := rootComp.(*KeyArea)
+
+	var rootPresses, firstPresses, secondPresses int
+	rootKeys.onKeyDown.AddListener(vit.ListenerCB(func(e *KeyEvent) { rootPresses++ }))
+	firstKeys.onKeyDown.AddListener(vit.ListenerCB(func(e *KeyEvent) { firstPresses++ }))
+	secondKeys.onKeyDown.AddListener(vit.ListenerCB(func(e *KeyEvent) { secondPresses++ }))
+
+	var reasons []FocusReason
+	second.onActiveFocusChanged.AddListener(vit.ListenerCB(func(e *FocusEvent) { reasons = append(reasons, e.Reason) }))
+
+	press := func(key string, mods KeyArea_Modifiers) bool {
+		return handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: key, Modifiers: mods})
+	}
+
+	// the item with focus inside of the focused scope has the active focus
+	if !secondKeys.activeFocus.Bool() || !second.activeFocus.Bool() || first.activeFocus.Bool() || firstKeys.activeFocus.Bool() {
+		t.Fatalf("unexpected initial active focus")
+	}
+	if handler.ActiveFocus() != secondKeys {
+		t.Fatalf("expected the key area of the second scope to be the active focus item, got %v", handler.ActiveFocus())
+	}
+
+	// accepted events don't reach the parents
+	press("A", 0)
+	if secondPresses != 1 || rootPresses != 0 {
+		t.Errorf("expected the key event to be accepted by the focused key area")
+	}
+	// this includes the tab key
+	press("Tab", 0)
+	if !secondKeys.activeFocus.Bool() {
+		t.Errorf("tab moved the focus even though it has been accepted")
+	}
+	secondKeys.propagateEvents.SetBoolValue(true)
+
+	// forcing the focus into the other scope keeps the focus of the items inside the second scope
+	first.ForceActiveFocus(OtherFocusReason)
+	if !firstKeys.activeFocus.Bool() || second.activeFocus.Bool() || second.focus.Bool() {
+		t.Errorf("active focus has not been moved to the first scope")
+	}
+	if !secondKeys.focus.Bool() {
+		t.Errorf("focus inside of the second scope has been lost")
+	}
+
+	// unaccepted events propagate to the parents
+	press("A", 0)
+	if firstPresses != 1 || rootPresses != 1 {
+		t.Errorf("expected the key event to propagate to the root")
+	}
+
+	// tab navigation in declaration order
+	if !press("Tab", 0) || !secondKeys.activeFocus.Bool() {
+		t.Fatalf("tab did not move the focus to the second scope")
+	}
+	if len(reasons) != 2 || reasons[1] != TabFocusReason {
+		t.Errorf("unexpected focus change reasons %v", reasons)
+	}
+	press("Tab", 0)
+	if !firstKeys.activeFocus.Bool() {
+		t.Errorf("tab did not wrap around to the first scope")
+	}
+
+	// tab navigation in visual order
+	handler.SetTabOrder(TabOrderVisual)
+	press("Tab", KeyArea_Modifiers_shiftModifier)
+	if !secondKeys.activeFocus.Bool() {
+		t.Errorf("backtab did not move the focus to the second scope")
+	}
+	if reasons[len(reasons)-1] != BacktabFocusReason {
+		t.Errorf("expected the last focus change to be caused by backtab, got %v", reasons[len(reasons)-1])
+	}
+
+	// clearing the focus moves the active focus to the enclosing scope
+	secondKeys.focus.SetBoolValue(false)
+	if handler.ActiveFocus() != second || secondKeys.activeFocus.Bool() || !second.activeFocus.Bool() {
+		t.Errorf("active focus has not been moved to the enclosing scope")
+	}
+}
@@ -0,0 +1,63 @@
+package std
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/omniskop/vitrum/vit/vfont"
+)
+
+// load reads the font file and registers it with vfont.
+// The family name is taken from the font unless it has been overwritten with the family property.
+func (f *FontLoader) load() {
+	path := f.source.String()
+	if path == "" {
+		f.name.SetStringValue("")
+		f.status.SetIntValue(int(FontLoader_Status_Null))
+		return
+	}
+	pos, ok := f.source.Position()
+	if !ok {
+		f.fail("FontLoader: source has no position")
+		return
+	}
+
+	file, err := pos.FilePath.Dir().Open(path)
+	if err != nil {
+		f.fail("FontLoader: %s", err)
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		f.fail("FontLoader: %s", err)
+		return
+	}
+
+	family, style, err := vfont.DescribeFont(data)
+	if err != nil {
+		f.fail("FontLoader: unable to parse font %s: %s", path, err)
+		return
+	}
+	if f.family.String() != "" {
+		family = f.family.String()
+	}
+	if family == "" {
+		f.fail("FontLoader: font %s has no family name", path)
+		return
+	}
+
+	err = vfont.RegisterFont(family, style, bytes.NewReader(data))
+	if err != nil {
+		f.fail("FontLoader: %s", err)
+		return
+	}
+	f.name.SetStringValue(family)
+	f.status.SetIntValue(int(FontLoader_Status_Ready))
+}
+
+func (f *FontLoader) fail(format string, args ...any) {
+	f.Context().Global.Environment.Logger().Printf(format+"\r\n", args...)
+	f.name.SetStringValue("")
+	f.status.SetIntValue(int(FontLoader_Status_Error))
+}
@@ -0,0 +1,255 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForFontLoader(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type FontLoader_Status uint
+
+const (
+	FontLoader_Status_Null  FontLoader_Status = 0
+	FontLoader_Status_Ready FontLoader_Status = 1
+	FontLoader_Status_Error FontLoader_Status = 2
+)
+
+func (enum FontLoader_Status) String() string {
+	switch enum {
+	case FontLoader_Status_Null:
+		return "Null"
+	case FontLoader_Status_Ready:
+		return "Ready"
+	case FontLoader_Status_Error:
+		return "Error"
+	default:
+		return "<unknownStatus>"
+	}
+}
+
+type FontLoader struct {
+	*Item
+	id string
+
+	source vit.StringValue
+	family vit.StringValue
+	name   vit.StringValue
+	status vit.IntValue
+}
+
+// newFontLoaderInGlobal creates an appropriate file context for the component and then returns a new FontLoader instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newFontLoaderInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*FontLoader, error) {
+	fileCtx, err := newFileContextForFontLoader(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewFontLoader(id, fileCtx), nil
+}
+func NewFontLoader(id string, context *vit.FileContext) *FontLoader {
+	f := &FontLoader{
+		Item:   NewItem("", context),
+		id:     id,
+		source: *vit.NewEmptyStringValue(),
+		family: *vit.NewEmptyStringValue(),
+		name:   *vit.NewEmptyStringValue(),
+		status: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Status.Null", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	f.source.AddDependent(vit.FuncDep(f.load))
+	f.family.AddDependent(vit.FuncDep(f.load))
+	// register event listeners
+	// register enumerations
+	f.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "Status",
+		Position: nil,
+		Values:   map[string]int{"Null": 0, "Ready": 1, "Error": 2},
+	})
+	// add child components
+
+	context.RegisterComponent("", f)
+
+	return f
+}
+
+func (f *FontLoader) String() string {
+	return fmt.Sprintf("FontLoader(%s)", f.id)
+}
+
+func (f *FontLoader) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "source":
+		return &f.source, true
+	case "family":
+		return &f.family, true
+	case "name":
+		return &f.name, true
+	case "status":
+		return &f.status, true
+	default:
+		return f.Item.Property(key)
+	}
+}
+
+func (f *FontLoader) MustProperty(key string) vit.Value {
+	v, ok := f.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (f *FontLoader) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "source":
+		err = f.source.SetValue(value)
+	case "family":
+		err = f.family.SetValue(value)
+	case "name":
+		err = f.name.SetValue(value)
+	case "status":
+		err = f.status.SetValue(value)
+	default:
+		return f.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("FontLoader", key, f.id, err)
+	}
+	return nil
+}
+
+func (f *FontLoader) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "source":
+		f.source.SetCode(code)
+	case "family":
+		f.family.SetCode(code)
+	case "name":
+		f.name.SetCode(code)
+	case "status":
+		f.status.SetCode(code)
+	default:
+		return f.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (f *FontLoader) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return f.Item.Event(name)
+	}
+}
+
+func (f *FontLoader) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "source":
+		return &f.source, true
+	case "family":
+		return &f.family, true
+	case "name":
+		return &f.name, true
+	case "status":
+		return &f.status, true
+	default:
+		return f.Item.ResolveVariable(key)
+	}
+}
+
+func (f *FontLoader) AddChild(child vit.Component) {
+	child.SetParent(f)
+	f.AddChildButKeepParent(child)
+}
+
+func (f *FontLoader) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range f.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(f)
+			f.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	f.AddChild(addThis)
+}
+
+func (f *FontLoader) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = f
+	}
+	// properties
+	if changed, err := f.source.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("FontLoader", "source", f.id, err))
+		}
+	}
+	if changed, err := f.family.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("FontLoader", "family", f.id, err))
+		}
+	}
+	if changed, err := f.name.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("FontLoader", "name", f.id, err))
+		}
+	}
+	if changed, err := f.status.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("FontLoader", "status", f.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := f.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (f *FontLoader) As(target *vit.Component) bool {
+	if _, ok := (*target).(*FontLoader); ok {
+		*target = f
+		return true
+	}
+	return f.Item.As(target)
+}
+
+func (f *FontLoader) ID() string {
+	return f.id
+}
+
+func (f *FontLoader) Finish() error {
+	return f.RootC().FinishInContext(f)
+}
+
+func (f *FontLoader) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "Null":
+		return uint(FontLoader_Status_Null), true
+	case "Ready":
+		return uint(FontLoader_Status_Ready), true
+	case "Error":
+		return uint(FontLoader_Status_Error), true
+	default:
+		return nil, false
+	}
+}
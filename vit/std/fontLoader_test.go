@@ -0,0 +1,97 @@
+package std
+
+import (
+	"log"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+	"github.com/omniskop/vitrum/vit/parse"
+	"github.com/omniskop/vitrum/vit/vfont"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+const fontLoaderSource = `import Vit 1.0
+
+Item {
+    FontLoader {
+        id: loader
+        source: "fonts/custom.ttf"
+        family: "Custom Test Family"
+    }
+
+    FontLoader {
+        id: missing
+        source: "fonts/missing.ttf"
+    }
+
+    Text {
+        text: "registered"
+        font.family: "Custom Test Family"
+    }
+}
+`
+
+func TestFontLoader(t *testing.T) {
+	data := testfont.Data()
+
+	manager := parse.NewManager()
+	err := manager.SetSource(vpath.FS(&fstest.MapFS{
+		"Main.vit":         {Data: []byte(fontLoaderSource)},
+		"fonts/custom.ttf": {Data: data},
+	}, "Main.vit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = manager.Initialize(NewInputHandler(log.New(os.Stderr, "", 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(parse.FormatError(errs))
+	}
+
+	loaders := findComponents[*FontLoader](manager.MainComponent())
+	if loaders[0].status.Int() != int(FontLoader_Status_Ready) || loaders[0].name.String() != "Custom Test Family" {
+		t.Errorf("expected the font to be loaded, got status %d and name %q", loaders[0].status.Int(), loaders[0].name.String())
+	}
+	if loaders[1].status.Int() != int(FontLoader_Status_Error) {
+		t.Errorf("expected the missing font to fail, got status %d", loaders[1].status.Int())
+	}
+	if !vfont.IsRegistered("Custom Test Family") {
+		t.Errorf("expected the family to be registered")
+	}
+
+	text := findComponents[*Text](manager.MainComponent())[0]
+	if text.fontFaceData == nil {
+		t.Fatalf("expected the text to use the registered font")
+	}
+
+	family, style, err := vfont.DescribeFont(data)
+	if err != nil || family != testfont.Family || style.Weight != vfont.Normal || style.Italic {
+		t.Errorf("unexpected font description %q %v: %v", family, style, err)
+	}
+}
+
+func TestFallbackFont(t *testing.T) {
+	err := vfont.RegisterFontPath("Fallback Test Family", vfont.Style{Weight: vfont.Normal}, vpath.FS(testfont.FS, testfont.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the tests use the test font as a fallback
+	previous := vfont.FallbackFont()
+	defer vfont.SetFallbackFont(previous)
+	vfont.SetFallbackFont("")
+	_, err = vfont.LoadFontFace("Family That Does Not Exist", vfont.Style{PointSize: 12})
+	if err == nil {
+		t.Fatalf("expected an error without a fallback font")
+	}
+
+	vfont.SetFallbackFont("Fallback Test Family")
+	face, err := vfont.LoadFontFace("Family That Does Not Exist", vfont.Style{PointSize: 12})
+	if err != nil || face.Font.Name() != "Fallback Test Family" {
+		t.Errorf("expected the fallback font to be used: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForGaussianBlur(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type GaussianBlur struct {
+	*Item
+	id string
+
+	radius vit.FloatValue
+}
+
+// newGaussianBlurInGlobal creates an appropriate file context for the component and then returns a new GaussianBlur instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newGaussianBlurInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*GaussianBlur, error) {
+	fileCtx, err := newFileContextForGaussianBlur(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewGaussianBlur(id, fileCtx), nil
+}
+func NewGaussianBlur(id string, context *vit.FileContext) *GaussianBlur {
+	g := &GaussianBlur{
+		Item:   NewItem("", context),
+		id:     id,
+		radius: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "4", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", g)
+
+	return g
+}
+
+func (g *GaussianBlur) String() string {
+	return fmt.Sprintf("GaussianBlur(%s)", g.id)
+}
+
+func (g *GaussianBlur) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "radius":
+		return &g.radius, true
+	default:
+		return g.Item.Property(key)
+	}
+}
+
+func (g *GaussianBlur) MustProperty(key string) vit.Value {
+	v, ok := g.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (g *GaussianBlur) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "radius":
+		err = g.radius.SetValue(value)
+	default:
+		return g.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("GaussianBlur", key, g.id, err)
+	}
+	return nil
+}
+
+func (g *GaussianBlur) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "radius":
+		g.radius.SetCode(code)
+	default:
+		return g.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (g *GaussianBlur) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return g.Item.Event(name)
+	}
+}
+
+func (g *GaussianBlur) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "radius":
+		return &g.radius, true
+	default:
+		return g.Item.ResolveVariable(key)
+	}
+}
+
+func (g *GaussianBlur) AddChild(child vit.Component) {
+	child.SetParent(g)
+	g.AddChildButKeepParent(child)
+}
+
+func (g *GaussianBlur) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range g.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(g)
+			g.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	g.AddChild(addThis)
+}
+
+func (g *GaussianBlur) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = g
+	}
+	// properties
+	if changed, err := g.radius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("GaussianBlur", "radius", g.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := g.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (g *GaussianBlur) As(target *vit.Component) bool {
+	if _, ok := (*target).(*GaussianBlur); ok {
+		*target = g
+		return true
+	}
+	return g.Item.As(target)
+}
+
+func (g *GaussianBlur) ID() string {
+	return g.id
+}
+
+func (g *GaussianBlur) Finish() error {
+	return g.RootC().FinishInContext(g)
+}
@@ -1,27 +1,438 @@
 package std
 
 import (
+	"fmt"
 	"image/color"
+	"math"
+	"sort"
+	"strings"
 
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
 	"github.com/tdewolff/canvas"
 )
 
-func (r *Gradient) ConstructGradient(start, end canvas.Point) *canvas.LinearGradient {
-	grad := canvas.NewLinearGradient(start, end)
-	grad.Add(0, color.RGBA{0, 0, 0, 255})
+// The gio backend only supports linear gradients with two stops and the pdf backend doesn't support transparency in gradients.
+// That's why all gradients are decomposed into pieces that are either filled with a single color or with an opaque linear gradient with two stops.
 
-	for i, child := range r.Children() {
-		if i > 0 && i != len(r.Children())-1 {
-			// canvas only supports gradients with 2 steps, thus we skip all but the first and last stop
-			continue
+// gradientTolerance is used to flatten curves before the pieces of a gradient are cut out of a path.
+const gradientTolerance = 0.05
+
+// maxGradientSteps limits the number of pieces that are used to approximate a transition between two stops.
+const maxGradientSteps = 256
+
+// gradientFill is implemented by all gradient components.
+type gradientFill interface {
+	vit.Component
+	// gradientKey returns a string that describes the gradient. If it doesn't change, the pieces don't change either.
+	gradientKey() string
+	// gradientPieces splits the path into pieces that are filled with the gradient. Relative positions of the gradient refer to the bounds.
+	gradientPieces(path *canvas.Path, bounds vit.Rect) []gradientPiece
+}
+
+// gradientPiece is a part of a path that is either filled with a single color or a gradient.
+type gradientPiece struct {
+	path     *canvas.Path
+	color    color.RGBA
+	gradient canvas.Gradient
+}
+
+// gradientCache keeps the pieces of a gradient so that they only have to be recomputed if the gradient or the filled path changes.
+type gradientCache struct {
+	key    string
+	pieces []gradientPiece
+}
+
+// instantiateGradient creates the gradient that has been assigned to a component property.
+func instantiateGradient(value *vit.ComponentDefValue) (gradientFill, error) {
+	instance, err := parse.InstantiateComponent(value.ComponentDefinition(), value.Context())
+	if err != nil {
+		return nil, err
+	}
+	instance.UpdateExpressions(nil)
+	grad, ok := instance.(gradientFill)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a gradient", instance.ID())
+	}
+	return grad, nil
+}
+
+// fill draws the path using the gradient. The path is in the coordinate system of the context.
+func (c *gradientCache) fill(ctx vit.DrawingContext, grad gradientFill, path *canvas.Path, bounds vit.Rect) {
+	key := fmt.Sprintf("%s|%v|%s", grad.gradientKey(), bounds, path)
+	if key != c.key {
+		c.key = key
+		c.pieces = grad.gradientPieces(path.Flatten(gradientTolerance), bounds)
+	}
+
+	ctx.Push()
+	defer ctx.Pop()
+	ctx.SetStrokeWidth(0)
+	ctx.SetStrokeColor(canvas.Transparent)
+	for _, piece := range c.pieces {
+		if piece.gradient != nil {
+			ctx.SetFillGradient(piece.gradient)
+		} else {
+			ctx.SetFillColor(piece.color)
 		}
+		ctx.DrawPath(0, 0, piece.path)
+	}
+}
+
+// ConstructGradient returns a linear gradient between the two points that contains all stops.
+// Note that not all backends are able to draw gradients with more than two stops.
+func (g *Gradient) ConstructGradient(start, end canvas.Point) *canvas.LinearGradient {
+	grad := canvas.NewLinearGradient(start, end)
+	grad.Stops = gradientStops(g)
+	return grad
+}
+
+func (g *Gradient) gradientKey() string {
+	return fmt.Sprintf("linear %d %v %s", g.orientation.Int(), g.angle.Float64(), stopsKey(gradientStops(g)))
+}
+
+// direction returns the start and end point of the gradient in the bounds.
+// Like in CSS the gradient line goes through the center and is long enough for the corners to receive the first and last color.
+func (g *Gradient) direction(bounds vit.Rect) (canvas.Point, canvas.Point) {
+	degrees := g.angle.Float64()
+	if Gradient_Orientation(g.orientation.Int()) == Gradient_Orientation_Horizontal {
+		degrees -= 90
+	}
+	rad := degrees * math.Pi / 180
+	// a direction of 0 degrees runs from the top to the bottom
+	dir := canvas.Point{X: -math.Sin(rad), Y: math.Cos(rad)}
+	length := math.Abs(bounds.Width()*dir.X) + math.Abs(bounds.Height()*dir.Y)
+	center := canvas.Point{X: bounds.CenterX(), Y: bounds.CenterY()}
+	return center.Sub(dir.Mul(length / 2)), center.Add(dir.Mul(length / 2))
+}
+
+func (g *Gradient) gradientPieces(path *canvas.Path, bounds vit.Rect) []gradientPiece {
+	start, end := g.direction(bounds)
+	return linearGradientPieces(path, start, end, gradientStops(g))
+}
+
+func (g *RadialGradient) gradientKey() string {
+	return fmt.Sprintf("radial %v %v %v %v %s", g.centerX.Float64(), g.centerY.Float64(), g.horizontalRadius.Float64(), g.verticalRadius.Float64(), stopsKey(gradientStops(g)))
+}
+
+func (g *RadialGradient) gradientPieces(path *canvas.Path, bounds vit.Rect) []gradientPiece {
+	center := canvas.Point{
+		X: bounds.X1 + g.centerX.Float64()*bounds.Width(),
+		Y: bounds.Y1 + g.centerY.Float64()*bounds.Height(),
+	}
+	rx := g.horizontalRadius.Float64() * bounds.Width()
+	ry := g.verticalRadius.Float64() * bounds.Height()
+	return radialGradientPieces(path, center, rx, ry, gradientStops(g))
+}
+
+func (g *ConicalGradient) gradientKey() string {
+	return fmt.Sprintf("conical %v %v %v %s", g.centerX.Float64(), g.centerY.Float64(), g.angle.Float64(), stopsKey(gradientStops(g)))
+}
+
+func (g *ConicalGradient) gradientPieces(path *canvas.Path, bounds vit.Rect) []gradientPiece {
+	center := canvas.Point{
+		X: bounds.X1 + g.centerX.Float64()*bounds.Width(),
+		Y: bounds.Y1 + g.centerY.Float64()*bounds.Height(),
+	}
+	return conicalGradientPieces(path, center, g.angle.Float64()*math.Pi/180, gradientStops(g))
+}
+
+// gradientStops returns the stops of all GradientStop children sorted by their position.
+// Unlike canvas.Stops.Add multiple stops at the same position are kept which allows hard transitions.
+func gradientStops(comp vit.Component) canvas.Stops {
+	var stops canvas.Stops
+	for _, child := range comp.Children() {
 		stop, ok := child.(*GradientStop)
 		if !ok {
 			continue
 		}
+		stops = append(stops, canvas.Stop{
+			Offset: math.Max(0, math.Min(1, stop.position.Float64())),
+			Color:  stop.color.RGBAColor(),
+		})
+	}
+	sort.SliceStable(stops, func(i, j int) bool { return stops[i].Offset < stops[j].Offset })
+	return stops
+}
 
-		grad.Add(stop.position.Float64(), stop.color.RGBAColor())
+func stopsKey(stops canvas.Stops) string {
+	var b strings.Builder
+	for _, stop := range stops {
+		fmt.Fprintf(&b, "%v:%v;", stop.Offset, stop.Color)
 	}
+	return b.String()
+}
 
-	return grad
+// gradientSegment is the range between two neighbouring stops.
+type gradientSegment struct {
+	from, to    float64 // the visible part of the segment
+	first, last float64 // the positions of the stops
+	start, end  color.RGBA
+}
+
+// gradientSegments returns the transitions between all stops as well as the areas before the first and after the last stop which are filled with a single color.
+// Only the parts of the segments between min and max are returned.
+func gradientSegments(stops canvas.Stops, min, max float64) []gradientSegment {
+	first, last := stops[0], stops[len(stops)-1]
+	segments := []gradientSegment{{math.Inf(-1), first.Offset, first.Offset, first.Offset, first.Color, first.Color}}
+	for i := 1; i < len(stops); i++ {
+		segments = append(segments, gradientSegment{stops[i-1].Offset, stops[i].Offset, stops[i-1].Offset, stops[i].Offset, stops[i-1].Color, stops[i].Color})
+	}
+	segments = append(segments, gradientSegment{last.Offset, math.Inf(1), last.Offset, last.Offset, last.Color, last.Color})
+
+	var visible []gradientSegment
+	for _, segment := range segments {
+		segment.from = math.Max(segment.from, min)
+		segment.to = math.Min(segment.to, max)
+		if segment.to > segment.from {
+			visible = append(visible, segment)
+		}
+	}
+	return visible
+}
+
+// steps returns the number of single colored pieces that are used to approximate the segment if it has the given size.
+func (s gradientSegment) steps(size float64) int {
+	if s.start == s.end {
+		return 1
+	}
+	// two steps per unit but not more steps than there are different colors
+	steps := int(math.Ceil(size * 2))
+	if levels := maxChannelDifference(s.start, s.end); levels < steps {
+		steps = levels
+	}
+	if steps < 1 {
+		return 1
+	}
+	if steps > maxGradientSteps {
+		return maxGradientSteps
+	}
+	return steps
+}
+
+func maxChannelDifference(a, b color.RGBA) int {
+	diff := 0
+	for _, d := range []int{int(a.R) - int(b.R), int(a.G) - int(b.G), int(a.B) - int(b.B), int(a.A) - int(b.A)} {
+		if d < 0 {
+			d = -d
+		}
+		if d > diff {
+			diff = d
+		}
+	}
+	return diff
+}
+
+// colorAt returns the color of the segment at the position t.
+func (s gradientSegment) colorAt(t float64) color.RGBA {
+	if s.last <= s.first {
+		return s.start
+	}
+	t = math.Max(0, math.Min(1, (t-s.first)/(s.last-s.first)))
+	lerp := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a)*(1-t) + float64(b)*t))
+	}
+	return color.RGBA{lerp(s.start.R, s.end.R), lerp(s.start.G, s.end.G), lerp(s.start.B, s.end.B), lerp(s.start.A, s.end.A)}
+}
+
+func (s gradientSegment) opaque() bool {
+	return s.start.A == 255 && s.end.A == 255
+}
+
+// linearGradientPieces cuts the path into bands that are orthogonal to the line from start to end.
+func linearGradientPieces(path *canvas.Path, start, end canvas.Point, stops canvas.Stops) []gradientPiece {
+	if len(stops) == 0 {
+		return nil
+	}
+	d := end.Sub(start)
+	length := d.Length()
+	if len(stops) == 1 || length == 0 {
+		return []gradientPiece{{path: path, color: stops[len(stops)-1].Color}}
+	}
+	u := d.Div(length)
+	n := canvas.Point{X: -u.Y, Y: u.X}
+
+	// project the bounds of the path onto the gradient line
+	b := path.Bounds()
+	tMin, tMax, sMin, sMax := math.Inf(1), math.Inf(-1), math.Inf(1), math.Inf(-1)
+	for _, corner := range []canvas.Point{{X: b.X, Y: b.Y}, {X: b.X + b.W, Y: b.Y}, {X: b.X, Y: b.Y + b.H}, {X: b.X + b.W, Y: b.Y + b.H}} {
+		p := corner.Sub(start)
+		t := p.Dot(u) / length
+		s := p.Dot(n)
+		tMin, tMax = math.Min(tMin, t), math.Max(tMax, t)
+		sMin, sMax = math.Min(sMin, s), math.Max(sMax, s)
+	}
+	sMin, sMax = sMin-1, sMax+1
+	at := func(t, s float64) canvas.Point {
+		return start.Add(u.Mul(t * length)).Add(n.Mul(s))
+	}
+	band := func(t0, t1 float64) *canvas.Path {
+		p := &canvas.Path{}
+		p.MoveTo(at(t0, sMin).X, at(t0, sMin).Y)
+		p.LineTo(at(t1, sMin).X, at(t1, sMin).Y)
+		p.LineTo(at(t1, sMax).X, at(t1, sMax).Y)
+		p.LineTo(at(t0, sMax).X, at(t0, sMax).Y)
+		p.Close()
+		return p
+	}
+	// Opaque pieces reach until the end of the path and are covered by the following pieces. This prevents visible seams.
+	opaque := opaqueStops(stops)
+	area := func(t0, t1 float64) *canvas.Path {
+		if opaque {
+			return band(t0, tMax)
+		}
+		return band(t0, t1)
+	}
+
+	var pieces []gradientPiece
+	for _, segment := range gradientSegments(stops, tMin, tMax) {
+		if segment.start == segment.end {
+			pieces = appendPiece(pieces, path, area(segment.from, segment.to), gradientPiece{color: segment.start})
+			continue
+		}
+		if segment.opaque() {
+			// the gradient pads the area after its end with the last color
+			grad := canvas.NewLinearGradient(at(segment.from, 0), at(segment.to, 0))
+			grad.Add(0, segment.colorAt(segment.from))
+			grad.Add(1, segment.colorAt(segment.to))
+			pieces = appendPiece(pieces, path, area(segment.from, segment.to), gradientPiece{gradient: grad})
+			continue
+		}
+		// Translucent strips can't overlap and every pixel that is shared by two strips is slightly too transparent.
+		// Only using one strip per unit keeps the number of shared pixels low.
+		steps := segment.steps((segment.to - segment.from) * length / 2)
+		size := (segment.to - segment.from) / float64(steps)
+		for i := 0; i < steps; i++ {
+			t0 := segment.from + float64(i)*size
+			pieces = appendPiece(pieces, path, area(t0, t0+size), gradientPiece{color: segment.colorAt(t0 + size/2)})
+		}
+	}
+	return pieces
+}
+
+// opaqueStops returns true if all stops are opaque.
+func opaqueStops(stops canvas.Stops) bool {
+	for _, stop := range stops {
+		if stop.Color.A != 255 {
+			return false
+		}
+	}
+	return true
+}
+
+// radialGradientPieces cuts the path into elliptic rings around the center.
+func radialGradientPieces(path *canvas.Path, center canvas.Point, rx, ry float64, stops canvas.Stops) []gradientPiece {
+	if len(stops) == 0 {
+		return nil
+	}
+	if len(stops) == 1 || rx <= 0 || ry <= 0 {
+		return []gradientPiece{{path: path, color: stops[len(stops)-1].Color}}
+	}
+
+	// the largest relative distance of the path to the center
+	b := path.Bounds()
+	tMax := 0.0
+	for _, corner := range []canvas.Point{{X: b.X, Y: b.Y}, {X: b.X + b.W, Y: b.Y}, {X: b.X, Y: b.Y + b.H}, {X: b.X + b.W, Y: b.Y + b.H}} {
+		tMax = math.Max(tMax, math.Hypot((corner.X-center.X)/rx, (corner.Y-center.Y)/ry))
+	}
+	ellipse := func(t float64) *canvas.Path {
+		return canvas.Ellipse(rx*t, ry*t).Translate(center.X, center.Y).Flatten(gradientTolerance)
+	}
+
+	// rings are collected from the inside to the outside
+	type ring struct {
+		from, to float64
+		color    color.RGBA
+	}
+	var rings []ring
+	for _, segment := range gradientSegments(stops, 0, tMax) {
+		steps := segment.steps((segment.to - segment.from) * math.Max(rx, ry))
+		size := (segment.to - segment.from) / float64(steps)
+		for i := 0; i < steps; i++ {
+			t0 := segment.from + float64(i)*size
+			rings = append(rings, ring{t0, t0 + size, segment.colorAt(t0 + size/2)})
+		}
+	}
+
+	var pieces []gradientPiece
+	if opaqueStops(stops) {
+		// Opaque rings are drawn as ellipses from the outside to the inside so that they overlap without seams.
+		for i := len(rings) - 1; i >= 0; i-- {
+			if i == len(rings)-1 {
+				pieces = append(pieces, gradientPiece{path: path, color: rings[i].color})
+			} else {
+				pieces = appendPiece(pieces, path, ellipse(rings[i].to), gradientPiece{color: rings[i].color})
+			}
+		}
+		return pieces
+	}
+	for i, r := range rings {
+		var area *canvas.Path
+		switch {
+		case i == len(rings)-1:
+			area = path.Not(ellipse(r.from))
+		case r.from == 0:
+			area = ellipse(r.to)
+		default:
+			area = ellipse(r.to).Not(ellipse(r.from))
+		}
+		pieces = appendPiece(pieces, path, area, gradientPiece{color: r.color})
+	}
+	return pieces
+}
+
+// conicalGradientPieces cuts the path into wedges around the center. The first stop is at the given angle in radians.
+func conicalGradientPieces(path *canvas.Path, center canvas.Point, angle float64, stops canvas.Stops) []gradientPiece {
+	if len(stops) == 0 {
+		return nil
+	}
+	if len(stops) == 1 {
+		return []gradientPiece{{path: path, color: stops[0].Color}}
+	}
+
+	b := path.Bounds()
+	radius := 1.0
+	for _, corner := range []canvas.Point{{X: b.X, Y: b.Y}, {X: b.X + b.W, Y: b.Y}, {X: b.X, Y: b.Y + b.H}, {X: b.X + b.W, Y: b.Y + b.H}} {
+		radius = math.Max(radius, corner.Sub(center).Length()+1)
+	}
+	// the edges between the points of a wedge are at most 1/8 of a turn long and must not cut into the path
+	radius /= math.Cos(math.Pi / 8)
+	wedge := func(t0, t1 float64) *canvas.Path {
+		p := &canvas.Path{}
+		p.MoveTo(center.X, center.Y)
+		n := int(math.Ceil((t1 - t0) * 8))
+		for i := 0; i <= n; i++ {
+			a := angle + (t0+(t1-t0)*float64(i)/float64(n))*2*math.Pi
+			p.LineTo(center.X+radius*math.Cos(a), center.Y+radius*math.Sin(a))
+		}
+		p.Close()
+		return p
+	}
+
+	// like linear gradients opaque wedges reach until the end and are covered by the following ones
+	opaque := opaqueStops(stops)
+	var pieces []gradientPiece
+	for _, segment := range gradientSegments(stops, 0, 1) {
+		// at most two steps for each degree
+		steps := segment.steps((segment.to - segment.from) * 360)
+		size := (segment.to - segment.from) / float64(steps)
+		for i := 0; i < steps; i++ {
+			t0 := segment.from + float64(i)*size
+			t1 := t0 + size
+			if opaque {
+				t1 = 1
+			}
+			pieces = appendPiece(pieces, path, wedge(t0, t1), gradientPiece{color: segment.colorAt(t0 + size/2)})
+		}
+	}
+	return pieces
+}
+
+// appendPiece cuts the area out of the path and appends it as a piece if it isn't empty.
+func appendPiece(pieces []gradientPiece, path, area *canvas.Path, piece gradientPiece) []gradientPiece {
+	piece.path = path.And(area)
+	if piece.path.Empty() {
+		return pieces
+	}
+	return append(pieces, piece)
 }
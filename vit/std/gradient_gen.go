@@ -12,9 +12,30 @@ func newFileContextForGradient(globalCtx *vit.GlobalContext) (*vit.FileContext,
 	return vit.NewFileContext(globalCtx), nil
 }
 
+type Gradient_Orientation uint
+
+const (
+	Gradient_Orientation_Vertical   Gradient_Orientation = 0
+	Gradient_Orientation_Horizontal Gradient_Orientation = 1
+)
+
+func (enum Gradient_Orientation) String() string {
+	switch enum {
+	case Gradient_Orientation_Vertical:
+		return "Vertical"
+	case Gradient_Orientation_Horizontal:
+		return "Horizontal"
+	default:
+		return "<unknownOrientation>"
+	}
+}
+
 type Gradient struct {
 	*Item
 	id string
+
+	orientation vit.IntValue
+	angle       vit.FloatValue
 }
 
 // newGradientInGlobal creates an appropriate file context for the component and then returns a new Gradient instance.
@@ -29,13 +50,21 @@ func newGradientInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary pa
 }
 func NewGradient(id string, context *vit.FileContext) *Gradient {
 	g := &Gradient{
-		Item: NewItem("", context),
-		id:   id,
+		Item:        NewItem("", context),
+		id:          id,
+		orientation: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Orientation.Vertical", Position: nil}),
+		angle:       *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
 	}
 	// property assignments on embedded components
 	// register listeners for when a property changes
 	// register event listeners
 	// register enumerations
+	g.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "Orientation",
+		Position: nil,
+		Values:   map[string]int{"Vertical": 0, "Horizontal": 1},
+	})
 	// add child components
 
 	context.RegisterComponent("", g)
@@ -49,6 +78,10 @@ func (g *Gradient) String() string {
 
 func (g *Gradient) Property(key string) (vit.Value, bool) {
 	switch key {
+	case "orientation":
+		return &g.orientation, true
+	case "angle":
+		return &g.angle, true
 	default:
 		return g.Item.Property(key)
 	}
@@ -65,6 +98,10 @@ func (g *Gradient) MustProperty(key string) vit.Value {
 func (g *Gradient) SetProperty(key string, value interface{}) error {
 	var err error
 	switch key {
+	case "orientation":
+		err = g.orientation.SetValue(value)
+	case "angle":
+		err = g.angle.SetValue(value)
 	default:
 		return g.Item.SetProperty(key, value)
 	}
@@ -76,6 +113,10 @@ func (g *Gradient) SetProperty(key string, value interface{}) error {
 
 func (g *Gradient) SetPropertyCode(key string, code vit.Code) error {
 	switch key {
+	case "orientation":
+		g.orientation.SetCode(code)
+	case "angle":
+		g.angle.SetCode(code)
 	default:
 		return g.Item.SetPropertyCode(key, code)
 	}
@@ -91,6 +132,10 @@ func (g *Gradient) Event(name string) (vit.Listenable, bool) {
 
 func (g *Gradient) ResolveVariable(key string) (interface{}, bool) {
 	switch key {
+	case "orientation":
+		return &g.orientation, true
+	case "angle":
+		return &g.angle, true
 	default:
 		return g.Item.ResolveVariable(key)
 	}
@@ -122,6 +167,18 @@ func (g *Gradient) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup
 		context = g
 	}
 	// properties
+	if changed, err := g.orientation.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Gradient", "orientation", g.id, err))
+		}
+	}
+	if changed, err := g.angle.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Gradient", "angle", g.id, err))
+		}
+	}
 
 	// methods
 
@@ -146,3 +203,14 @@ func (g *Gradient) ID() string {
 func (g *Gradient) Finish() error {
 	return g.RootC().FinishInContext(g)
 }
+
+func (g *Gradient) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "Vertical":
+		return uint(Gradient_Orientation_Vertical), true
+	case "Horizontal":
+		return uint(Gradient_Orientation_Horizontal), true
+	default:
+		return nil, false
+	}
+}
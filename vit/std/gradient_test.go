@@ -0,0 +1,96 @@
+package std
+
+import (
+	"image/color"
+	"testing"
+)
+
+const gradientSource = `import Vit 1.0
+
+Item {
+    width: 500
+    height: 100
+
+    Rectangle {
+        width: 100
+        height: 100
+        gradient: Gradient {
+            GradientStop { position: 0; color: "red" }
+            GradientStop { position: 0.5; color: "lime" }
+            GradientStop { position: 1; color: "blue" }
+        }
+    }
+
+    Rectangle {
+        x: 100
+        width: 100
+        height: 100
+        gradient: Gradient {
+            orientation: Gradient.Horizontal
+            GradientStop { position: 0; color: "black" }
+            GradientStop { position: 0.5; color: "black" }
+            GradientStop { position: 0.5; color: "white" }
+            GradientStop { position: 1; color: "white" }
+        }
+    }
+
+    Rectangle {
+        x: 200
+        width: 100
+        height: 100
+        gradient: Gradient {
+            GradientStop { position: 0; color: "transparent" }
+            GradientStop { position: 1; color: "red" }
+        }
+    }
+
+    Rectangle {
+        x: 300
+        width: 100
+        height: 100
+        gradient: RadialGradient {
+            GradientStop { position: 0; color: "white" }
+            GradientStop { position: 1; color: "black" }
+        }
+    }
+
+    Rectangle {
+        x: 400
+        width: 100
+        height: 100
+        gradient: ConicalGradient {
+            GradientStop { position: 0; color: "red" }
+            GradientStop { position: 0.5; color: "blue" }
+            GradientStop { position: 1; color: "red" }
+        }
+    }
+}
+`
+
+func TestGradients(t *testing.T) {
+	manager, _ := loadTestComponent(t, gradientSource)
+
+	img := renderComponent(t, manager.MainComponent(), 500, 100)
+	checkPixels(t, img, []pixelTest{
+		// linear gradient with three stops
+		{50, 1, color.RGBA{252, 3, 0, 255}},
+		{50, 25, color.RGBA{126, 129, 0, 255}},
+		{50, 50, color.RGBA{0, 254, 1, 255}},
+		{50, 75, color.RGBA{0, 126, 129, 255}},
+		{50, 98, color.RGBA{0, 3, 252, 255}},
+		// horizontal gradient with a hard transition
+		{148, 50, color.RGBA{0, 0, 0, 255}},
+		{152, 50, color.RGBA{255, 255, 255, 255}},
+		// transparency
+		{250, 1, color.RGBA{0, 0, 0, 0}},
+		{250, 50, color.RGBA{128, 0, 0, 128}},
+		// radial gradient
+		{350, 50, color.RGBA{255, 255, 255, 255}},
+		{375, 50, color.RGBA{128, 128, 128, 255}},
+		{302, 2, color.RGBA{0, 0, 0, 255}},
+		// conical gradient
+		{495, 50, color.RGBA{255, 0, 0, 255}},
+		{405, 50, color.RGBA{0, 0, 255, 255}},
+		{450, 95, color.RGBA{128, 0, 128, 255}},
+	}, 12)
+}
@@ -0,0 +1,68 @@
+package std
+
+import (
+	"image"
+	"image/color"
+	"os"
+	"testing"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/vfont"
+	"github.com/omniskop/vitrum/vit/vpath"
+	"github.com/tdewolff/canvas"
+	"github.com/tdewolff/canvas/renderers/rasterizer"
+)
+
+// TestMain makes the test font available and uses it for all families so that text is laid out even where no fonts are installed.
+func TestMain(m *testing.M) {
+	err := vfont.RegisterFontPath(testfont.Family, vfont.Style{Weight: vfont.Normal}, vpath.FS(testfont.FS, testfont.Name))
+	if err != nil {
+		panic(err)
+	}
+	vfont.SetFallbackFont(testfont.Family)
+	os.Exit(m.Run())
+}
+
+// renderComponent draws the component into an image of the given size with one pixel per unit.
+func renderComponent(t *testing.T, comp vit.Component, width, height float64) *image.RGBA {
+	t.Helper()
+	return renderComponentAt(t, comp, width, height, canvas.DPMM(1))
+}
+
+// renderComponentAt draws the component into an image of the given size in units using the resolution for the image and for effects.
+func renderComponentAt(t *testing.T, comp vit.Component, width, height float64, resolution canvas.Resolution) *image.RGBA {
+	t.Helper()
+	c := canvas.New(width, height)
+	ctx := canvas.NewContext(c)
+	ctx.SetCoordSystem(canvas.CartesianIV)
+	err := comp.Draw(vit.DrawingContext{Context: ctx, Resolution: resolution}, vit.NewRect(0, 0, width, height))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rasterizer.Draw(c, resolution, canvas.LinearColorSpace{})
+}
+
+// pixelTest is the color that is expected at a pixel.
+type pixelTest struct {
+	x, y     int
+	expected color.RGBA
+}
+
+// checkPixels reports every pixel whose color differs from the expected one by more than the tolerance in any channel.
+func checkPixels(t *testing.T, img *image.RGBA, tests []pixelTest, tolerance int) {
+	t.Helper()
+	for _, test := range tests {
+		if actual := img.RGBAAt(test.x, test.y); !similarColor(actual, test.expected, tolerance) {
+			t.Errorf("(%d, %d): expected %v, got %v", test.x, test.y, test.expected, actual)
+		}
+	}
+}
+
+func similarColor(a, b color.RGBA, tolerance int) bool {
+	diff := func(x, y uint8) bool {
+		d := int(x) - int(y)
+		return d <= tolerance && d >= -tolerance
+	}
+	return diff(a.R, b.R) && diff(a.G, b.G) && diff(a.B, b.B) && diff(a.A, b.A)
+}
@@ -1,66 +1,632 @@
 package std
 
 import (
-	"path/filepath"
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/draw"
+	"image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+	"strings"
+	"time"
 
 	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/vimage"
+	"github.com/omniskop/vitrum/vit/vpath"
 	"github.com/tdewolff/canvas"
+	_ "golang.org/x/image/bmp"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
 )
 
-type img canvas.Image
+// ImageStatusEvent is fired by an Image when its status changes.
+type ImageStatusEvent struct {
+	Status Image_Status
+}
+
+// img is the decoded content of an image file. It is either a raster image with one or more frames or a vector image.
+// Decoded images are shared through the imageCache and must not be modified afterwards.
+type img struct {
+	frames []canvas.Image  // all frames of raster images, fully composed
+	delays []time.Duration // how long each frame is shown for animated images
+	loops  int             // like gif.GIF.LoopCount
+	vector *canvas.Canvas  // the content of vector images
+	source int             // size of the svg document of vector images
+	width  float64         // natural size of the image
+	height float64
+}
+
+// memorySize estimates the number of bytes the image occupies in memory.
+// Vector images are assumed to take up about as much memory as their svg document.
+func (d *img) memorySize() int {
+	size := d.source
+	for _, frame := range d.frames {
+		bounds := frame.Bounds()
+		size += bounds.Dx()*bounds.Dy()*4 + len(frame.Bytes)
+	}
+	return size
+}
+
+// frameCount returns the number of frames. Vector images always have a single frame.
+func (d *img) frameCount() int {
+	if d.vector != nil {
+		return 1
+	}
+	return len(d.frames)
+}
+
+// loadState keeps track of the image that is being loaded and where it comes from.
+type loadState struct {
+	request    int  // incremented for every load so that the results of outdated asynchronous loads can be discarded
+	provided   bool // the image comes from a provider
+	scheme, id string
+	generation int // generation of a provided image at the time it has been requested
+}
 
 func (i *Image) reloadImage() {
 	path := i.path.String()
+	i.loading = loadState{request: i.loading.request + 1}
 	if path == "" {
 		i.imageData = nil
+		i.SetContentSize(0, 0)
+		i.setStatus(Image_Status_Null)
 		return
 	}
-	pos, ok := i.path.Position()
-	if !ok {
-		i.Context().Global.Environment.Logger().Printf("failed to open image file: path string has no position\r\n")
+	i.setStatus(Image_Status_Loading)
+
+	key := imageKey{size: i.requestedSize()}
+	if vimage.IsProviderURL(path) {
+		scheme, id, err := vimage.ParseURL(path)
+		if err != nil {
+			i.fail("failed to load image: %s", err)
+			return
+		}
+		// the generation is read first so that a refresh while the image is being created isn't missed
+		key.url = path
+		key.generation = vimage.Generation(scheme, id)
+		i.loading.provided = true
+		i.loading.scheme, i.loading.id, i.loading.generation = scheme, id, key.generation
+	} else {
+		pos, ok := i.path.Position()
+		if !ok {
+			i.fail("failed to open image file: path string has no position")
+			return
+		}
+		key.file = vpath.Join(pos.FilePath.Dir(), path)
+	}
+
+	if !i.asynchronous.Bool() {
+		data, err := loadImage(key)
+		i.finishLoading(data, err)
 		return
 	}
+	request := i.loading.request
+	i.Context().Global.Environment.RunInBackground(func() func() {
+		data, err := loadImage(key)
+		return func() {
+			// the path might have been changed in the meantime
+			if i.loading.request == request {
+				i.finishLoading(data, err)
+			}
+		}
+	})
+}
+
+// loadImage returns the decoded image from the cache or loads it. It is safe to call from any goroutine.
+func loadImage(key imageKey) (*img, error) {
+	return imageCache.load(key, func() (*img, error) {
+		if key.url != "" {
+			provided, err := vimage.Load(key.url, key.size)
+			if err != nil {
+				return nil, err
+			}
+			return rasterImage(provided), nil
+		}
+		file, err := key.file.OpenFile()
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		data, err := decodeImage(file)
+		if err != nil {
+			return nil, err
+		}
+		data.fitSourceSize(key.size)
+		return data, nil
+	})
+}
 
-	file, err := pos.FilePath.Dir().Open(path)
+func (i *Image) finishLoading(data *img, err error) {
 	if err != nil {
-		i.Context().Global.Environment.Logger().Printf("failed to open image file: %s\r\n", err)
+		i.fail("failed to load image %s: %s", i.path.String(), err)
+		return
+	}
+	i.imageData = data
+	i.SetContentSize(data.width, data.height)
+	i.setStatus(Image_Status_Ready)
+}
+
+// requestedSize returns the sourceSize. Negative dimensions are treated as unset.
+func (i *Image) requestedSize() image.Point {
+	size := image.Point{
+		X: i.sourceSize.MustGet("width").GetValue().(int),
+		Y: i.sourceSize.MustGet("height").GetValue().(int),
+	}
+	if size.X < 0 {
+		size.X = 0
+	}
+	if size.Y < 0 {
+		size.Y = 0
+	}
+	return size
+}
+
+// refreshProvided reloads an image of a provider if it has been refreshed since it has been requested.
+func (i *Image) refreshProvided() {
+	if !i.loading.provided || vimage.Generation(i.loading.scheme, i.loading.id) == i.loading.generation {
+		return
+	}
+	i.reloadImage()
+	// the layout might depend on the size of the image which has only been updated now
+	i.Context().Global.Environment.ScheduleRedraw(time.Now())
+}
+
+// fail logs the error and removes the previous image.
+func (i *Image) fail(format string, args ...any) {
+	i.Context().Global.Environment.Logger().Printf(format+"\r\n", args...)
+	i.imageData = nil
+	i.SetContentSize(0, 0)
+	i.setStatus(Image_Status_Error)
+}
+
+func (i *Image) setStatus(status Image_Status) {
+	if Image_Status(i.status.Int()) == status {
 		return
 	}
-	defer file.Close()
+	i.status.SetIntValue(int(status))
+	i.onStatusChanged.Fire(&ImageStatusEvent{status})
+}
+
+// decodeImage reads an image file. The format is detected by the content of the file.
+// Raster images can be in any format that has been registered with the image package. Next to png and jpeg this includes gif, bmp, tiff and webp.
+func decodeImage(r io.Reader) (*img, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if isSVG(data) {
+		return decodeSVG(data)
+	}
 
-	var loaded canvas.Image
-	ext := filepath.Ext(path)
-	switch ext {
-	case ".png":
-		loaded, err = canvas.NewPNGImage(file)
-	case ".jpg", ".jpeg":
-		loaded, err = canvas.NewJPEGImage(file)
+	_, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
 	}
+	if format == "gif" {
+		return decodeGIF(data)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
 	if err != nil {
-		i.Context().Global.Environment.Logger().Printf("failed to parse image %s: %s\r\n", path, err)
+		return nil, err
+	}
+	result := rasterImage(decoded)
+	if format == "png" || format == "jpeg" {
+		// renderers can embed the original data of these formats
+		result.frames[0].Mimetype = "image/" + format
+		result.frames[0].Bytes = data
+	}
+	return result, nil
+}
+
+// rasterImage wraps a single decoded image.
+func rasterImage(decoded image.Image) *img {
+	size := decoded.Bounds().Size()
+	return &img{
+		frames: []canvas.Image{{Image: decoded}},
+		width:  float64(size.X),
+		height: float64(size.Y),
+	}
+}
+
+// fitSourceSize scales raster images down to the requested size while preserving the aspect ratio.
+// Vector images only take on the size as their natural size as they stay sharp at any size anyway.
+func (d *img) fitSourceSize(size image.Point) {
+	if (size.X == 0 && size.Y == 0) || d.width <= 0 || d.height <= 0 {
+		return
+	}
+	scale := float64(size.X) / d.width
+	if size.X == 0 || (size.Y != 0 && float64(size.Y)/d.height < scale) {
+		scale = float64(size.Y) / d.height
+	}
+	if d.vector == nil && scale >= 1 {
+		return
+	}
+	d.width = math.Max(1, math.Round(d.width*scale))
+	d.height = math.Max(1, math.Round(d.height*scale))
+	if d.vector != nil {
 		return
 	}
-	i.imageData = (*img)(&loaded)
+	for index, frame := range d.frames {
+		scaled := image.NewRGBA(image.Rect(0, 0, int(d.width), int(d.height)))
+		xdraw.CatmullRom.Scale(scaled, scaled.Bounds(), frame.Image, frame.Image.Bounds(), xdraw.Src, nil)
+		// the original data can't be embedded anymore
+		d.frames[index] = canvas.Image{Image: scaled}
+	}
+}
+
+// isSVG returns true if the data looks like an svg document.
+func isSVG(data []byte) bool {
+	if len(data) > 4096 {
+		data = data[:4096]
+	}
+	content := strings.TrimSpace(strings.TrimPrefix(string(data), "\ufeff"))
+	return strings.HasPrefix(content, "<") && strings.Contains(content, "<svg")
+}
+
+// decodeGIF decodes all frames of a gif. Every frame is composed with the previous ones according to the disposal methods.
+func decodeGIF(data []byte) (*img, error) {
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	for _, frame := range g.Image {
+		bounds = bounds.Union(frame.Bounds())
+	}
+	decoded := &img{
+		loops:  g.LoopCount,
+		width:  float64(bounds.Dx()),
+		height: float64(bounds.Dy()),
+	}
+	current := image.NewRGBA(bounds)
+	for index, frame := range g.Image {
+		var disposal byte
+		if index < len(g.Disposal) {
+			disposal = g.Disposal[index]
+		}
+		var previous *image.RGBA
+		if disposal == gif.DisposalPrevious {
+			previous = cloneRGBA(current)
+		}
+		draw.Draw(current, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		decoded.frames = append(decoded.frames, canvas.Image{Image: cloneRGBA(current)})
+		decoded.delays = append(decoded.delays, gifDelay(g.Delay, index))
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(current, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			current = previous
+		}
+	}
+	return decoded, nil
+}
+
+// gifDelay returns how long the frame is shown. Like browsers very short delays are extended to 100ms.
+func gifDelay(delays []int, index int) time.Duration {
+	if index >= len(delays) || delays[index] <= 1 {
+		return 100 * time.Millisecond
+	}
+	return time.Duration(delays[index]) * 10 * time.Millisecond
+}
 
-	i.SetContentSize(float64(loaded.Bounds().Dx()), float64(loaded.Bounds().Dy()))
+func cloneRGBA(src *image.RGBA) *image.RGBA {
+	dst := image.NewRGBA(src.Rect)
+	copy(dst.Pix, src.Pix)
+	return dst
+}
+
+// decodeSVG parses an svg document which is kept as vectors.
+func decodeSVG(data []byte) (*img, error) {
+	vector, err := canvas.ParseSVG(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	width, height := svgSize(data, vector)
+	return &img{vector: vector, source: len(data), width: width, height: height}, nil
+}
+
+// svgSize returns the natural size of an svg document in pixels.
+// The parser returns the size in millimeters if it is derived from the viewBox and in pixels if the width and height are given.
+func svgSize(data []byte, vector *canvas.Canvas) (float64, float64) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		element, ok := token.(xml.StartElement)
+		if !ok || element.Name.Local != "svg" {
+			continue
+		}
+		var hasWidth, hasHeight bool
+		for _, attr := range element.Attr {
+			relative := strings.HasSuffix(strings.TrimSpace(attr.Value), "%")
+			switch attr.Name.Local {
+			case "width":
+				hasWidth = !relative
+			case "height":
+				hasHeight = !relative
+			}
+		}
+		if hasWidth && hasHeight {
+			return vector.W, vector.H
+		}
+		break
+	}
+	return vector.W * 96 / 25.4, vector.H * 96 / 25.4
 }
 
 func (i *Image) Draw(ctx vit.DrawingContext, area vit.Rect) error {
-	rect := i.Bounds()
+	i.refreshProvided()
+	i.drawFrame(ctx, 0)
+	return i.Root.DrawChildren(ctx, i.Bounds())
+}
+
+// maxImageTiles limits the number of tiles that are drawn so that tiny images don't stall the drawing.
+const maxImageTiles = 4096
+
+// drawFrame draws the frame with the given index according to the fill mode and alignment.
+func (i *Image) drawFrame(ctx vit.DrawingContext, frame int) {
+	data := i.imageData
+	if data == nil || data.width <= 0 || data.height <= 0 {
+		return
+	}
+	i.scaledParts.begin(data)
+	bounds := i.Bounds()
+	whole := vit.NewRect(0, 0, data.width, data.height)
+	mode := Image_FillMode(i.fillMode.Int())
+	placed := i.align(i.fill(bounds, whole, mode), bounds)
+	switch mode {
+	case Image_FillMode_Tile, Image_FillMode_TileVertically, Image_FillMode_TileHorizontally:
+		for _, tile := range tiles(placed, bounds) {
+			data.draw(ctx, frame, whole, tile, bounds, &i.scaledParts)
+		}
+	default:
+		data.draw(ctx, frame, whole, placed, bounds, &i.scaledParts)
+	}
+}
+
+// align moves a rectangle that has been centered in the space by fill according to the alignment.
+func (i *Image) align(rect vit.Rect, space vit.Rect) vit.Rect {
+	switch Image_HorizontalAlignment(i.horizontalAlignment.Int()) {
+	case Image_HorizontalAlignment_AlignLeft:
+		rect = rect.MovedX(space.X1 - rect.X1)
+	case Image_HorizontalAlignment_AlignRight:
+		rect = rect.MovedX(space.X2 - rect.X2)
+	}
+	switch Image_VerticalAlignment(i.verticalAlignment.Int()) {
+	case Image_VerticalAlignment_AlignTop:
+		rect = rect.MovedY(space.Y1 - rect.Y1)
+	case Image_VerticalAlignment_AlignBottom:
+		rect = rect.MovedY(space.Y2 - rect.Y2)
+	}
+	return rect
+}
+
+// tiles returns all rectangles with the size of the tile that are necessary to cover the space. They are aligned to the given tile.
+func tiles(tile vit.Rect, space vit.Rect) []vit.Rect {
+	width, height := tile.Width(), tile.Height()
+	if width <= 0 || height <= 0 || space.Empty() {
+		return nil
+	}
+	startX := tile.X1 - math.Ceil((tile.X1-space.X1)/width)*width
+	startY := tile.Y1 - math.Ceil((tile.Y1-space.Y1)/height)*height
+	// a small tolerance prevents tiles that would only cover rounding errors
+	columns := int(math.Ceil((space.X2-startX)/width - 1e-9))
+	rows := int(math.Ceil((space.Y2-startY)/height - 1e-9))
+	var out []vit.Rect
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			if len(out) == maxImageTiles {
+				return out
+			}
+			out = append(out, vit.NewRect(startX+float64(column)*width, startY+float64(row)*height, width, height))
+		}
+	}
+	return out
+}
 
-	if i.imageData != nil {
-		imageBounds := vit.ImageRect(i.imageData.Image.Bounds())
-		finalBounds := i.fill(rect, imageBounds, Image_FillMode(i.fillMode.Int()))
+// draw draws the part src of the frame into the target rectangle. Everything outside of clip is cut off.
+// The source rectangle is given in the natural size of the image. Parts of raster images that need to be scaled are kept in the cache.
+func (d *img) draw(ctx vit.DrawingContext, frame int, src vit.Rect, target vit.Rect, clip vit.Rect, cache *partCache) {
+	visible := target.Intersection(clip)
+	if visible.Empty() || src.Empty() {
+		return
+	}
+	scaleX := target.Width() / src.Width()
+	scaleY := target.Height() / src.Height()
 
-		scaleX := finalBounds.Width() / imageBounds.Width()
-		scaleY := finalBounds.Height() / imageBounds.Height()
+	if d.vector != nil {
+		// the whole image is drawn and cut off at the visible area
+		whole := vit.Rect{
+			X1: target.X1 - src.X1*scaleX,
+			Y1: target.Y1 - src.Y1*scaleY,
+			X2: target.X1 + (d.width-src.X1)*scaleX,
+			Y2: target.Y1 + (d.height-src.Y1)*scaleY,
+		}
+		var clipPath *canvas.Path
+		if visible != whole {
+			clipPath = canvas.Rectangle(visible.Width(), visible.Height()).Translate(visible.X1, visible.Y1).Transform(rendererView(ctx))
+		}
+		drawVector(ctx, d.vector, placement(whole, d.vector.W, d.vector.H), clipPath)
+		return
+	}
 
-		ctx.ScaleAbout(scaleX, scaleY, finalBounds.X1, finalBounds.Y1)
-		ctx.DrawImage(finalBounds.X1, finalBounds.Y1, i.imageData, canvas.Resolution(1))
-		ctx.ScaleAbout(1/scaleX, 1/scaleY, finalBounds.X1, finalBounds.Y1)
+	if frame < 0 || frame >= len(d.frames) {
+		return
+	}
+	picture := d.frames[frame]
+	pixels := picture.Bounds()
+	pixelsX := float64(pixels.Dx()) / d.width
+	pixelsY := float64(pixels.Dy()) / d.height
+	// only the visible pixels are drawn as renderers can't cut off images
+	part := image.Rect(
+		pixels.Min.X+int(math.Round((src.X1+(visible.X1-target.X1)/scaleX)*pixelsX)),
+		pixels.Min.Y+int(math.Round((src.Y1+(visible.Y1-target.Y1)/scaleY)*pixelsY)),
+		pixels.Min.X+int(math.Round((src.X1+(visible.X2-target.X1)/scaleX)*pixelsX)),
+		pixels.Min.Y+int(math.Round((src.Y1+(visible.Y2-target.Y1)/scaleY)*pixelsY)),
+	).Intersect(pixels)
+	if part.Empty() {
+		return
 	}
+	dest := vit.Rect{
+		X1: target.X1 + (float64(part.Min.X-pixels.Min.X)/pixelsX-src.X1)*scaleX,
+		Y1: target.Y1 + (float64(part.Min.Y-pixels.Min.Y)/pixelsY-src.Y1)*scaleY,
+		X2: target.X1 + (float64(part.Max.X-pixels.Min.X)/pixelsX-src.X1)*scaleX,
+		Y2: target.Y1 + (float64(part.Max.Y-pixels.Min.Y)/pixelsY-src.Y1)*scaleY,
+	}
+	if part != pixels {
+		// the original data of the image can't be embedded anymore
+		picture = canvas.Image{Image: cache.scaledPart(frame, picture.Image, part, scaledSize(dest, ctx.PixelResolution()))}
+	}
+	// the image is passed to the renderer directly as Context.DrawImage doesn't place it correctly if the view contains a translation
+	size := picture.Bounds().Size()
+	ctx.RenderImage(picture, rendererView(ctx).Mul(placement(dest, float64(size.X), float64(size.Y))))
+}
+
+// placement returns the transformation that maps content with the given size into the rectangle.
+// Image content uses a coordinate system with the y axis pointing up which is mapped into the coordinate system of the components.
+func placement(rect vit.Rect, width, height float64) canvas.Matrix {
+	return canvas.Identity.Translate(rect.X1, rect.Y1).Scale(rect.Width()/width, rect.Height()/height).Translate(0, height).ReflectY()
+}
 
-	return i.Root.DrawChildren(ctx, rect)
+// scaledSize returns the number of pixels the rectangle covers in the output.
+func scaledSize(dest vit.Rect, resolution canvas.Resolution) image.Point {
+	return image.Pt(int(math.Round(dest.Width()*resolution.DPMM())), int(math.Round(dest.Height()*resolution.DPMM())))
+}
+
+// scaledPart returns the part of the image scaled to the size it will have in the output.
+// Renderers blur the edges of images when they scale them up which would be visible where parts of an image meet, like in a BorderImage.
+func scaledPart(src image.Image, part image.Rectangle, size image.Point) image.Image {
+	if size.X <= 0 || size.Y <= 0 || size.X*size.Y > maxLayerPixels || (size.X <= part.Dx() && size.Y <= part.Dy()) {
+		return subImage(src, part)
+	}
+	scaled := image.NewRGBA(image.Rectangle{Max: size})
+	xdraw.ApproxBiLinear.Scale(scaled, scaled.Bounds(), src, part, xdraw.Src, nil)
+	return scaled
+}
+
+// partCache keeps the parts of an image that a component has scaled so that they don't need to be scaled again every time it is drawn.
+// Only the parts that have been used while the component has been drawn the last time are kept.
+// This drops parts that are outdated because the size of the component has changed, while a different image clears the cache completely.
+type partCache struct {
+	data     *img
+	parts    map[partKey]image.Image // parts used in the current drawing
+	previous map[partKey]image.Image // parts used in the previous drawing
+}
+
+type partKey struct {
+	frame int
+	part  image.Rectangle // pixels of the frame
+	size  image.Point     // size in the output
+}
+
+// begin needs to be called before the component draws the image.
+func (c *partCache) begin(data *img) {
+	if c.data != data {
+		c.data = data
+		c.parts = nil
+	}
+	c.previous, c.parts = c.parts, c.previous
+	if c.parts == nil {
+		c.parts = make(map[partKey]image.Image)
+	}
+	for key := range c.parts {
+		delete(c.parts, key)
+	}
+}
+
+// scaledPart returns the part of the frame scaled to the given size like the scaledPart function.
+// Without a cache the part is scaled every time.
+func (c *partCache) scaledPart(frame int, src image.Image, part image.Rectangle, size image.Point) image.Image {
+	if c == nil || c.parts == nil {
+		return scaledPart(src, part, size)
+	}
+	key := partKey{frame: frame, part: part, size: size}
+	scaled, ok := c.parts[key]
+	if !ok {
+		scaled, ok = c.previous[key]
+		if !ok {
+			scaled = scaledPart(src, part, size)
+		}
+		c.parts[key] = scaled
+	}
+	return scaled
+}
+
+// subImage returns the part of the image inside of the rectangle.
+func subImage(src image.Image, rect image.Rectangle) image.Image {
+	if sub, ok := src.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return sub.SubImage(rect)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, rect.Min, draw.Src)
+	return dst
+}
+
+// drawVector draws the recorded content of a vector image using the given transformation into the coordinate system of the components.
+// The content is passed on to the renderer directly so that it stays vectors in every output. If clip is set everything outside of it is cut off.
+func drawVector(ctx vit.DrawingContext, vector *canvas.Canvas, view canvas.Matrix, clip *canvas.Path) {
+	if vector.W <= 0 || vector.H <= 0 {
+		return
+	}
+	view = rendererView(ctx).Mul(view)
+	vector.RenderViewTo(viewRenderer{ctx.Context.Renderer, view, clip}, view)
+}
+
+// rendererView returns the transformation from the coordinate system of the components into the coordinate system of the renderer.
+// This matches what canvas.Context.DrawPath does for the CartesianIV coordinate system that is used for all components.
+func rendererView(ctx vit.DrawingContext) canvas.Matrix {
+	origin := canvas.Identity.ReflectYAbout(ctx.Height() / 2).Mul(ctx.CoordView()).Dot(canvas.Point{})
+	return canvas.Identity.Translate(origin.X, origin.Y).ReflectY().Mul(ctx.View())
+}
+
+// viewRenderer passes everything on to another renderer.
+// Gradients and patterns are not transformed by the matrix of a path which is why the view is applied to them separately.
+// Paths are cut off at the clip path in the coordinate system of the renderer if it is set.
+type viewRenderer struct {
+	canvas.Renderer
+	view canvas.Matrix
+	clip *canvas.Path
+}
+
+func (r viewRenderer) RenderPath(path *canvas.Path, style canvas.Style, m canvas.Matrix) {
+	if style.Fill.IsGradient() {
+		style.Fill.Gradient = style.Fill.Gradient.SetView(r.view)
+	} else if style.Fill.IsPattern() {
+		style.Fill.Pattern = style.Fill.Pattern.SetView(r.view)
+	}
+	if style.Stroke.IsGradient() {
+		style.Stroke.Gradient = style.Stroke.Gradient.SetView(r.view)
+	} else if style.Stroke.IsPattern() {
+		style.Stroke.Pattern = style.Stroke.Pattern.SetView(r.view)
+	}
+	if r.clip == nil {
+		r.Renderer.RenderPath(path, style, m)
+		return
+	}
+
+	// strokes are turned into filled paths so that they can be cut off
+	if style.HasFill() {
+		fill := style
+		fill.Stroke = canvas.Paint{}
+		r.Renderer.RenderPath(path.Transform(m).And(r.clip), fill, canvas.Identity)
+	}
+	if style.HasStroke() {
+		stroke := path
+		if style.IsDashed() {
+			stroke = stroke.Dash(style.DashOffset, style.Dashes...)
+		}
+		stroke = stroke.Stroke(style.StrokeWidth, style.StrokeCapper, style.StrokeJoiner, canvas.Tolerance)
+		r.Renderer.RenderPath(stroke.Transform(m).And(r.clip), canvas.Style{Fill: style.Stroke, FillRule: canvas.NonZero}, canvas.Identity)
+	}
 }
 
 func (i *Image) fill(space vit.Rect, img vit.Rect, fillMode Image_FillMode) vit.Rect {
@@ -81,6 +647,16 @@ func (i *Image) fill(space vit.Rect, img vit.Rect, fillMode Image_FillMode) vit.
 			scaleY = scaleX
 		}
 		return vit.NewRect(0, 0, img.Width()*scaleX, img.Height()*scaleY).CenteredIn(space)
+	case Image_FillMode_PreserveAspectCrop:
+		scale := math.Max(space.Width()/img.Width(), space.Height()/img.Height())
+		return vit.NewRect(0, 0, img.Width()*scale, img.Height()*scale).CenteredIn(space)
+	case Image_FillMode_Pad, Image_FillMode_Tile:
+		// tiled images return the first tile
+		return img.CenteredIn(space)
+	case Image_FillMode_TileVertically:
+		return vit.NewRect(0, 0, space.Width(), img.Height()).CenteredIn(space)
+	case Image_FillMode_TileHorizontally:
+		return vit.NewRect(0, 0, img.Width(), space.Height()).CenteredIn(space)
 	default:
 		i.Context().Global.Environment.Logger().Printf("image.fill called with unknown fill mode %T\r\n", fillMode)
 		return img
@@ -0,0 +1,124 @@
+package std
+
+import (
+	"container/list"
+	"image"
+	"sync"
+
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+// DefaultImageCacheSize is the default number of bytes that decoded images may occupy in the cache.
+const DefaultImageCacheSize = 64 << 20
+
+// imageCache contains decoded images so that they can be shared between all Image components, windows and documents that show them.
+var imageCache = newDecodedImageCache(DefaultImageCacheSize)
+
+// SetImageCacheSize sets the number of bytes that decoded images may occupy in memory.
+// The least recently used images are removed once the limit is exceeded. Images that are shown by components stay in memory regardless.
+func SetImageCacheSize(bytes int) {
+	imageCache.mux.Lock()
+	defer imageCache.mux.Unlock()
+	imageCache.capacity = bytes
+	imageCache.evict()
+}
+
+// ClearImageCache removes all decoded images from the cache.
+func ClearImageCache() {
+	imageCache.mux.Lock()
+	defer imageCache.mux.Unlock()
+	imageCache.items = make(map[imageKey]*list.Element)
+	imageCache.order.Init()
+	imageCache.size = 0
+}
+
+// imageKey identifies a decoded image. Either the file or the url of a provider is set.
+type imageKey struct {
+	file       vpath.Path
+	url        string
+	generation int         // generation of provided images to distinguish refreshed versions
+	size       image.Point // the requested sourceSize
+}
+
+// decodedImageCache is a cache for decoded images that is bounded by the memory the images occupy.
+// It is safe for concurrent use. Images that are requested while they are being decoded are only decoded once.
+type decodedImageCache struct {
+	mux      sync.Mutex
+	capacity int
+	size     int
+	items    map[imageKey]*list.Element // of *imageCacheEntry
+	order    *list.List                 // the most recently used entry is at the front
+	loading  map[imageKey]*pendingImage
+}
+
+type imageCacheEntry struct {
+	key  imageKey
+	data *img
+	size int
+}
+
+// pendingImage is an image that is currently being decoded.
+type pendingImage struct {
+	done chan struct{}
+	data *img
+	err  error
+}
+
+func newDecodedImageCache(capacity int) *decodedImageCache {
+	return &decodedImageCache{
+		capacity: capacity,
+		items:    make(map[imageKey]*list.Element),
+		order:    list.New(),
+		loading:  make(map[imageKey]*pendingImage),
+	}
+}
+
+// load returns the cached image or decodes it using the function. Errors are not cached.
+func (c *decodedImageCache) load(key imageKey, decode func() (*img, error)) (*img, error) {
+	c.mux.Lock()
+	if element, ok := c.items[key]; ok {
+		c.order.MoveToFront(element)
+		c.mux.Unlock()
+		return element.Value.(*imageCacheEntry).data, nil
+	}
+	if pending, ok := c.loading[key]; ok {
+		c.mux.Unlock()
+		<-pending.done
+		return pending.data, pending.err
+	}
+	pending := &pendingImage{done: make(chan struct{})}
+	c.loading[key] = pending
+	c.mux.Unlock()
+
+	pending.data, pending.err = decode()
+
+	c.mux.Lock()
+	delete(c.loading, key)
+	if pending.err == nil {
+		entry := &imageCacheEntry{key: key, data: pending.data, size: pending.data.memorySize()}
+		c.items[key] = c.order.PushFront(entry)
+		c.size += entry.size
+		c.evict()
+	}
+	c.mux.Unlock()
+	close(pending.done)
+	return pending.data, pending.err
+}
+
+// evict removes the least recently used images until the cache fits into its capacity. The mutex needs to be held.
+func (c *decodedImageCache) evict() {
+	for c.size > c.capacity && c.order.Len() > 0 {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*imageCacheEntry)
+		c.order.Remove(oldest)
+		delete(c.items, entry.key)
+		c.size -= entry.size
+	}
+}
+
+// len returns the number of cached images.
+func (c *decodedImageCache) len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,132 @@
+package std
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/omniskop/vitrum/vit/vimage"
+)
+
+func TestImageCacheEviction(t *testing.T) {
+	cache := newDecodedImageCache(250)
+	decodes := 0
+	load := func(name string) {
+		_, err := cache.load(imageKey{url: name}, func() (*img, error) {
+			decodes++
+			return rasterImage(image.NewRGBA(image.Rect(0, 0, 5, 5))), nil // 100 bytes
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	load("a")
+	load("b")
+	load("a")
+	if decodes != 2 {
+		t.Errorf("images have been decoded %d times but expected 2", decodes)
+	}
+	// b is the least recently used image now
+	load("c")
+	if cache.len() != 2 {
+		t.Errorf("cache contains %d images but expected 2", cache.len())
+	}
+	load("a")
+	load("b")
+	if decodes != 4 {
+		t.Errorf("images have been decoded %d times but expected only b to be decoded again", decodes)
+	}
+}
+
+func TestImageCacheConcurrentLoads(t *testing.T) {
+	cache := newDecodedImageCache(DefaultImageCacheSize)
+	var mux sync.Mutex
+	decodes := 0
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache.load(imageKey{url: "shared"}, func() (*img, error) {
+				mux.Lock()
+				decodes++
+				mux.Unlock()
+				<-release
+				return rasterImage(image.NewRGBA(image.Rect(0, 0, 1, 1))), nil
+			})
+		}()
+	}
+	close(release)
+	wg.Wait()
+	if decodes != 1 {
+		t.Errorf("image has been decoded %d times but expected once", decodes)
+	}
+}
+
+const asynchronousImageSource = `import Vit 1.0
+
+Item {
+    Image {
+        id: first
+        path: "image://testcounter/a"
+        asynchronous: true
+    }
+    Image {
+        id: second
+        path: "image://testcounter/a"
+    }
+    Image {
+        id: file
+        path: "picture.png"
+        asynchronous: true
+    }
+}
+`
+
+func TestAsynchronousImage(t *testing.T) {
+	var mux sync.Mutex
+	requests := 0
+	vimage.RegisterProvider("testcounter", vimage.ProviderFunc(func(id string, requestedSize image.Point) (image.Image, error) {
+		mux.Lock()
+		requests++
+		mux.Unlock()
+		return image.NewRGBA(image.Rect(0, 0, 3, 2)), nil
+	}))
+	defer vimage.UnregisterProvider("testcounter")
+
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	manager, handler := loadTestFiles(t, fstest.MapFS{
+		"Main.vit":    {Data: []byte(asynchronousImageSource)},
+		"picture.png": {Data: picture.Bytes()},
+	})
+	images := make(map[string]*Image)
+	for _, image := range findComponents[*Image](manager.MainComponent()) {
+		images[image.ID()] = image
+	}
+	// the results are only applied once the handler has been asked to
+	if status := Image_Status(images["file"].status.Int()); status != Image_Status_Loading {
+		t.Errorf("asynchronous image has status %d before the background work has been finished", status)
+	}
+
+	handler.WaitForBackgroundWork()
+	manager.UpdateFully()
+	for id, image := range images {
+		if status := Image_Status(image.status.Int()); status != Image_Status_Ready {
+			t.Errorf("%s: image has status %d but expected Ready", id, status)
+		}
+	}
+	if images["file"].imageData.width != 4 {
+		t.Errorf("asynchronous image has width %v but expected 4", images["file"].imageData.width)
+	}
+	// both images of the provider share the decoded image
+	if requests != 1 || images["first"].imageData != images["second"].imageData {
+		t.Errorf("provider has been asked %d times for the same image", requests)
+	}
+}
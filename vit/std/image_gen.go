@@ -15,9 +15,14 @@ func newFileContextForImage(globalCtx *vit.GlobalContext) (*vit.FileContext, err
 type Image_FillMode uint
 
 const (
-	Image_FillMode_Fill            Image_FillMode = 0
-	Image_FillMode_Fit             Image_FillMode = 1
-	Image_FillMode_PreferUnchanged Image_FillMode = 2
+	Image_FillMode_Fill               Image_FillMode = 0
+	Image_FillMode_Fit                Image_FillMode = 1
+	Image_FillMode_PreferUnchanged    Image_FillMode = 2
+	Image_FillMode_PreserveAspectCrop Image_FillMode = 3
+	Image_FillMode_Tile               Image_FillMode = 4
+	Image_FillMode_TileVertically     Image_FillMode = 5
+	Image_FillMode_TileHorizontally   Image_FillMode = 6
+	Image_FillMode_Pad                Image_FillMode = 7
 )
 
 func (enum Image_FillMode) String() string {
@@ -28,18 +33,103 @@ func (enum Image_FillMode) String() string {
 		return "Fit"
 	case Image_FillMode_PreferUnchanged:
 		return "PreferUnchanged"
+	case Image_FillMode_PreserveAspectCrop:
+		return "PreserveAspectCrop"
+	case Image_FillMode_Tile:
+		return "Tile"
+	case Image_FillMode_TileVertically:
+		return "TileVertically"
+	case Image_FillMode_TileHorizontally:
+		return "TileHorizontally"
+	case Image_FillMode_Pad:
+		return "Pad"
 	default:
 		return "<unknownFillMode>"
 	}
 }
 
+type Image_HorizontalAlignment uint
+
+const (
+	Image_HorizontalAlignment_AlignLeft    Image_HorizontalAlignment = 0
+	Image_HorizontalAlignment_AlignHCenter Image_HorizontalAlignment = 1
+	Image_HorizontalAlignment_AlignRight   Image_HorizontalAlignment = 2
+)
+
+func (enum Image_HorizontalAlignment) String() string {
+	switch enum {
+	case Image_HorizontalAlignment_AlignLeft:
+		return "AlignLeft"
+	case Image_HorizontalAlignment_AlignHCenter:
+		return "AlignHCenter"
+	case Image_HorizontalAlignment_AlignRight:
+		return "AlignRight"
+	default:
+		return "<unknownHorizontalAlignment>"
+	}
+}
+
+type Image_VerticalAlignment uint
+
+const (
+	Image_VerticalAlignment_AlignTop     Image_VerticalAlignment = 0
+	Image_VerticalAlignment_AlignVCenter Image_VerticalAlignment = 1
+	Image_VerticalAlignment_AlignBottom  Image_VerticalAlignment = 2
+)
+
+func (enum Image_VerticalAlignment) String() string {
+	switch enum {
+	case Image_VerticalAlignment_AlignTop:
+		return "AlignTop"
+	case Image_VerticalAlignment_AlignVCenter:
+		return "AlignVCenter"
+	case Image_VerticalAlignment_AlignBottom:
+		return "AlignBottom"
+	default:
+		return "<unknownVerticalAlignment>"
+	}
+}
+
+type Image_Status uint
+
+const (
+	Image_Status_Null    Image_Status = 0
+	Image_Status_Loading Image_Status = 1
+	Image_Status_Ready   Image_Status = 2
+	Image_Status_Error   Image_Status = 3
+)
+
+func (enum Image_Status) String() string {
+	switch enum {
+	case Image_Status_Null:
+		return "Null"
+	case Image_Status_Loading:
+		return "Loading"
+	case Image_Status_Ready:
+		return "Ready"
+	case Image_Status_Error:
+		return "Error"
+	default:
+		return "<unknownStatus>"
+	}
+}
+
 type Image struct {
 	*Item
 	id string
 
-	path      vit.StringValue
-	fillMode  vit.IntValue
-	imageData *img
+	path                vit.StringValue
+	fillMode            vit.IntValue
+	horizontalAlignment vit.IntValue
+	verticalAlignment   vit.IntValue
+	status              vit.IntValue
+	asynchronous        vit.BoolValue
+	sourceSize          vit.GroupValue
+	imageData           *img
+	loading             loadState
+	scaledParts         partCache
+
+	onStatusChanged vit.EventAttribute[ImageStatusEvent]
 }
 
 // newImageInGlobal creates an appropriate file context for the component and then returns a new Image instance.
@@ -54,22 +144,52 @@ func newImageInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse
 }
 func NewImage(id string, context *vit.FileContext) *Image {
 	i := &Image{
-		Item:      NewItem("", context),
-		id:        id,
-		path:      *vit.NewEmptyStringValue(),
-		fillMode:  *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "FillMode.Fit", Position: nil}),
-		imageData: nil,
+		Item:                NewItem("", context),
+		id:                  id,
+		path:                *vit.NewEmptyStringValue(),
+		fillMode:            *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "FillMode.Fit", Position: nil}),
+		horizontalAlignment: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "HorizontalAlignment.AlignHCenter", Position: nil}),
+		verticalAlignment:   *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "VerticalAlignment.AlignVCenter", Position: nil}),
+		status:              *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Status.Null", Position: nil}),
+		asynchronous:        *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		sourceSize: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"width":  vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+			"height": vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		}),
+		imageData:       nil,
+		loading:         loadState{},
+		scaledParts:     partCache{},
+		onStatusChanged: *vit.NewEventAttribute[ImageStatusEvent](),
 	}
 	// property assignments on embedded components
 	// register listeners for when a property changes
 	i.path.AddDependent(vit.FuncDep(i.reloadImage))
+	i.sourceSize.AddDependent(vit.FuncDep(i.reloadImage))
 	// register event listeners
 	// register enumerations
 	i.DefineEnum(vit.Enumeration{
 		Embedded: true,
 		Name:     "FillMode",
 		Position: nil,
-		Values:   map[string]int{"Fill": 0, "Fit": 1, "PreferUnchanged": 2},
+		Values:   map[string]int{"Fill": 0, "Fit": 1, "PreferUnchanged": 2, "PreserveAspectCrop": 3, "Tile": 4, "TileVertically": 5, "TileHorizontally": 6, "Pad": 7},
+	})
+	i.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "HorizontalAlignment",
+		Position: nil,
+		Values:   map[string]int{"AlignLeft": 0, "AlignHCenter": 1, "AlignRight": 2},
+	})
+	i.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "VerticalAlignment",
+		Position: nil,
+		Values:   map[string]int{"AlignTop": 0, "AlignVCenter": 1, "AlignBottom": 2},
+	})
+	i.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "Status",
+		Position: nil,
+		Values:   map[string]int{"Null": 0, "Loading": 1, "Ready": 2, "Error": 3},
 	})
 	// add child components
 
@@ -88,6 +208,16 @@ func (i *Image) Property(key string) (vit.Value, bool) {
 		return &i.path, true
 	case "fillMode":
 		return &i.fillMode, true
+	case "horizontalAlignment":
+		return &i.horizontalAlignment, true
+	case "verticalAlignment":
+		return &i.verticalAlignment, true
+	case "status":
+		return &i.status, true
+	case "asynchronous":
+		return &i.asynchronous, true
+	case "sourceSize":
+		return &i.sourceSize, true
 	default:
 		return i.Item.Property(key)
 	}
@@ -108,6 +238,16 @@ func (i *Image) SetProperty(key string, value interface{}) error {
 		err = i.path.SetValue(value)
 	case "fillMode":
 		err = i.fillMode.SetValue(value)
+	case "horizontalAlignment":
+		err = i.horizontalAlignment.SetValue(value)
+	case "verticalAlignment":
+		err = i.verticalAlignment.SetValue(value)
+	case "status":
+		err = i.status.SetValue(value)
+	case "asynchronous":
+		err = i.asynchronous.SetValue(value)
+	case "sourceSize":
+		err = i.sourceSize.SetValue(value)
 	default:
 		return i.Item.SetProperty(key, value)
 	}
@@ -123,6 +263,16 @@ func (i *Image) SetPropertyCode(key string, code vit.Code) error {
 		i.path.SetCode(code)
 	case "fillMode":
 		i.fillMode.SetCode(code)
+	case "horizontalAlignment":
+		i.horizontalAlignment.SetCode(code)
+	case "verticalAlignment":
+		i.verticalAlignment.SetCode(code)
+	case "status":
+		i.status.SetCode(code)
+	case "asynchronous":
+		i.asynchronous.SetCode(code)
+	case "sourceSize":
+		i.sourceSize.SetCode(code)
 	default:
 		return i.Item.SetPropertyCode(key, code)
 	}
@@ -131,6 +281,8 @@ func (i *Image) SetPropertyCode(key string, code vit.Code) error {
 
 func (i *Image) Event(name string) (vit.Listenable, bool) {
 	switch name {
+	case "onStatusChanged":
+		return &i.onStatusChanged, true
 	default:
 		return i.Item.Event(name)
 	}
@@ -142,6 +294,18 @@ func (i *Image) ResolveVariable(key string) (interface{}, bool) {
 		return &i.path, true
 	case "fillMode":
 		return &i.fillMode, true
+	case "horizontalAlignment":
+		return &i.horizontalAlignment, true
+	case "verticalAlignment":
+		return &i.verticalAlignment, true
+	case "status":
+		return &i.status, true
+	case "asynchronous":
+		return &i.asynchronous, true
+	case "sourceSize":
+		return &i.sourceSize, true
+	case "onStatusChanged":
+		return &i.onStatusChanged, true
 	default:
 		return i.Item.ResolveVariable(key)
 	}
@@ -185,6 +349,36 @@ func (i *Image) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
 			errs.Add(vit.NewPropertyError("Image", "fillMode", i.id, err))
 		}
 	}
+	if changed, err := i.horizontalAlignment.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Image", "horizontalAlignment", i.id, err))
+		}
+	}
+	if changed, err := i.verticalAlignment.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Image", "verticalAlignment", i.id, err))
+		}
+	}
+	if changed, err := i.status.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Image", "status", i.id, err))
+		}
+	}
+	if changed, err := i.asynchronous.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Image", "asynchronous", i.id, err))
+		}
+	}
+	if changed, err := i.sourceSize.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Image", "sourceSize", i.id, err))
+		}
+	}
 
 	// methods
 
@@ -218,6 +412,36 @@ func (i *Image) staticAttribute(name string) (interface{}, bool) {
 		return uint(Image_FillMode_Fit), true
 	case "PreferUnchanged":
 		return uint(Image_FillMode_PreferUnchanged), true
+	case "PreserveAspectCrop":
+		return uint(Image_FillMode_PreserveAspectCrop), true
+	case "Tile":
+		return uint(Image_FillMode_Tile), true
+	case "TileVertically":
+		return uint(Image_FillMode_TileVertically), true
+	case "TileHorizontally":
+		return uint(Image_FillMode_TileHorizontally), true
+	case "Pad":
+		return uint(Image_FillMode_Pad), true
+	case "AlignLeft":
+		return uint(Image_HorizontalAlignment_AlignLeft), true
+	case "AlignHCenter":
+		return uint(Image_HorizontalAlignment_AlignHCenter), true
+	case "AlignRight":
+		return uint(Image_HorizontalAlignment_AlignRight), true
+	case "AlignTop":
+		return uint(Image_VerticalAlignment_AlignTop), true
+	case "AlignVCenter":
+		return uint(Image_VerticalAlignment_AlignVCenter), true
+	case "AlignBottom":
+		return uint(Image_VerticalAlignment_AlignBottom), true
+	case "Null":
+		return uint(Image_Status_Null), true
+	case "Loading":
+		return uint(Image_Status_Loading), true
+	case "Ready":
+		return uint(Image_Status_Ready), true
+	case "Error":
+		return uint(Image_Status_Error), true
 	default:
 		return nil, false
 	}
@@ -1,10 +1,21 @@
 package std
 
 import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
 	"math"
 	"testing"
+	"testing/fstest"
 
 	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/vimage"
+	"github.com/tdewolff/canvas"
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/tiff"
 )
 
 var testCases = []struct {
@@ -54,6 +65,33 @@ var testCases = []struct {
 		mode: Image_FillMode_PreferUnchanged, // check scale down
 		in:   vit.NewRect(0, 0, 150, 75),
 		out:  vit.NewRect(100, 125, 100, 50),
+	},
+	{
+		mode: Image_FillMode_PreserveAspectCrop, // check scale up with landscape ratio
+		in:   vit.NewRect(0, 0, 50, 25),
+		out:  vit.NewRect(50, 100, 200, 100),
+	}, {
+		mode: Image_FillMode_PreserveAspectCrop, // check scale down with portrait ratio
+		in:   vit.NewRect(0, 0, 150, 300),
+		out:  vit.NewRect(100, 50, 100, 200),
+	},
+	{
+		mode: Image_FillMode_Pad, // check no change of larger image
+		in:   vit.NewRect(0, 0, 150, 50),
+		out:  vit.NewRect(75, 125, 150, 50),
+	},
+	{
+		mode: Image_FillMode_Tile, // check first tile
+		in:   vit.NewRect(0, 0, 30, 40),
+		out:  vit.NewRect(135, 130, 30, 40),
+	}, {
+		mode: Image_FillMode_TileVertically, // check stretched width
+		in:   vit.NewRect(0, 0, 30, 40),
+		out:  vit.NewRect(100, 130, 100, 40),
+	}, {
+		mode: Image_FillMode_TileHorizontally, // check stretched height
+		in:   vit.NewRect(0, 0, 30, 40),
+		out:  vit.NewRect(135, 100, 30, 100),
 	}}
 
 func TestFill(t *testing.T) {
@@ -74,3 +112,294 @@ func rectanglesEqual(a, b vit.Rect) bool {
 		math.Abs(a.X2-b.X2) < 0.00000001 &&
 		math.Abs(a.Y2-b.Y2) < 0.00000001
 }
+
+const imageFormatsSource = `import Vit 1.0
+
+Item {
+    id: root
+    width: 300
+    height: 100
+    property int changes: 0
+
+    Image { id: png; path: "picture"; fillMode: Image.Fill; width: 60; height: 40 }
+    Image { id: gif; path: "animation"; x: 60; fillMode: Image.Fill; width: 60; height: 40 }
+    Image { id: bmp; path: "bitmap"; x: 120; fillMode: Image.Fill; width: 60; height: 40 }
+    Image { id: tiff; path: "scan"; x: 180; fillMode: Image.Fill; width: 60; height: 40 }
+    Image { id: svg; path: "drawing"; y: 40; fillMode: Image.Fill; width: 240; height: 60 }
+    Image {
+        id: broken
+        path: "broken"
+        onStatusChanged: function(event) {
+            root.changes = root.changes + 1
+        }
+    }
+    Image {
+        id: empty
+    }
+}
+`
+
+const testSVG = `<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="40" height="10" viewBox="0 0 4 1">
+    <rect x="0" y="0" width="2" height="1" fill="#00ff00"/>
+    <rect x="2" y="0" width="2" height="1" fill="#0000ff"/>
+</svg>`
+
+func TestImageFormats(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	raster := image.NewRGBA(image.Rect(0, 0, 6, 4))
+	for i := 0; i < len(raster.Pix); i += 4 {
+		copy(raster.Pix[i:], []byte{255, 0, 0, 255})
+	}
+	paletted := image.NewPaletted(image.Rect(0, 0, 6, 4), color.Palette{red, color.RGBA{0, 0, 255, 255}})
+
+	encode := func(encoder func(*bytes.Buffer) error) []byte {
+		var buf bytes.Buffer
+		if err := encoder(&buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	files := fstest.MapFS{
+		"Main.vit": {Data: []byte(imageFormatsSource)},
+		// the files have no extension on purpose as the format should be detected by the content
+		"picture":   {Data: encode(func(b *bytes.Buffer) error { return png.Encode(b, raster) })},
+		"animation": {Data: encode(func(b *bytes.Buffer) error { return gif.Encode(b, paletted, nil) })},
+		"bitmap":    {Data: encode(func(b *bytes.Buffer) error { return bmp.Encode(b, raster) })},
+		"scan":      {Data: encode(func(b *bytes.Buffer) error { return tiff.Encode(b, raster, nil) })},
+		"drawing":   {Data: []byte(testSVG)},
+		"broken":    {Data: []byte("this is not an image")},
+	}
+	manager, _ := loadTestFiles(t, files)
+	main := manager.MainComponent()
+	images := make(map[string]*Image)
+	for _, image := range findComponents[*Image](main) {
+		images[image.ID()] = image
+	}
+
+	for _, id := range []string{"png", "gif", "bmp", "tiff"} {
+		image := images[id]
+		if status := Image_Status(image.status.Int()); status != Image_Status_Ready {
+			t.Errorf("%s: expected status Ready but got %d", id, status)
+		}
+		if image.imageData == nil || image.imageData.width != 6 || image.imageData.height != 4 {
+			t.Errorf("%s: image has not been decoded with the correct size", id)
+		}
+	}
+
+	svg := images["svg"]
+	if svg.imageData == nil || svg.imageData.vector == nil {
+		t.Fatal("svg has not been decoded as vectors")
+	}
+	if svg.imageData.width != 40 || svg.imageData.height != 10 {
+		t.Errorf("svg has size %vx%v but expected 40x10", svg.imageData.width, svg.imageData.height)
+	}
+
+	broken := images["broken"]
+	if status := Image_Status(broken.status.Int()); status != Image_Status_Error {
+		t.Errorf("broken image has status %d but expected Error", status)
+	}
+	// listeners are called once with the last event even though the status changed to Loading before
+	if changes := main.MustProperty("changes").GetValue(); changes != 1 {
+		t.Errorf("onStatusChanged listener has been called %v times but expected 1", changes)
+	}
+	empty := images["empty"]
+	if status := Image_Status(empty.status.Int()); status != Image_Status_Null {
+		t.Errorf("image without path has status %d but expected Null", status)
+	}
+
+	// svg images are passed on to the renderer as paths
+	recorder := &recordingRenderer{}
+	ctx := canvas.NewContext(recorder)
+	ctx.SetCoordSystem(canvas.CartesianIV)
+	if err := svg.Draw(vit.DrawingContext{Context: ctx}, svg.Bounds()); err != nil {
+		t.Fatal(err)
+	}
+	if recorder.paths != 2 || recorder.images != 0 {
+		t.Errorf("svg has been drawn using %d paths and %d images but expected 2 paths", recorder.paths, recorder.images)
+	}
+
+	img := renderComponent(t, main, 300, 100)
+	checkPixels(t, img, []pixelTest{
+		{30, 20, red},
+		{90, 20, red},
+		{150, 20, red},
+		{210, 20, red},
+		{250, 20, color.RGBA{0, 0, 0, 0}},
+		// the svg is stretched over 240x60
+		{2, 42, color.RGBA{0, 255, 0, 255}},
+		{118, 97, color.RGBA{0, 255, 0, 255}},
+		{122, 42, color.RGBA{0, 0, 255, 255}},
+		{238, 97, color.RGBA{0, 0, 255, 255}},
+	}, 2)
+}
+
+// recordingRenderer counts what has been rendered.
+type recordingRenderer struct {
+	paths, texts, images int
+}
+
+func (r *recordingRenderer) Size() (float64, float64)                             { return 300, 100 }
+func (r *recordingRenderer) RenderPath(*canvas.Path, canvas.Style, canvas.Matrix) { r.paths++ }
+func (r *recordingRenderer) RenderText(*canvas.Text, canvas.Matrix)               { r.texts++ }
+func (r *recordingRenderer) RenderImage(image.Image, canvas.Matrix)               { r.images++ }
+
+const imageProviderSource = `import Vit 1.0
+
+Item {
+    width: 100
+    height: 100
+
+    Image {
+        id: provided
+        path: "image://testplots/sales"
+        sourceSize.width: 40
+        sourceSize.height: 20
+    }
+    Image {
+        id: scaled
+        path: "large.png"
+        sourceSize.width: 8
+    }
+}
+`
+
+func TestImageProvider(t *testing.T) {
+	var requests []image.Point
+	version := 1
+	vimage.RegisterProvider("testplots", vimage.ProviderFunc(func(id string, requestedSize image.Point) (image.Image, error) {
+		if id != "sales" {
+			return nil, fmt.Errorf("unknown plot %q", id)
+		}
+		requests = append(requests, requestedSize)
+		return image.NewRGBA(image.Rect(0, 0, requestedSize.X*version, requestedSize.Y)), nil
+	}))
+	defer vimage.UnregisterProvider("testplots")
+
+	var large bytes.Buffer
+	if err := png.Encode(&large, image.NewRGBA(image.Rect(0, 0, 32, 16))); err != nil {
+		t.Fatal(err)
+	}
+	manager, handler := loadTestFiles(t, fstest.MapFS{
+		"Main.vit":  {Data: []byte(imageProviderSource)},
+		"large.png": {Data: large.Bytes()},
+	})
+	images := make(map[string]*Image)
+	for _, image := range findComponents[*Image](manager.MainComponent()) {
+		images[image.ID()] = image
+	}
+
+	provided := images["provided"]
+	if Image_Status(provided.status.Int()) != Image_Status_Ready {
+		t.Fatalf("provided image has status %d", provided.status.Int())
+	}
+	if len(requests) == 0 || requests[len(requests)-1] != image.Pt(40, 20) {
+		t.Errorf("provider has been called with %v but expected a requested size of 40x20", requests)
+	}
+	if provided.imageData.width != 40 || provided.imageData.height != 20 {
+		t.Errorf("provided image has size %vx%v", provided.imageData.width, provided.imageData.height)
+	}
+
+	// a refresh causes the image to be requested again when it is drawn the next time
+	version = 2
+	vimage.Refresh("testplots", "sales")
+	ctx := canvas.NewContext(&recordingRenderer{})
+	ctx.SetCoordSystem(canvas.CartesianIV)
+	if err := provided.Draw(vit.DrawingContext{Context: ctx}, provided.Bounds()); err != nil {
+		t.Fatal(err)
+	}
+	if provided.imageData.width != 80 {
+		t.Errorf("refreshed image has width %v but expected 80", provided.imageData.width)
+	}
+	if _, ok := handler.NextRedraw(); !ok {
+		t.Error("no redraw has been scheduled after the image has been refreshed")
+	}
+
+	// raster files are scaled down to the source size
+	scaled := images["scaled"]
+	if scaled.imageData.width != 8 || scaled.imageData.height != 4 {
+		t.Errorf("scaled image has size %vx%v but expected 8x4", scaled.imageData.width, scaled.imageData.height)
+	}
+	if bounds := scaled.imageData.frames[0].Bounds(); bounds.Dx() != 8 || bounds.Dy() != 4 {
+		t.Errorf("scaled image data has size %v but expected 8x4", bounds)
+	}
+}
+
+const imageFillModesSource = `import Vit 1.0
+
+Item {
+    width: 120
+    height: 40
+
+    Image {
+        path: "halves.png"
+        width: 40
+        height: 20
+        fillMode: Image.PreserveAspectCrop
+    }
+    Image {
+        path: "halves.png"
+        x: 50
+        width: 20
+        height: 8
+        fillMode: Image.Tile
+        horizontalAlignment: Image.AlignLeft
+        verticalAlignment: Image.AlignTop
+    }
+    Image {
+        path: "halves.png"
+        x: 80
+        width: 20
+        height: 20
+        fillMode: Image.Pad
+        horizontalAlignment: Image.AlignRight
+        verticalAlignment: Image.AlignBottom
+    }
+}
+`
+
+func TestImageFillModes(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	transparent := color.RGBA{0, 0, 0, 0}
+	// the left half of the image is red and the right half is blue
+	halves := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if x < 2 {
+				halves.SetRGBA(x, y, red)
+			} else {
+				halves.SetRGBA(x, y, blue)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, halves); err != nil {
+		t.Fatal(err)
+	}
+	manager, _ := loadTestFiles(t, fstest.MapFS{
+		"Main.vit":   {Data: []byte(imageFillModesSource)},
+		"halves.png": {Data: buf.Bytes()},
+	})
+
+	img := renderComponent(t, manager.MainComponent(), 120, 40)
+	checkPixels(t, img, []pixelTest{
+		// cropped image that is 40x40 but cut off at the bottom of the item
+		{5, 10, red},
+		{35, 10, blue},
+		{5, 25, transparent},
+		{35, 35, transparent},
+		// tiles starting at the top left corner
+		{50, 2, red},
+		{53, 2, blue},
+		{54, 6, red},
+		{57, 6, blue},
+		{69, 2, blue},
+		{51, 9, transparent},
+		// image at its natural size in the bottom right corner
+		{97, 18, red},
+		{99, 18, blue},
+		{97, 15, transparent},
+		{90, 10, transparent},
+	}, 10)
+}
@@ -0,0 +1,398 @@
+package std
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+// InputHandler implements vit.ExecutionEnvironment and distributes input events to all components that are interested in them.
+// It doesn't depend on a specific backend. Backends translate their native events and pass them on using TriggerMouseEvent and TriggerKeyEvent.
+type InputHandler struct {
+	inputs           []inputComponent  // in the order they have been registered
+	mouseGrab        inputComponent    // input component that received the press of the mouse buttons that are currently held down
+	stacking         map[*vit.Root]int // registration order of mouse areas and input components which roughly matches the drawing order
+	registered       int
+	keyInputs        map[*vit.Root]keyInputComponent
+	components       map[*vit.Root]vit.Component // maps the root of every component to the outermost component that embeds it
+	mouse            []*MouseArea                // in the order they have been registered which roughly matches the drawing order
+	shortcuts        map[*Shortcut]bool
+	dropAreas        []*DropArea // in the order they have been registered which roughly matches the drawing order
+	dropTargets      map[*Drag]*DropArea
+	canvases         map[*Canvas]bool
+	focusedComponent vit.FocusableComponent // focused component that is not based on an Item
+	activeFocus      vit.Component          // component that receives key events
+	activeFocusChain []vit.Component        // activeFocus and all focus scopes that contain it
+	updatingFocus    bool
+	tabOrder         TabOrder
+	buttons          MouseArea_MouseButtons
+	clipboard        vit.Clipboard
+	logger           *log.Logger
+	redrawAt         time.Time // the earliest time at which a redraw has been requested, zero if there is none
+
+	backgroundMux      sync.Mutex
+	backgroundPending  int      // number of functions running in the background
+	backgroundFinished []func() // results of background work that still need to be applied
+	backgroundDone     *sync.Cond
+	onBackgroundDone   func()
+}
+
+// inputComponent is implemented by components that handle mouse events themselves instead of using a MouseArea.
+type inputComponent interface {
+	vit.Component
+	acceptsMouse(x, y float64) bool             // returns true if the component handles the mouse at the given position
+	handleMouseEvent(MouseEvent)                // only called for the topmost component under the mouse or the one that has been pressed
+	mouseExited()                               // called instead of handleMouseEvent for all other components
+	cursorShape() (MouseArea_CursorShape, bool) // returns the shape of the cursor if the mouse is above the component
+}
+
+// keyInputComponent is implemented by input components that also handle key events themselves instead of using a KeyArea.
+// Key events are only delivered while the component has the active focus.
+type keyInputComponent interface {
+	inputComponent
+	handleKeyEvent(*KeyEvent)
+}
+
+func NewInputHandler(logger *log.Logger) *InputHandler {
+	h := &InputHandler{
+		components:  make(map[*vit.Root]vit.Component),
+		keyInputs:   make(map[*vit.Root]keyInputComponent),
+		shortcuts:   make(map[*Shortcut]bool),
+		dropTargets: make(map[*Drag]*DropArea),
+		canvases:    make(map[*Canvas]bool),
+		stacking:    make(map[*vit.Root]int),
+		clipboard:   vit.NewMemoryClipboard(),
+		logger:      logger,
+	}
+	h.backgroundDone = sync.NewCond(&h.backgroundMux)
+	return h
+}
+
+func (h *InputHandler) RegisterComponent(id string, comp vit.Component) {
+	// components that embed others are registered last
+	h.components[comp.RootC()] = comp
+	switch comp := comp.(type) {
+	case *MouseArea:
+		h.mouse = append(h.mouse, comp)
+		h.registered++
+		h.stacking[comp.RootC()] = h.registered
+	case *Shortcut:
+		h.shortcuts[comp] = true
+	case *DropArea:
+		h.dropAreas = append(h.dropAreas, comp)
+	case *Drag:
+		comp.handler = h
+	case *Canvas:
+		h.canvases[comp] = true
+	case inputComponent:
+		h.inputs = append(h.inputs, comp)
+		h.registered++
+		h.stacking[comp.RootC()] = h.registered
+		if comp, ok := comp.(keyInputComponent); ok {
+			h.keyInputs[comp.RootC()] = comp
+		}
+	}
+}
+
+func (h *InputHandler) UnregisterComponent(id string, comp vit.Component) {
+	delete(h.components, comp.RootC())
+	delete(h.stacking, comp.RootC())
+	for _, c := range h.activeFocusChain {
+		if c.RootC() == comp.RootC() {
+			h.setActiveFocus(nil, OtherFocusReason)
+			break
+		}
+	}
+	switch comp := comp.(type) {
+	case *MouseArea:
+		for i, area := range h.mouse {
+			if area == comp {
+				h.mouse = append(h.mouse[:i], h.mouse[i+1:]...)
+				break
+			}
+		}
+	case *Shortcut:
+		delete(h.shortcuts, comp)
+	case *DropArea:
+		for i, area := range h.dropAreas {
+			if area == comp {
+				h.dropAreas = append(h.dropAreas[:i], h.dropAreas[i+1:]...)
+				break
+			}
+		}
+		for drag, target := range h.dropTargets {
+			if target == comp {
+				h.dropTargets[drag] = nil
+			}
+		}
+	case *Drag:
+		delete(h.dropTargets, comp)
+		comp.handler = nil
+	case *Canvas:
+		delete(h.canvases, comp)
+	case inputComponent:
+		for i, input := range h.inputs {
+			if input == comp {
+				h.inputs = append(h.inputs[:i], h.inputs[i+1:]...)
+				break
+			}
+		}
+		if h.mouseGrab == comp {
+			h.mouseGrab = nil
+		}
+		delete(h.keyInputs, comp.RootC())
+	}
+}
+
+// RequestFocus gives the active focus to the component.
+// Components that are not based on an Item only get their Focus and Blur methods called.
+func (h *InputHandler) RequestFocus(comp vit.FocusableComponent) {
+	if h.focusedComponent != nil {
+		h.focusedComponent.Blur()
+		h.focusedComponent = nil
+	}
+	if c, ok := comp.(vit.Component); ok {
+		if item, ok := asItem(c); ok {
+			h.forceActiveFocus(item, OtherFocusReason)
+			return
+		}
+	}
+	h.focusedComponent = comp
+	comp.Focus()
+}
+
+// Clipboard returns the clipboard of the environment. By default this is a clipboard that only exists in memory.
+func (h *InputHandler) Clipboard() vit.Clipboard {
+	return h.clipboard
+}
+
+// SetClipboard replaces the clipboard of the environment. Backends use this to provide access to the clipboard of the system.
+func (h *InputHandler) SetClipboard(clipboard vit.Clipboard) {
+	h.clipboard = clipboard
+}
+
+func (h *InputHandler) Logger() *log.Logger {
+	return h.logger
+}
+
+// ScheduleRedraw remembers the time at which the components want to be drawn again. Only the earliest request is kept.
+func (h *InputHandler) ScheduleRedraw(at time.Time) {
+	if h.redrawAt.IsZero() || at.Before(h.redrawAt) {
+		h.redrawAt = at
+	}
+}
+
+// NextRedraw returns the earliest time at which a redraw has been requested since the last call.
+// Backends call it after drawing and schedule a new frame for that time.
+// Canvases that have requested a paint from within onPaint are painted during the next update.
+func (h *InputHandler) NextRedraw() (time.Time, bool) {
+	for canvas := range h.canvases {
+		canvas.painter.nextFrame()
+	}
+	at := h.redrawAt
+	h.redrawAt = time.Time{}
+	return at, !at.IsZero()
+}
+
+// RunInBackground runs the work on a shared pool of goroutines. The function it returns is queued until FinishBackgroundWork is called.
+func (h *InputHandler) RunInBackground(work func() (finish func())) {
+	h.backgroundMux.Lock()
+	h.backgroundPending++
+	h.backgroundMux.Unlock()
+	runInBackground(func() {
+		finish := work()
+		h.backgroundMux.Lock()
+		h.backgroundPending--
+		h.backgroundFinished = append(h.backgroundFinished, finish)
+		notify := h.onBackgroundDone
+		h.backgroundDone.Broadcast()
+		h.backgroundMux.Unlock()
+		if notify != nil {
+			notify()
+		}
+	})
+}
+
+// OnBackgroundDone sets a function that is called whenever work that has been started with RunInBackground is done.
+// It is called on the goroutine that did the work. Backends use it to update the components again.
+func (h *InputHandler) OnBackgroundDone(notify func()) {
+	h.backgroundMux.Lock()
+	defer h.backgroundMux.Unlock()
+	h.onBackgroundDone = notify
+}
+
+// FinishBackgroundWork applies the results of all work that has been done in the background since the last call without waiting for work that is still running.
+// It needs to be called on the goroutine that updates the components and returns true if anything has been applied.
+func (h *InputHandler) FinishBackgroundWork() bool {
+	h.backgroundMux.Lock()
+	finished := h.backgroundFinished
+	h.backgroundFinished = nil
+	h.backgroundMux.Unlock()
+	for _, finish := range finished {
+		finish()
+	}
+	return len(finished) > 0
+}
+
+// WaitForBackgroundWork waits until all work running in the background is done and applies the results.
+// Backends that draw only once use it to make sure that everything has been loaded. It returns true if anything has been applied.
+func (h *InputHandler) WaitForBackgroundWork() bool {
+	applied := false
+	for {
+		h.backgroundMux.Lock()
+		for h.backgroundPending > 0 {
+			h.backgroundDone.Wait()
+		}
+		h.backgroundMux.Unlock()
+		// applying the results could start new work
+		if !h.FinishBackgroundWork() {
+			return applied
+		}
+		applied = true
+	}
+}
+
+// TriggerMouseEvent distributes a mouse event to all mouse areas and updates all drags that are in progress.
+// The position of the event is expected to be in the coordinate system of the components.
+func (h *InputHandler) TriggerMouseEvent(e MouseEvent) {
+	// the input component that received a press keeps receiving events until all buttons have been released
+	var target inputComponent
+	if h.buttons != 0 {
+		target = h.mouseGrab
+	} else {
+		target = h.inputAt(float64(e.X), float64(e.Y))
+	}
+	if e.Buttons != 0 {
+		h.mouseGrab = target
+	} else {
+		h.mouseGrab = nil
+	}
+	h.buttons = e.Buttons
+
+	for _, ma := range h.mouse {
+		ma.TriggerEvent(e)
+	}
+	for _, input := range h.inputs {
+		if input == target {
+			input.handleMouseEvent(e)
+		} else {
+			input.mouseExited()
+		}
+	}
+	for drag := range h.dropTargets {
+		h.moveDrag(drag)
+	}
+}
+
+// inputAt returns the topmost input component that accepts the mouse at the given position.
+// Nothing is returned if it is covered by an enabled mouse area.
+func (h *InputHandler) inputAt(x, y float64) inputComponent {
+	for i := len(h.inputs) - 1; i >= 0; i-- {
+		input := h.inputs[i]
+		if !input.acceptsMouse(x, y) {
+			continue
+		}
+		for _, ma := range h.mouse {
+			if h.stacking[ma.RootC()] > h.stacking[input.RootC()] && ma.enabled.Bool() && ma.Bounds().Contains(x, y) {
+				return nil
+			}
+		}
+		return input
+	}
+	return nil
+}
+
+// CursorShape returns the shape the mouse cursor should have.
+// This is the shape of the mouse area that is currently being pressed or otherwise of the topmost one that contains the mouse.
+func (h *InputHandler) CursorShape() MouseArea_CursorShape {
+	for i := len(h.mouse) - 1; i >= 0; i-- {
+		if h.mouse[i].pressed.Bool() {
+			return MouseArea_CursorShape(h.mouse[i].cursorShape.Int())
+		}
+	}
+	for i := len(h.inputs) - 1; i >= 0; i-- {
+		if shape, ok := h.inputs[i].cursorShape(); ok {
+			return shape
+		}
+	}
+	for i := len(h.mouse) - 1; i >= 0; i-- {
+		if h.mouse[i].enabled.Bool() && h.mouse[i].containsMouse.Bool() {
+			return MouseArea_CursorShape(h.mouse[i].cursorShape.Int())
+		}
+	}
+	return MouseArea_CursorShape_ArrowCursor
+}
+
+// TriggerKeyEvent first checks if the key event activates any shortcuts and otherwise delivers it to the active focus item and its parents.
+// A tab key press that hasn't been accepted by anyone moves the focus.
+// It returns true if the event has been accepted.
+func (h *InputHandler) TriggerKeyEvent(e KeyEvent) bool {
+	for s := range h.shortcuts {
+		if s.handleKeyEvent(&e, h.activeFocus) {
+			e.Accepted = true
+		}
+	}
+	if e.Accepted {
+		return true
+	}
+	h.deliverKeyEvent(&e)
+	if !e.Accepted && e.Pressed && e.Key == "Tab" && e.Modifiers&^KeyArea_Modifiers_shiftModifier == 0 {
+		e.Accepted = h.moveFocusByTab(e.Modifiers&KeyArea_Modifiers_shiftModifier > 0)
+	}
+	return e.Accepted
+}
+
+// dragActiveChanged will be called by a Drag when it was activated or deactivated.
+func (h *InputHandler) dragActiveChanged(d *Drag) {
+	if d.active.Bool() {
+		if _, ok := h.dropTargets[d]; !ok {
+			h.dropTargets[d] = nil
+		}
+		h.moveDrag(d)
+		return
+	}
+
+	target, ok := h.dropTargets[d]
+	if !ok {
+		return
+	}
+	delete(h.dropTargets, d)
+	if target != nil {
+		target.dragDropped(d.event())
+	}
+	d.target.SetComponent(nil)
+}
+
+// moveDrag finds the drop area under the hot spot of the drag and notifies all affected drop areas.
+func (h *InputHandler) moveDrag(d *Drag) {
+	e := d.event()
+
+	var area *DropArea
+	for i := len(h.dropAreas) - 1; i >= 0; i-- {
+		if h.dropAreas[i].accepts(e.Keys) && h.dropAreas[i].Bounds().Contains(e.X, e.Y) {
+			area = h.dropAreas[i]
+			break
+		}
+	}
+
+	current := h.dropTargets[d]
+	if current == area {
+		if area != nil {
+			area.dragMoved(e)
+		}
+		return
+	}
+
+	if current != nil {
+		current.dragExited(e)
+	}
+	h.dropTargets[d] = area
+	if area != nil {
+		d.target.SetComponent(area)
+		area.dragEntered(e)
+	} else {
+		d.target.SetComponent(nil)
+	}
+}
@@ -0,0 +1,252 @@
+package std
+
+import (
+	"strings"
+	"unicode"
+)
+
+// textMask restricts the text of a TextInput to a fixed pattern that is described by an input mask.
+// While a mask is active the text of the editor always contains one rune per slot of the mask.
+// Slots that haven't been filled by the user contain the blank character.
+//
+// The mask uses the following characters:
+//
+//	A  letter, required         a  letter, optional
+//	N  letter or digit, required n  letter or digit, optional
+//	X  any character, required   x  any character, optional
+//	9  digit, required           0  digit, optional
+//	D  digit 1-9, required       d  digit 1-9, optional
+//	#  digit or sign, optional
+//	H  hex digit, required       h  hex digit, optional
+//	B  binary digit, required    b  binary digit, optional
+//	>  following letters are uppercase
+//	<  following letters are lowercase
+//	!  switch off case conversion
+//	\  use the following character as a literal
+//
+// All other characters are literals. The mask can be followed by a semicolon and the blank character which defaults to a space.
+type textMask struct {
+	slots []maskSlot
+	blank rune
+}
+
+// maskSlot is a single position of an input mask.
+type maskSlot struct {
+	literal  rune // the fixed character at this position or 0 if the user can edit it
+	kind     rune // the mask character that describes which characters are allowed
+	caseMode rune // '>' for uppercase, '<' for lowercase or 0
+}
+
+func parseMask(mask string) textMask {
+	m := textMask{blank: ' '}
+	if i := strings.LastIndex(mask, ";"); i >= 0 && (i == 0 || mask[i-1] != '\\') {
+		if blank := []rune(mask[i+1:]); len(blank) > 0 {
+			m.blank = blank[0]
+		}
+		mask = mask[:i]
+	}
+
+	var caseMode rune
+	escaped := false
+	for _, r := range mask {
+		if escaped {
+			m.slots = append(m.slots, maskSlot{literal: r})
+			escaped = false
+			continue
+		}
+		switch r {
+		case '\\':
+			escaped = true
+		case '>', '<':
+			caseMode = r
+		case '!':
+			caseMode = 0
+		case 'A', 'a', 'N', 'n', 'X', 'x', '9', '0', 'D', 'd', '#', 'H', 'h', 'B', 'b':
+			m.slots = append(m.slots, maskSlot{kind: r, caseMode: caseMode})
+		default:
+			m.slots = append(m.slots, maskSlot{literal: r})
+		}
+	}
+	return m
+}
+
+func (m *textMask) active() bool {
+	return len(m.slots) > 0
+}
+
+// accept returns the rune that will be stored in the slot if the user enters r.
+func (s maskSlot) accept(r rune) (rune, bool) {
+	switch s.caseMode {
+	case '>':
+		r = unicode.ToUpper(r)
+	case '<':
+		r = unicode.ToLower(r)
+	}
+	var ok bool
+	switch unicode.ToUpper(s.kind) {
+	case 'A':
+		ok = unicode.IsLetter(r)
+	case 'N':
+		ok = unicode.IsLetter(r) || unicode.IsDigit(r)
+	case 'X':
+		ok = !unicode.IsSpace(r) && !unicode.IsControl(r)
+	case '9', '0':
+		ok = unicode.IsDigit(r)
+	case 'D':
+		ok = unicode.IsDigit(r) && r != '0'
+	case '#':
+		ok = unicode.IsDigit(r) || r == '+' || r == '-'
+	case 'H':
+		ok = unicode.Is(unicode.ASCII_Hex_Digit, r)
+	case 'B':
+		ok = r == '0' || r == '1'
+	}
+	return r, ok
+}
+
+// required returns true if the slot has to be filled for the text to be acceptable.
+func (s maskSlot) required() bool {
+	return s.literal == 0 && (unicode.IsUpper(s.kind) || s.kind == '9')
+}
+
+// format fits the text into the mask. Characters that don't fit are skipped.
+func (m *textMask) format(text string) []rune {
+	input := []rune(text)
+	result := make([]rune, len(m.slots))
+	for i, slot := range m.slots {
+		if slot.literal != 0 {
+			result[i] = slot.literal
+			if len(input) > 0 && input[0] == slot.literal {
+				input = input[1:]
+			}
+			continue
+		}
+		result[i] = m.blank
+		for len(input) > 0 {
+			r, ok := slot.accept(input[0])
+			input = input[1:]
+			if ok {
+				result[i] = r
+				break
+			}
+		}
+	}
+	return result
+}
+
+// plain returns the text without the blank characters.
+func (m *textMask) plain(text []rune) string {
+	var b strings.Builder
+	for i, r := range text {
+		if i < len(m.slots) && m.slots[i].literal == 0 && r == m.blank {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// complete returns true if all required slots have been filled.
+func (m *textMask) complete(text []rune) bool {
+	for i, slot := range m.slots {
+		if slot.required() && (i >= len(text) || text[i] == m.blank) {
+			return false
+		}
+	}
+	return true
+}
+
+// clear replaces all editable slots between start and end with the blank character.
+func (m *textMask) clear(text []rune, start, end int) {
+	for i := start; i < end; i++ {
+		if m.slots[i].literal == 0 {
+			text[i] = m.blank
+		}
+	}
+}
+
+// insert writes the text into the slots starting at the selection. The selection is cleared beforehand.
+// It returns false and leaves the editor untouched if a character isn't allowed by the mask.
+func (m *textMask) insert(e *textEditor, text string) bool {
+	result := append([]rune(nil), e.text...)
+	start, end := e.selection()
+	m.clear(result, start, end)
+	pos := start
+	for _, r := range text {
+		// literals are skipped, typing one just moves over it
+		typedLiteral := false
+		for pos < len(m.slots) && m.slots[pos].literal != 0 {
+			typedLiteral = m.slots[pos].literal == r
+			pos++
+			if typedLiteral {
+				break
+			}
+		}
+		if typedLiteral {
+			continue
+		}
+		if pos >= len(m.slots) {
+			break
+		}
+		accepted, ok := m.slots[pos].accept(r)
+		if !ok {
+			return false
+		}
+		result[pos] = accepted
+		pos++
+	}
+	for pos < len(m.slots) && m.slots[pos].literal != 0 && pos > start {
+		pos++
+	}
+	if start == end && pos == start {
+		return false
+	}
+	e.text = result
+	e.setCursor(pos, false)
+	return true
+}
+
+// removeSelection clears the selected slots. It returns false if nothing was selected.
+func (m *textMask) removeSelection(e *textEditor) bool {
+	if !e.hasSelection() {
+		return false
+	}
+	result := append([]rune(nil), e.text...)
+	start, end := e.selection()
+	m.clear(result, start, end)
+	e.text = result
+	e.setCursor(start, false)
+	return true
+}
+
+// deleteBackward clears the selection or otherwise the slot or word in front of the cursor.
+func (m *textMask) deleteBackward(e *textEditor, word bool) bool {
+	if m.removeSelection(e) {
+		return true
+	}
+	if e.cursor == 0 {
+		return false
+	}
+	if word {
+		e.anchor = e.previousWord(e.cursor)
+	} else {
+		e.anchor = e.cursor - 1
+	}
+	return m.removeSelection(e)
+}
+
+// deleteForward clears the selection or otherwise the slot or word after the cursor.
+func (m *textMask) deleteForward(e *textEditor, word bool) bool {
+	if m.removeSelection(e) {
+		return true
+	}
+	if e.cursor == len(e.text) {
+		return false
+	}
+	if word {
+		e.anchor = e.nextWord(e.cursor)
+	} else {
+		e.anchor = e.cursor + 1
+	}
+	return m.removeSelection(e)
+}
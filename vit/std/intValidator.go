@@ -0,0 +1,35 @@
+package std
+
+import (
+	"strconv"
+	"strings"
+)
+
+func (v *IntValidator) onRulesChanged(changed func()) {
+	v.rules.changed = changed
+}
+
+func (v *IntValidator) rulesChanged() {
+	v.rules.notify()
+}
+
+// Validate accepts integers between bottom and top.
+func (v *IntValidator) Validate(text string) ValidationState {
+	bottom, top := v.bottom.Int(), v.top.Int()
+	digits, negative := splitSign(text)
+	if digits == "" {
+		if negative && bottom >= 0 || text != "" && !negative && top < 0 {
+			return InvalidInput
+		}
+		return IntermediateInput
+	}
+	if strings.IndexFunc(digits, isNotDigit) >= 0 {
+		return InvalidInput
+	}
+
+	value, err := strconv.Atoi(text)
+	if err == nil && value >= bottom && value <= top {
+		return AcceptableInput
+	}
+	return numberRangeState(negative, len(strings.TrimLeft(digits, "0")), float64(bottom), float64(top))
+}
@@ -0,0 +1,185 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForIntValidator(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type IntValidator struct {
+	*Item
+	id string
+
+	bottom vit.IntValue
+	top    vit.IntValue
+	rules  rulesListener
+}
+
+// newIntValidatorInGlobal creates an appropriate file context for the component and then returns a new IntValidator instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newIntValidatorInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*IntValidator, error) {
+	fileCtx, err := newFileContextForIntValidator(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewIntValidator(id, fileCtx), nil
+}
+func NewIntValidator(id string, context *vit.FileContext) *IntValidator {
+	i := &IntValidator{
+		Item:   NewItem("", context),
+		id:     id,
+		bottom: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "-2147483648", Position: nil}),
+		top:    *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "2147483647", Position: nil}),
+		rules:  rulesListener{},
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	i.bottom.AddDependent(vit.FuncDep(i.rulesChanged))
+	i.top.AddDependent(vit.FuncDep(i.rulesChanged))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", i)
+
+	return i
+}
+
+func (i *IntValidator) String() string {
+	return fmt.Sprintf("IntValidator(%s)", i.id)
+}
+
+func (i *IntValidator) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "bottom":
+		return &i.bottom, true
+	case "top":
+		return &i.top, true
+	default:
+		return i.Item.Property(key)
+	}
+}
+
+func (i *IntValidator) MustProperty(key string) vit.Value {
+	v, ok := i.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (i *IntValidator) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "bottom":
+		err = i.bottom.SetValue(value)
+	case "top":
+		err = i.top.SetValue(value)
+	default:
+		return i.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("IntValidator", key, i.id, err)
+	}
+	return nil
+}
+
+func (i *IntValidator) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "bottom":
+		i.bottom.SetCode(code)
+	case "top":
+		i.top.SetCode(code)
+	default:
+		return i.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (i *IntValidator) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return i.Item.Event(name)
+	}
+}
+
+func (i *IntValidator) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "bottom":
+		return &i.bottom, true
+	case "top":
+		return &i.top, true
+	default:
+		return i.Item.ResolveVariable(key)
+	}
+}
+
+func (i *IntValidator) AddChild(child vit.Component) {
+	child.SetParent(i)
+	i.AddChildButKeepParent(child)
+}
+
+func (i *IntValidator) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range i.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(i)
+			i.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	i.AddChild(addThis)
+}
+
+func (i *IntValidator) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = i
+	}
+	// properties
+	if changed, err := i.bottom.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("IntValidator", "bottom", i.id, err))
+		}
+	}
+	if changed, err := i.top.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("IntValidator", "top", i.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := i.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (i *IntValidator) As(target *vit.Component) bool {
+	if _, ok := (*target).(*IntValidator); ok {
+		*target = i
+		return true
+	}
+	return i.Item.As(target)
+}
+
+func (i *IntValidator) ID() string {
+	return i.id
+}
+
+func (i *IntValidator) Finish() error {
+	return i.RootC().FinishInContext(i)
+}
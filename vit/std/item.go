@@ -22,11 +22,21 @@ type Item struct {
 	top              vit.AnchorLineValue
 	verticalCenter   vit.AnchorLineValue
 	bottom           vit.AnchorLineValue
+	focus            vit.BoolValue
+	activeFocus      vit.BoolValue
+	activeFocusOnTab vit.BoolValue
+	layer            vit.GroupValue
+
+	onActiveFocusChanged vit.EventAttribute[FocusEvent]
 
 	contentWidth  float64
 	contentHeight float64
 
 	layout *vit.Layout
+
+	// the instance of the effect that has been assigned to layer.effect
+	layerEffect           vit.Effect
+	layerEffectDefinition *vit.ComponentDefinition
 }
 
 func NewItem(id string, context *vit.FileContext) *Item {
@@ -45,12 +55,22 @@ func NewItem(id string, context *vit.FileContext) *Item {
 		top:              *vit.NewAnchorLineValue(),
 		verticalCenter:   *vit.NewAnchorLineValue(),
 		bottom:           *vit.NewAnchorLineValue(),
+		focus:            *vit.NewEmptyBoolValue(),
+		activeFocus:      *vit.NewEmptyBoolValue(),
+		activeFocusOnTab: *vit.NewEmptyBoolValue(),
+		layer: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"enabled": vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+			"effect":  vit.NewOptionalValue(vit.NewEmptyComponentDefValue()),
+		}),
+
+		onActiveFocusChanged: *vit.NewEventAttribute[FocusEvent](),
 	}
 	i.x.AddDependent(vit.FuncDep(i.layouting))
 	i.y.AddDependent(vit.FuncDep(i.layouting))
 	i.z.AddDependent(vit.FuncDep(i.layouting))
 	i.width.AddDependent(vit.FuncDep(i.layouting))
 	i.height.AddDependent(vit.FuncDep(i.layouting))
+	i.focus.AddDependent(vit.FuncDep(i.focusChanged))
 	return i
 }
 
@@ -84,6 +104,14 @@ func (i *Item) Property(key string) (vit.Value, bool) {
 		return &i.verticalCenter, true
 	case "bottom":
 		return &i.bottom, true
+	case "focus":
+		return &i.focus, true
+	case "activeFocus":
+		return &i.activeFocus, true
+	case "activeFocusOnTab":
+		return &i.activeFocusOnTab, true
+	case "layer":
+		return &i.layer, true
 	default:
 		return i.Root.Property(key)
 	}
@@ -113,6 +141,12 @@ func (i *Item) SetProperty(key string, value interface{}) error {
 		err = i.y.SetValue(value)
 	case "z":
 		err = i.z.SetValue(value)
+	case "focus":
+		err = i.focus.SetValue(value)
+	case "activeFocusOnTab":
+		err = i.activeFocusOnTab.SetValue(value)
+	case "layer":
+		err = i.layer.SetValue(value)
 	default:
 		return i.Root.SetProperty(key, value)
 	}
@@ -136,6 +170,12 @@ func (i *Item) SetPropertyCode(key string, code vit.Code) error {
 		i.y.SetCode(code)
 	case "z":
 		i.z.SetCode(code)
+	case "focus":
+		i.focus.SetCode(code)
+	case "activeFocusOnTab":
+		i.activeFocusOnTab.SetCode(code)
+	case "layer":
+		i.layer.SetCode(code)
 	default:
 		return i.Root.SetPropertyCode(key, code)
 	}
@@ -143,7 +183,12 @@ func (i *Item) SetPropertyCode(key string, code vit.Code) error {
 }
 
 func (i *Item) Event(name string) (vit.Listenable, bool) {
-	return i.Root.Event(name)
+	switch name {
+	case "onActiveFocusChanged":
+		return &i.onActiveFocusChanged, true
+	default:
+		return i.Root.Event(name)
+	}
 }
 
 func (i *Item) ResolveVariable(key string) (interface{}, bool) {
@@ -172,6 +217,16 @@ func (i *Item) ResolveVariable(key string) (interface{}, bool) {
 		return &i.verticalCenter, true
 	case "bottom":
 		return &i.bottom, true
+	case "focus":
+		return &i.focus, true
+	case "activeFocus":
+		return &i.activeFocus, true
+	case "activeFocusOnTab":
+		return &i.activeFocusOnTab, true
+	case "layer":
+		return &i.layer, true
+	case "onActiveFocusChanged":
+		return &i.onActiveFocusChanged, true
 	default:
 		return i.Root.ResolveVariable(key)
 	}
@@ -267,6 +322,24 @@ func (i *Item) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
 			errs.Add(vit.NewPropertyError("Item", "bottom", i.id, err))
 		}
 	}
+	if changed, err := i.focus.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Item", "focus", i.id, err))
+		}
+	}
+	if changed, err := i.activeFocusOnTab.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Item", "activeFocusOnTab", i.id, err))
+		}
+	}
+	if changed, err := i.layer.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Item", "layer", i.id, err))
+		}
+	}
 
 	if i.layout.PositionChanged() {
 		i.layouting()
@@ -284,6 +357,10 @@ func (i *Item) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
 	sum += n
 	errs.AddGroup(err)
 
+	n, err = i.updateLayerEffect()
+	sum += n
+	errs.AddGroup(err)
+
 	n, err = i.Root.UpdateExpressions(context)
 	sum += n
 	errs.AddGroup(err)
@@ -547,6 +624,23 @@ func (i *Item) getIntrinsicSize() (float64, float64) {
 	return width, height
 }
 
+// explicitWidth returns the width that is imposed on the item by its width property, anchors or a layout.
+// It returns false if the width is only given by the content of the item.
+func (i *Item) explicitWidth() (float64, bool) {
+	if i.layout != nil {
+		if w, ok := i.layout.GetWidth(); ok {
+			return w, true
+		}
+	}
+	if w := i.width.Float64(); w != 0 {
+		return w, true
+	}
+	if i.anchors.Fill.GetValue() != nil || i.anchors.Left.IsSet() && i.anchors.Right.IsSet() {
+		return i.Bounds().Width(), true
+	}
+	return 0, false
+}
+
 // SetContentSize sets size of the content of the item. Should only be called by Components that embed this Item.
 func (i *Item) SetContentSize(w, h float64) {
 	if w != i.contentWidth || h != i.contentHeight {
@@ -1,24 +1,44 @@
 package std
 
+// KeyEvent describes either a physical key that has been pressed or released, or text that has been entered.
+// Events for physical keys have Key set while text input only sets Text and Letter.
 type KeyEvent struct {
-	Pressed bool
-	Letter  rune
-	Code    string
+	Pressed    bool
+	Letter     rune              // first character of Text
+	Code       string            // code of special keys according to https://www.w3.org/TR/uievents-code/ like "Enter" or "ArrowLeft"
+	Key        string            // name of the key; either the Code of a special key or the upper case character of a printable key
+	Text       string            // text that has been entered
+	Modifiers  KeyArea_Modifiers // modifier keys that were held down
+	AutoRepeat bool              // true if the event was generated because the key is being held down
+	Accepted   bool              // can be set by a receiver to indicate that the event has been handled
+}
+
+// IsText returns true if the event describes entered text instead of a physical key.
+func (e KeyEvent) IsText() bool {
+	return e.Key == "" && e.Text != ""
 }
 
 func (a *KeyArea) enableDisable() {
 	if !a.enabled.Bool() {
 		// KeyArea was just disabled
+		a.pressed.SetBoolValue(false)
 	}
 }
 
-func (a *KeyArea) TriggerEvent(e KeyEvent) {
+// TriggerEvent fires the corresponding event of the key area.
+// Unless propagateEvents is set the event will be accepted afterwards so that it won't be passed on to the parents.
+func (a *KeyArea) TriggerEvent(e *KeyEvent) {
 	if !a.enabled.Bool() {
 		return
 	}
 	if e.Pressed {
-		a.onKeyDown.Fire(&e)
+		a.pressed.SetBoolValue(true)
+		a.onKeyDown.Fire(e)
 	} else {
-		a.onKeyUp.Fire(&e)
+		a.pressed.SetBoolValue(false)
+		a.onKeyUp.Fire(e)
+	}
+	if !a.propagateEvents.Bool() {
+		e.Accepted = true
 	}
 }
@@ -12,12 +12,40 @@ func newFileContextForKeyArea(globalCtx *vit.GlobalContext) (*vit.FileContext, e
 	return vit.NewFileContext(globalCtx), nil
 }
 
+type KeyArea_Modifiers uint
+
+const (
+	KeyArea_Modifiers_noModifier      KeyArea_Modifiers = 0
+	KeyArea_Modifiers_shiftModifier   KeyArea_Modifiers = 1
+	KeyArea_Modifiers_controlModifier KeyArea_Modifiers = 2
+	KeyArea_Modifiers_altModifier     KeyArea_Modifiers = 4
+	KeyArea_Modifiers_superModifier   KeyArea_Modifiers = 8
+)
+
+func (enum KeyArea_Modifiers) String() string {
+	switch enum {
+	case KeyArea_Modifiers_noModifier:
+		return "noModifier"
+	case KeyArea_Modifiers_shiftModifier:
+		return "shiftModifier"
+	case KeyArea_Modifiers_controlModifier:
+		return "controlModifier"
+	case KeyArea_Modifiers_altModifier:
+		return "altModifier"
+	case KeyArea_Modifiers_superModifier:
+		return "superModifier"
+	default:
+		return "<unknownModifiers>"
+	}
+}
+
 type KeyArea struct {
 	*Item
 	id string
 
-	enabled vit.BoolValue
-	pressed vit.BoolValue
+	enabled         vit.BoolValue
+	pressed         vit.BoolValue
+	propagateEvents vit.BoolValue
 
 	onKeyDown vit.EventAttribute[KeyEvent]
 	onKeyUp   vit.EventAttribute[KeyEvent]
@@ -35,18 +63,25 @@ func newKeyAreaInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary par
 }
 func NewKeyArea(id string, context *vit.FileContext) *KeyArea {
 	k := &KeyArea{
-		Item:      NewItem("", context),
-		id:        id,
-		enabled:   *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
-		pressed:   *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
-		onKeyDown: *vit.NewEventAttribute[KeyEvent](),
-		onKeyUp:   *vit.NewEventAttribute[KeyEvent](),
+		Item:            NewItem("", context),
+		id:              id,
+		enabled:         *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		pressed:         *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		propagateEvents: *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		onKeyDown:       *vit.NewEventAttribute[KeyEvent](),
+		onKeyUp:         *vit.NewEventAttribute[KeyEvent](),
 	}
 	// property assignments on embedded components
 	// register listeners for when a property changes
 	k.enabled.AddDependent(vit.FuncDep(k.enableDisable))
 	// register event listeners
 	// register enumerations
+	k.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "Modifiers",
+		Position: nil,
+		Values:   map[string]int{"noModifier": 0, "shiftModifier": 1, "controlModifier": 2, "altModifier": 4, "superModifier": 8},
+	})
 	// add child components
 
 	context.RegisterComponent("", k)
@@ -64,6 +99,8 @@ func (k *KeyArea) Property(key string) (vit.Value, bool) {
 		return &k.enabled, true
 	case "pressed":
 		return &k.pressed, true
+	case "propagateEvents":
+		return &k.propagateEvents, true
 	default:
 		return k.Item.Property(key)
 	}
@@ -84,6 +121,8 @@ func (k *KeyArea) SetProperty(key string, value interface{}) error {
 		err = k.enabled.SetValue(value)
 	case "pressed":
 		err = k.pressed.SetValue(value)
+	case "propagateEvents":
+		err = k.propagateEvents.SetValue(value)
 	default:
 		return k.Item.SetProperty(key, value)
 	}
@@ -99,6 +138,8 @@ func (k *KeyArea) SetPropertyCode(key string, code vit.Code) error {
 		k.enabled.SetCode(code)
 	case "pressed":
 		k.pressed.SetCode(code)
+	case "propagateEvents":
+		k.propagateEvents.SetCode(code)
 	default:
 		return k.Item.SetPropertyCode(key, code)
 	}
@@ -122,6 +163,8 @@ func (k *KeyArea) ResolveVariable(key string) (interface{}, bool) {
 		return &k.enabled, true
 	case "pressed":
 		return &k.pressed, true
+	case "propagateEvents":
+		return &k.propagateEvents, true
 	case "onKeyDown":
 		return &k.onKeyDown, true
 	case "onKeyUp":
@@ -169,6 +212,12 @@ func (k *KeyArea) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup)
 			errs.Add(vit.NewPropertyError("KeyArea", "pressed", k.id, err))
 		}
 	}
+	if changed, err := k.propagateEvents.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("KeyArea", "propagateEvents", k.id, err))
+		}
+	}
 
 	// methods
 
@@ -193,3 +242,20 @@ func (k *KeyArea) ID() string {
 func (k *KeyArea) Finish() error {
 	return k.RootC().FinishInContext(k)
 }
+
+func (k *KeyArea) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "noModifier":
+		return uint(KeyArea_Modifiers_noModifier), true
+	case "shiftModifier":
+		return uint(KeyArea_Modifiers_shiftModifier), true
+	case "controlModifier":
+		return uint(KeyArea_Modifiers_controlModifier), true
+	case "altModifier":
+		return uint(KeyArea_Modifiers_altModifier), true
+	case "superModifier":
+		return uint(KeyArea_Modifiers_superModifier), true
+	default:
+		return nil, false
+	}
+}
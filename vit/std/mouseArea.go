@@ -1,6 +1,11 @@
 package std
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
 
 type MouseEvent struct {
 	X, Y    int
@@ -48,6 +53,7 @@ func (m *MouseArea) enableDisable() {
 		m.containsMouse.SetBoolValue(false)
 		m.pressed.SetBoolValue(false)
 		m.pressedButtons.SetIntValue(0)
+		m.endDrag()
 	}
 }
 
@@ -56,11 +62,12 @@ func (m *MouseArea) TriggerEvent(e MouseEvent) {
 	if !m.enabled.Bool() {
 		return
 	}
-	if !m.Bounds().Contains(float64(e.X), float64(e.Y)) {
-		m.containsMouse.SetBoolValue(false)
+	contains := m.Bounds().Contains(float64(e.X), float64(e.Y))
+	m.containsMouse.SetBoolValue(contains)
+	if !contains && !m.pressed.Bool() {
+		// while a button is held down we will keep tracking the mouse even outside of our bounds
 		return
 	}
-	m.containsMouse.SetBoolValue(true)
 	m.mouseX.SetFloatValue(float64(e.X))
 	m.mouseY.SetFloatValue(float64(e.Y))
 
@@ -70,7 +77,102 @@ func (m *MouseArea) TriggerEvent(e MouseEvent) {
 
 	m.pressedButtons.SetIntValue(int(e.Buttons))
 
-	if filtered == 0 && wasPressed {
-		m.onClicked.Fire(&e)
+	if filtered > 0 {
+		if wasPressed {
+			m.moveDrag(float64(e.X), float64(e.Y))
+		} else {
+			m.startDrag(float64(e.X), float64(e.Y))
+		}
+	} else if wasPressed {
+		dragged := m.endDrag()
+		if contains && !dragged {
+			m.onClicked.Fire(&e)
+		}
+	}
+}
+
+// dragState holds information about the drag that is currently in progress.
+type dragState struct {
+	target         vit.Component // the component that is being dragged; nil if no drag is in progress
+	pressX, pressY float64       // position of the mouse when the drag started
+	startX, startY float64       // position of the target when the drag started
+}
+
+// startDrag prepares a possible drag of the drag target.
+// The drag will only become active after the mouse moved further than the drag threshold.
+func (m *MouseArea) startDrag(x, y float64) {
+	target := m.drag.MustGet("target").(*vit.ComponentRefValue).Component()
+	if target == nil {
+		m.dragState = dragState{}
+		return
+	}
+	bounds := target.Bounds()
+	m.dragState = dragState{
+		target: target,
+		pressX: x,
+		pressY: y,
+		startX: bounds.X1,
+		startY: bounds.Y1,
+	}
+}
+
+// moveDrag moves the drag target according to the new mouse position.
+func (m *MouseArea) moveDrag(x, y float64) {
+	if m.dragState.target == nil {
+		return
+	}
+	dx := x - m.dragState.pressX
+	dy := y - m.dragState.pressY
+	axis := Drag_Axis(m.drag.MustGet("axis").(*vit.IntValue).Int())
+	if axis&Drag_Axis_XAxis == 0 {
+		dx = 0
+	}
+	if axis&Drag_Axis_YAxis == 0 {
+		dy = 0
+	}
+
+	active := m.drag.MustGet("active").(*vit.BoolValue)
+	if !active.Bool() {
+		threshold := m.drag.MustGet("threshold").(*vit.FloatValue).Float64()
+		if math.Abs(dx) <= threshold && math.Abs(dy) <= threshold {
+			return
+		}
+		active.SetBoolValue(true)
+		if d := attachedDrag(m.dragState.target); d != nil {
+			d.active.SetBoolValue(true)
+		}
+	}
+
+	if axis&Drag_Axis_XAxis != 0 {
+		m.dragState.target.SetProperty("x", m.clampDrag(m.dragState.startX+dx, "minimumX", "maximumX"))
+	}
+	if axis&Drag_Axis_YAxis != 0 {
+		m.dragState.target.SetProperty("y", m.clampDrag(m.dragState.startY+dy, "minimumY", "maximumY"))
+	}
+}
+
+// endDrag finishes the current drag and returns true if the drag was active.
+func (m *MouseArea) endDrag() bool {
+	target := m.dragState.target
+	m.dragState = dragState{}
+	active := m.drag.MustGet("active").(*vit.BoolValue)
+	if !active.Bool() {
+		return false
+	}
+	active.SetBoolValue(false)
+	if d := attachedDrag(target); d != nil {
+		d.active.SetBoolValue(false)
+	}
+	return true
+}
+
+// clampDrag limits the value using the given optional minimum and maximum properties of the drag group.
+func (m *MouseArea) clampDrag(value float64, minimum string, maximum string) float64 {
+	if min := m.drag.MustGet(minimum).(*vit.OptionalValue[*vit.FloatValue]); min.IsSet() {
+		value = math.Max(value, min.Value().Float64())
+	}
+	if max := m.drag.MustGet(maximum).(*vit.OptionalValue[*vit.FloatValue]); max.IsSet() {
+		value = math.Min(value, max.Value().Float64())
 	}
+	return value
 }
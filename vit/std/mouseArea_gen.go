@@ -39,17 +39,77 @@ func (enum MouseArea_MouseButtons) String() string {
 	}
 }
 
+type MouseArea_CursorShape uint
+
+const (
+	MouseArea_CursorShape_ArrowCursor        MouseArea_CursorShape = 0
+	MouseArea_CursorShape_PointingHandCursor MouseArea_CursorShape = 1
+	MouseArea_CursorShape_IBeamCursor        MouseArea_CursorShape = 2
+	MouseArea_CursorShape_CrossCursor        MouseArea_CursorShape = 3
+	MouseArea_CursorShape_WaitCursor         MouseArea_CursorShape = 4
+	MouseArea_CursorShape_BusyCursor         MouseArea_CursorShape = 5
+	MouseArea_CursorShape_ForbiddenCursor    MouseArea_CursorShape = 6
+	MouseArea_CursorShape_OpenHandCursor     MouseArea_CursorShape = 7
+	MouseArea_CursorShape_ClosedHandCursor   MouseArea_CursorShape = 8
+	MouseArea_CursorShape_SizeHorCursor      MouseArea_CursorShape = 9
+	MouseArea_CursorShape_SizeVerCursor      MouseArea_CursorShape = 10
+	MouseArea_CursorShape_SizeBDiagCursor    MouseArea_CursorShape = 11
+	MouseArea_CursorShape_SizeFDiagCursor    MouseArea_CursorShape = 12
+	MouseArea_CursorShape_SizeAllCursor      MouseArea_CursorShape = 13
+	MouseArea_CursorShape_BlankCursor        MouseArea_CursorShape = 14
+)
+
+func (enum MouseArea_CursorShape) String() string {
+	switch enum {
+	case MouseArea_CursorShape_ArrowCursor:
+		return "ArrowCursor"
+	case MouseArea_CursorShape_PointingHandCursor:
+		return "PointingHandCursor"
+	case MouseArea_CursorShape_IBeamCursor:
+		return "IBeamCursor"
+	case MouseArea_CursorShape_CrossCursor:
+		return "CrossCursor"
+	case MouseArea_CursorShape_WaitCursor:
+		return "WaitCursor"
+	case MouseArea_CursorShape_BusyCursor:
+		return "BusyCursor"
+	case MouseArea_CursorShape_ForbiddenCursor:
+		return "ForbiddenCursor"
+	case MouseArea_CursorShape_OpenHandCursor:
+		return "OpenHandCursor"
+	case MouseArea_CursorShape_ClosedHandCursor:
+		return "ClosedHandCursor"
+	case MouseArea_CursorShape_SizeHorCursor:
+		return "SizeHorCursor"
+	case MouseArea_CursorShape_SizeVerCursor:
+		return "SizeVerCursor"
+	case MouseArea_CursorShape_SizeBDiagCursor:
+		return "SizeBDiagCursor"
+	case MouseArea_CursorShape_SizeFDiagCursor:
+		return "SizeFDiagCursor"
+	case MouseArea_CursorShape_SizeAllCursor:
+		return "SizeAllCursor"
+	case MouseArea_CursorShape_BlankCursor:
+		return "BlankCursor"
+	default:
+		return "<unknownCursorShape>"
+	}
+}
+
 type MouseArea struct {
 	*Item
 	id string
 
 	acceptedButtons vit.IntValue
 	containsMouse   vit.BoolValue
+	cursorShape     vit.IntValue
+	drag            vit.GroupValue
 	enabled         vit.BoolValue
 	mouseX          vit.FloatValue
 	mouseY          vit.FloatValue
 	pressed         vit.BoolValue
 	pressedButtons  vit.IntValue
+	dragState       dragState
 
 	onClicked vit.EventAttribute[MouseEvent]
 }
@@ -70,12 +130,24 @@ func NewMouseArea(id string, context *vit.FileContext) *MouseArea {
 		id:              id,
 		acceptedButtons: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "MouseButtons.leftButton", Position: nil}),
 		containsMouse:   *vit.NewEmptyBoolValue(),
-		enabled:         *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
-		mouseX:          *vit.NewEmptyFloatValue(),
-		mouseY:          *vit.NewEmptyFloatValue(),
-		pressed:         *vit.NewEmptyBoolValue(),
-		pressedButtons:  *vit.NewEmptyIntValue(),
-		onClicked:       *vit.NewEventAttribute[MouseEvent](),
+		cursorShape:     *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "CursorShape.ArrowCursor", Position: nil}),
+		drag: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"active":    vit.NewEmptyBoolValue(),
+			"axis":      vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Drag.XAndYAxis", Position: nil}),
+			"maximumX":  vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"maximumY":  vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"minimumX":  vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"minimumY":  vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"target":    vit.NewEmptyComponentRefValue(),
+			"threshold": vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "5", Position: nil}),
+		}),
+		enabled:        *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		mouseX:         *vit.NewEmptyFloatValue(),
+		mouseY:         *vit.NewEmptyFloatValue(),
+		pressed:        *vit.NewEmptyBoolValue(),
+		pressedButtons: *vit.NewEmptyIntValue(),
+		dragState:      dragState{},
+		onClicked:      *vit.NewEventAttribute[MouseEvent](),
 	}
 	// property assignments on embedded components
 	// register listeners for when a property changes
@@ -88,6 +160,12 @@ func NewMouseArea(id string, context *vit.FileContext) *MouseArea {
 		Position: nil,
 		Values:   map[string]int{"noButton": 0, "leftButton": 1, "rightButton": 2, "middleButton": 4, "allButtons": 134217727},
 	})
+	m.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "CursorShape",
+		Position: nil,
+		Values:   map[string]int{"ArrowCursor": 0, "PointingHandCursor": 1, "IBeamCursor": 2, "CrossCursor": 3, "WaitCursor": 4, "BusyCursor": 5, "ForbiddenCursor": 6, "OpenHandCursor": 7, "ClosedHandCursor": 8, "SizeHorCursor": 9, "SizeVerCursor": 10, "SizeBDiagCursor": 11, "SizeFDiagCursor": 12, "SizeAllCursor": 13, "BlankCursor": 14},
+	})
 	// add child components
 
 	context.RegisterComponent("", m)
@@ -105,6 +183,10 @@ func (m *MouseArea) Property(key string) (vit.Value, bool) {
 		return &m.acceptedButtons, true
 	case "containsMouse":
 		return &m.containsMouse, true
+	case "cursorShape":
+		return &m.cursorShape, true
+	case "drag":
+		return &m.drag, true
 	case "enabled":
 		return &m.enabled, true
 	case "mouseX":
@@ -135,6 +217,10 @@ func (m *MouseArea) SetProperty(key string, value interface{}) error {
 		err = m.acceptedButtons.SetValue(value)
 	case "containsMouse":
 		err = m.containsMouse.SetValue(value)
+	case "cursorShape":
+		err = m.cursorShape.SetValue(value)
+	case "drag":
+		err = m.drag.SetValue(value)
 	case "enabled":
 		err = m.enabled.SetValue(value)
 	case "mouseX":
@@ -160,6 +246,10 @@ func (m *MouseArea) SetPropertyCode(key string, code vit.Code) error {
 		m.acceptedButtons.SetCode(code)
 	case "containsMouse":
 		m.containsMouse.SetCode(code)
+	case "cursorShape":
+		m.cursorShape.SetCode(code)
+	case "drag":
+		m.drag.SetCode(code)
 	case "enabled":
 		m.enabled.SetCode(code)
 	case "mouseX":
@@ -191,6 +281,10 @@ func (m *MouseArea) ResolveVariable(key string) (interface{}, bool) {
 		return &m.acceptedButtons, true
 	case "containsMouse":
 		return &m.containsMouse, true
+	case "cursorShape":
+		return &m.cursorShape, true
+	case "drag":
+		return &m.drag, true
 	case "enabled":
 		return &m.enabled, true
 	case "mouseX":
@@ -246,6 +340,18 @@ func (m *MouseArea) UpdateExpressions(context vit.Component) (int, vit.ErrorGrou
 			errs.Add(vit.NewPropertyError("MouseArea", "containsMouse", m.id, err))
 		}
 	}
+	if changed, err := m.cursorShape.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("MouseArea", "cursorShape", m.id, err))
+		}
+	}
+	if changed, err := m.drag.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("MouseArea", "drag", m.id, err))
+		}
+	}
 	if changed, err := m.enabled.Update(context); changed || err != nil {
 		sum++
 		if err != nil {
@@ -313,6 +419,36 @@ func (m *MouseArea) staticAttribute(name string) (interface{}, bool) {
 		return uint(MouseArea_MouseButtons_middleButton), true
 	case "allButtons":
 		return uint(MouseArea_MouseButtons_allButtons), true
+	case "ArrowCursor":
+		return uint(MouseArea_CursorShape_ArrowCursor), true
+	case "PointingHandCursor":
+		return uint(MouseArea_CursorShape_PointingHandCursor), true
+	case "IBeamCursor":
+		return uint(MouseArea_CursorShape_IBeamCursor), true
+	case "CrossCursor":
+		return uint(MouseArea_CursorShape_CrossCursor), true
+	case "WaitCursor":
+		return uint(MouseArea_CursorShape_WaitCursor), true
+	case "BusyCursor":
+		return uint(MouseArea_CursorShape_BusyCursor), true
+	case "ForbiddenCursor":
+		return uint(MouseArea_CursorShape_ForbiddenCursor), true
+	case "OpenHandCursor":
+		return uint(MouseArea_CursorShape_OpenHandCursor), true
+	case "ClosedHandCursor":
+		return uint(MouseArea_CursorShape_ClosedHandCursor), true
+	case "SizeHorCursor":
+		return uint(MouseArea_CursorShape_SizeHorCursor), true
+	case "SizeVerCursor":
+		return uint(MouseArea_CursorShape_SizeVerCursor), true
+	case "SizeBDiagCursor":
+		return uint(MouseArea_CursorShape_SizeBDiagCursor), true
+	case "SizeFDiagCursor":
+		return uint(MouseArea_CursorShape_SizeFDiagCursor), true
+	case "SizeAllCursor":
+		return uint(MouseArea_CursorShape_SizeAllCursor), true
+	case "BlankCursor":
+		return uint(MouseArea_CursorShape_BlankCursor), true
 	default:
 		return nil, false
 	}
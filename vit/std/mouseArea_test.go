@@ -0,0 +1,47 @@
+package std
+
+import "testing"
+
+const cursorSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 400
+
+    MouseArea {
+        width: 200
+        height: 200
+        cursorShape: MouseArea.PointingHandCursor
+    }
+
+    MouseArea {
+        x: 100
+        y: 100
+        width: 200
+        height: 200
+        cursorShape: MouseArea.IBeamCursor
+    }
+}
+`
+
+func TestCursorShape(t *testing.T) {
+	_, handler := loadTestComponent(t, cursorSource)
+
+	var tests = []struct {
+		x, y     int
+		buttons  MouseArea_MouseButtons
+		expected MouseArea_CursorShape
+	}{
+		{50, 50, 0, MouseArea_CursorShape_PointingHandCursor},
+		{150, 150, 0, MouseArea_CursorShape_IBeamCursor}, // the topmost area wins
+		{350, 350, 0, MouseArea_CursorShape_ArrowCursor},
+		{50, 50, MouseArea_MouseButtons_leftButton, MouseArea_CursorShape_PointingHandCursor},
+		{350, 50, MouseArea_MouseButtons_leftButton, MouseArea_CursorShape_PointingHandCursor}, // the pressed area keeps its cursor
+	}
+	for _, test := range tests {
+		handler.TriggerMouseEvent(MouseEvent{X: test.x, Y: test.y, Buttons: test.buttons})
+		if shape := handler.CursorShape(); shape != test.expected {
+			t.Errorf("at (%d, %d): expected cursor shape %d, got %d", test.x, test.y, test.expected, shape)
+		}
+	}
+}
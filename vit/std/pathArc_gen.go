@@ -0,0 +1,303 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForPathArc(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type PathArc_ArcDirection uint
+
+const (
+	PathArc_ArcDirection_Clockwise        PathArc_ArcDirection = 0
+	PathArc_ArcDirection_Counterclockwise PathArc_ArcDirection = 1
+)
+
+func (enum PathArc_ArcDirection) String() string {
+	switch enum {
+	case PathArc_ArcDirection_Clockwise:
+		return "Clockwise"
+	case PathArc_ArcDirection_Counterclockwise:
+		return "Counterclockwise"
+	default:
+		return "<unknownArcDirection>"
+	}
+}
+
+type PathArc struct {
+	*Item
+	id string
+
+	x             vit.FloatValue
+	y             vit.FloatValue
+	radiusX       vit.FloatValue
+	radiusY       vit.FloatValue
+	xAxisRotation vit.FloatValue
+	useLargeArc   vit.BoolValue
+	direction     vit.IntValue
+}
+
+// newPathArcInGlobal creates an appropriate file context for the component and then returns a new PathArc instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newPathArcInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*PathArc, error) {
+	fileCtx, err := newFileContextForPathArc(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewPathArc(id, fileCtx), nil
+}
+func NewPathArc(id string, context *vit.FileContext) *PathArc {
+	p := &PathArc{
+		Item:          NewItem("", context),
+		id:            id,
+		x:             *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		y:             *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		radiusX:       *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		radiusY:       *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		xAxisRotation: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		useLargeArc:   *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		direction:     *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "ArcDirection.Clockwise", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	p.x.AddDependent(vit.FuncDep(p.elementChanged))
+	p.y.AddDependent(vit.FuncDep(p.elementChanged))
+	p.radiusX.AddDependent(vit.FuncDep(p.elementChanged))
+	p.radiusY.AddDependent(vit.FuncDep(p.elementChanged))
+	p.xAxisRotation.AddDependent(vit.FuncDep(p.elementChanged))
+	p.useLargeArc.AddDependent(vit.FuncDep(p.elementChanged))
+	p.direction.AddDependent(vit.FuncDep(p.elementChanged))
+	// register event listeners
+	// register enumerations
+	p.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "ArcDirection",
+		Position: nil,
+		Values:   map[string]int{"Clockwise": 0, "Counterclockwise": 1},
+	})
+	// add child components
+
+	context.RegisterComponent("", p)
+
+	return p
+}
+
+func (p *PathArc) String() string {
+	return fmt.Sprintf("PathArc(%s)", p.id)
+}
+
+func (p *PathArc) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	case "radiusX":
+		return &p.radiusX, true
+	case "radiusY":
+		return &p.radiusY, true
+	case "xAxisRotation":
+		return &p.xAxisRotation, true
+	case "useLargeArc":
+		return &p.useLargeArc, true
+	case "direction":
+		return &p.direction, true
+	default:
+		return p.Item.Property(key)
+	}
+}
+
+func (p *PathArc) MustProperty(key string) vit.Value {
+	v, ok := p.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (p *PathArc) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "x":
+		err = p.x.SetValue(value)
+	case "y":
+		err = p.y.SetValue(value)
+	case "radiusX":
+		err = p.radiusX.SetValue(value)
+	case "radiusY":
+		err = p.radiusY.SetValue(value)
+	case "xAxisRotation":
+		err = p.xAxisRotation.SetValue(value)
+	case "useLargeArc":
+		err = p.useLargeArc.SetValue(value)
+	case "direction":
+		err = p.direction.SetValue(value)
+	default:
+		return p.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("PathArc", key, p.id, err)
+	}
+	return nil
+}
+
+func (p *PathArc) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "x":
+		p.x.SetCode(code)
+	case "y":
+		p.y.SetCode(code)
+	case "radiusX":
+		p.radiusX.SetCode(code)
+	case "radiusY":
+		p.radiusY.SetCode(code)
+	case "xAxisRotation":
+		p.xAxisRotation.SetCode(code)
+	case "useLargeArc":
+		p.useLargeArc.SetCode(code)
+	case "direction":
+		p.direction.SetCode(code)
+	default:
+		return p.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (p *PathArc) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return p.Item.Event(name)
+	}
+}
+
+func (p *PathArc) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	case "radiusX":
+		return &p.radiusX, true
+	case "radiusY":
+		return &p.radiusY, true
+	case "xAxisRotation":
+		return &p.xAxisRotation, true
+	case "useLargeArc":
+		return &p.useLargeArc, true
+	case "direction":
+		return &p.direction, true
+	default:
+		return p.Item.ResolveVariable(key)
+	}
+}
+
+func (p *PathArc) AddChild(child vit.Component) {
+	child.SetParent(p)
+	p.AddChildButKeepParent(child)
+}
+
+func (p *PathArc) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range p.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(p)
+			p.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	p.AddChild(addThis)
+}
+
+func (p *PathArc) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = p
+	}
+	// properties
+	if changed, err := p.x.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "x", p.id, err))
+		}
+	}
+	if changed, err := p.y.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "y", p.id, err))
+		}
+	}
+	if changed, err := p.radiusX.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "radiusX", p.id, err))
+		}
+	}
+	if changed, err := p.radiusY.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "radiusY", p.id, err))
+		}
+	}
+	if changed, err := p.xAxisRotation.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "xAxisRotation", p.id, err))
+		}
+	}
+	if changed, err := p.useLargeArc.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "useLargeArc", p.id, err))
+		}
+	}
+	if changed, err := p.direction.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathArc", "direction", p.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := p.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (p *PathArc) As(target *vit.Component) bool {
+	if _, ok := (*target).(*PathArc); ok {
+		*target = p
+		return true
+	}
+	return p.Item.As(target)
+}
+
+func (p *PathArc) ID() string {
+	return p.id
+}
+
+func (p *PathArc) Finish() error {
+	return p.RootC().FinishInContext(p)
+}
+
+func (p *PathArc) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "Clockwise":
+		return uint(PathArc_ArcDirection_Clockwise), true
+	case "Counterclockwise":
+		return uint(PathArc_ArcDirection_Counterclockwise), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,251 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForPathCubic(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type PathCubic struct {
+	*Item
+	id string
+
+	x         vit.FloatValue
+	y         vit.FloatValue
+	control1X vit.FloatValue
+	control1Y vit.FloatValue
+	control2X vit.FloatValue
+	control2Y vit.FloatValue
+}
+
+// newPathCubicInGlobal creates an appropriate file context for the component and then returns a new PathCubic instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newPathCubicInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*PathCubic, error) {
+	fileCtx, err := newFileContextForPathCubic(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewPathCubic(id, fileCtx), nil
+}
+func NewPathCubic(id string, context *vit.FileContext) *PathCubic {
+	p := &PathCubic{
+		Item:      NewItem("", context),
+		id:        id,
+		x:         *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		y:         *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		control1X: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		control1Y: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		control2X: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		control2Y: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	p.x.AddDependent(vit.FuncDep(p.elementChanged))
+	p.y.AddDependent(vit.FuncDep(p.elementChanged))
+	p.control1X.AddDependent(vit.FuncDep(p.elementChanged))
+	p.control1Y.AddDependent(vit.FuncDep(p.elementChanged))
+	p.control2X.AddDependent(vit.FuncDep(p.elementChanged))
+	p.control2Y.AddDependent(vit.FuncDep(p.elementChanged))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", p)
+
+	return p
+}
+
+func (p *PathCubic) String() string {
+	return fmt.Sprintf("PathCubic(%s)", p.id)
+}
+
+func (p *PathCubic) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	case "control1X":
+		return &p.control1X, true
+	case "control1Y":
+		return &p.control1Y, true
+	case "control2X":
+		return &p.control2X, true
+	case "control2Y":
+		return &p.control2Y, true
+	default:
+		return p.Item.Property(key)
+	}
+}
+
+func (p *PathCubic) MustProperty(key string) vit.Value {
+	v, ok := p.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (p *PathCubic) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "x":
+		err = p.x.SetValue(value)
+	case "y":
+		err = p.y.SetValue(value)
+	case "control1X":
+		err = p.control1X.SetValue(value)
+	case "control1Y":
+		err = p.control1Y.SetValue(value)
+	case "control2X":
+		err = p.control2X.SetValue(value)
+	case "control2Y":
+		err = p.control2Y.SetValue(value)
+	default:
+		return p.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("PathCubic", key, p.id, err)
+	}
+	return nil
+}
+
+func (p *PathCubic) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "x":
+		p.x.SetCode(code)
+	case "y":
+		p.y.SetCode(code)
+	case "control1X":
+		p.control1X.SetCode(code)
+	case "control1Y":
+		p.control1Y.SetCode(code)
+	case "control2X":
+		p.control2X.SetCode(code)
+	case "control2Y":
+		p.control2Y.SetCode(code)
+	default:
+		return p.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (p *PathCubic) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return p.Item.Event(name)
+	}
+}
+
+func (p *PathCubic) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	case "control1X":
+		return &p.control1X, true
+	case "control1Y":
+		return &p.control1Y, true
+	case "control2X":
+		return &p.control2X, true
+	case "control2Y":
+		return &p.control2Y, true
+	default:
+		return p.Item.ResolveVariable(key)
+	}
+}
+
+func (p *PathCubic) AddChild(child vit.Component) {
+	child.SetParent(p)
+	p.AddChildButKeepParent(child)
+}
+
+func (p *PathCubic) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range p.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(p)
+			p.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	p.AddChild(addThis)
+}
+
+func (p *PathCubic) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = p
+	}
+	// properties
+	if changed, err := p.x.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathCubic", "x", p.id, err))
+		}
+	}
+	if changed, err := p.y.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathCubic", "y", p.id, err))
+		}
+	}
+	if changed, err := p.control1X.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathCubic", "control1X", p.id, err))
+		}
+	}
+	if changed, err := p.control1Y.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathCubic", "control1Y", p.id, err))
+		}
+	}
+	if changed, err := p.control2X.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathCubic", "control2X", p.id, err))
+		}
+	}
+	if changed, err := p.control2Y.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathCubic", "control2Y", p.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := p.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (p *PathCubic) As(target *vit.Component) bool {
+	if _, ok := (*target).(*PathCubic); ok {
+		*target = p
+		return true
+	}
+	return p.Item.As(target)
+}
+
+func (p *PathCubic) ID() string {
+	return p.id
+}
+
+func (p *PathCubic) Finish() error {
+	return p.RootC().FinishInContext(p)
+}
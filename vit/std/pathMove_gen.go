@@ -0,0 +1,183 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForPathMove(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type PathMove struct {
+	*Item
+	id string
+
+	x vit.FloatValue
+	y vit.FloatValue
+}
+
+// newPathMoveInGlobal creates an appropriate file context for the component and then returns a new PathMove instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newPathMoveInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*PathMove, error) {
+	fileCtx, err := newFileContextForPathMove(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewPathMove(id, fileCtx), nil
+}
+func NewPathMove(id string, context *vit.FileContext) *PathMove {
+	p := &PathMove{
+		Item: NewItem("", context),
+		id:   id,
+		x:    *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		y:    *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	p.x.AddDependent(vit.FuncDep(p.elementChanged))
+	p.y.AddDependent(vit.FuncDep(p.elementChanged))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", p)
+
+	return p
+}
+
+func (p *PathMove) String() string {
+	return fmt.Sprintf("PathMove(%s)", p.id)
+}
+
+func (p *PathMove) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	default:
+		return p.Item.Property(key)
+	}
+}
+
+func (p *PathMove) MustProperty(key string) vit.Value {
+	v, ok := p.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (p *PathMove) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "x":
+		err = p.x.SetValue(value)
+	case "y":
+		err = p.y.SetValue(value)
+	default:
+		return p.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("PathMove", key, p.id, err)
+	}
+	return nil
+}
+
+func (p *PathMove) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "x":
+		p.x.SetCode(code)
+	case "y":
+		p.y.SetCode(code)
+	default:
+		return p.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (p *PathMove) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return p.Item.Event(name)
+	}
+}
+
+func (p *PathMove) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	default:
+		return p.Item.ResolveVariable(key)
+	}
+}
+
+func (p *PathMove) AddChild(child vit.Component) {
+	child.SetParent(p)
+	p.AddChildButKeepParent(child)
+}
+
+func (p *PathMove) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range p.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(p)
+			p.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	p.AddChild(addThis)
+}
+
+func (p *PathMove) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = p
+	}
+	// properties
+	if changed, err := p.x.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathMove", "x", p.id, err))
+		}
+	}
+	if changed, err := p.y.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathMove", "y", p.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := p.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (p *PathMove) As(target *vit.Component) bool {
+	if _, ok := (*target).(*PathMove); ok {
+		*target = p
+		return true
+	}
+	return p.Item.As(target)
+}
+
+func (p *PathMove) ID() string {
+	return p.id
+}
+
+func (p *PathMove) Finish() error {
+	return p.RootC().FinishInContext(p)
+}
@@ -0,0 +1,217 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForPathQuad(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type PathQuad struct {
+	*Item
+	id string
+
+	x        vit.FloatValue
+	y        vit.FloatValue
+	controlX vit.FloatValue
+	controlY vit.FloatValue
+}
+
+// newPathQuadInGlobal creates an appropriate file context for the component and then returns a new PathQuad instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newPathQuadInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*PathQuad, error) {
+	fileCtx, err := newFileContextForPathQuad(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewPathQuad(id, fileCtx), nil
+}
+func NewPathQuad(id string, context *vit.FileContext) *PathQuad {
+	p := &PathQuad{
+		Item:     NewItem("", context),
+		id:       id,
+		x:        *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		y:        *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		controlX: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		controlY: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	p.x.AddDependent(vit.FuncDep(p.elementChanged))
+	p.y.AddDependent(vit.FuncDep(p.elementChanged))
+	p.controlX.AddDependent(vit.FuncDep(p.elementChanged))
+	p.controlY.AddDependent(vit.FuncDep(p.elementChanged))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", p)
+
+	return p
+}
+
+func (p *PathQuad) String() string {
+	return fmt.Sprintf("PathQuad(%s)", p.id)
+}
+
+func (p *PathQuad) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	case "controlX":
+		return &p.controlX, true
+	case "controlY":
+		return &p.controlY, true
+	default:
+		return p.Item.Property(key)
+	}
+}
+
+func (p *PathQuad) MustProperty(key string) vit.Value {
+	v, ok := p.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (p *PathQuad) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "x":
+		err = p.x.SetValue(value)
+	case "y":
+		err = p.y.SetValue(value)
+	case "controlX":
+		err = p.controlX.SetValue(value)
+	case "controlY":
+		err = p.controlY.SetValue(value)
+	default:
+		return p.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("PathQuad", key, p.id, err)
+	}
+	return nil
+}
+
+func (p *PathQuad) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "x":
+		p.x.SetCode(code)
+	case "y":
+		p.y.SetCode(code)
+	case "controlX":
+		p.controlX.SetCode(code)
+	case "controlY":
+		p.controlY.SetCode(code)
+	default:
+		return p.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (p *PathQuad) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return p.Item.Event(name)
+	}
+}
+
+func (p *PathQuad) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "x":
+		return &p.x, true
+	case "y":
+		return &p.y, true
+	case "controlX":
+		return &p.controlX, true
+	case "controlY":
+		return &p.controlY, true
+	default:
+		return p.Item.ResolveVariable(key)
+	}
+}
+
+func (p *PathQuad) AddChild(child vit.Component) {
+	child.SetParent(p)
+	p.AddChildButKeepParent(child)
+}
+
+func (p *PathQuad) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range p.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(p)
+			p.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	p.AddChild(addThis)
+}
+
+func (p *PathQuad) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = p
+	}
+	// properties
+	if changed, err := p.x.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathQuad", "x", p.id, err))
+		}
+	}
+	if changed, err := p.y.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathQuad", "y", p.id, err))
+		}
+	}
+	if changed, err := p.controlX.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathQuad", "controlX", p.id, err))
+		}
+	}
+	if changed, err := p.controlY.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("PathQuad", "controlY", p.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := p.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (p *PathQuad) As(target *vit.Component) bool {
+	if _, ok := (*target).(*PathQuad); ok {
+		*target = p
+		return true
+	}
+	return p.Item.As(target)
+}
+
+func (p *PathQuad) ID() string {
+	return p.id
+}
+
+func (p *PathQuad) Finish() error {
+	return p.RootC().FinishInContext(p)
+}
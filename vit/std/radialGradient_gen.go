@@ -0,0 +1,213 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForRadialGradient(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type RadialGradient struct {
+	*Item
+	id string
+
+	centerX          vit.FloatValue
+	centerY          vit.FloatValue
+	horizontalRadius vit.FloatValue
+	verticalRadius   vit.FloatValue
+}
+
+// newRadialGradientInGlobal creates an appropriate file context for the component and then returns a new RadialGradient instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newRadialGradientInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*RadialGradient, error) {
+	fileCtx, err := newFileContextForRadialGradient(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewRadialGradient(id, fileCtx), nil
+}
+func NewRadialGradient(id string, context *vit.FileContext) *RadialGradient {
+	r := &RadialGradient{
+		Item:             NewItem("", context),
+		id:               id,
+		centerX:          *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0.5", Position: nil}),
+		centerY:          *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0.5", Position: nil}),
+		horizontalRadius: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0.5", Position: nil}),
+		verticalRadius:   *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0.5", Position: nil}),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", r)
+
+	return r
+}
+
+func (r *RadialGradient) String() string {
+	return fmt.Sprintf("RadialGradient(%s)", r.id)
+}
+
+func (r *RadialGradient) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "centerX":
+		return &r.centerX, true
+	case "centerY":
+		return &r.centerY, true
+	case "horizontalRadius":
+		return &r.horizontalRadius, true
+	case "verticalRadius":
+		return &r.verticalRadius, true
+	default:
+		return r.Item.Property(key)
+	}
+}
+
+func (r *RadialGradient) MustProperty(key string) vit.Value {
+	v, ok := r.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (r *RadialGradient) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "centerX":
+		err = r.centerX.SetValue(value)
+	case "centerY":
+		err = r.centerY.SetValue(value)
+	case "horizontalRadius":
+		err = r.horizontalRadius.SetValue(value)
+	case "verticalRadius":
+		err = r.verticalRadius.SetValue(value)
+	default:
+		return r.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("RadialGradient", key, r.id, err)
+	}
+	return nil
+}
+
+func (r *RadialGradient) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "centerX":
+		r.centerX.SetCode(code)
+	case "centerY":
+		r.centerY.SetCode(code)
+	case "horizontalRadius":
+		r.horizontalRadius.SetCode(code)
+	case "verticalRadius":
+		r.verticalRadius.SetCode(code)
+	default:
+		return r.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (r *RadialGradient) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return r.Item.Event(name)
+	}
+}
+
+func (r *RadialGradient) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "centerX":
+		return &r.centerX, true
+	case "centerY":
+		return &r.centerY, true
+	case "horizontalRadius":
+		return &r.horizontalRadius, true
+	case "verticalRadius":
+		return &r.verticalRadius, true
+	default:
+		return r.Item.ResolveVariable(key)
+	}
+}
+
+func (r *RadialGradient) AddChild(child vit.Component) {
+	child.SetParent(r)
+	r.AddChildButKeepParent(child)
+}
+
+func (r *RadialGradient) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range r.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(r)
+			r.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	r.AddChild(addThis)
+}
+
+func (r *RadialGradient) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = r
+	}
+	// properties
+	if changed, err := r.centerX.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("RadialGradient", "centerX", r.id, err))
+		}
+	}
+	if changed, err := r.centerY.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("RadialGradient", "centerY", r.id, err))
+		}
+	}
+	if changed, err := r.horizontalRadius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("RadialGradient", "horizontalRadius", r.id, err))
+		}
+	}
+	if changed, err := r.verticalRadius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("RadialGradient", "verticalRadius", r.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := r.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (r *RadialGradient) As(target *vit.Component) bool {
+	if _, ok := (*target).(*RadialGradient); ok {
+		*target = r
+		return true
+	}
+	return r.Item.As(target)
+}
+
+func (r *RadialGradient) ID() string {
+	return r.id
+}
+
+func (r *RadialGradient) Finish() error {
+	return r.RootC().FinishInContext(r)
+}
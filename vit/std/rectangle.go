@@ -1,46 +1,366 @@
 package std
 
 import (
-	"fmt"
+	"image/color"
 	"math"
 
 	"github.com/omniskop/vitrum/vit"
-	parse "github.com/omniskop/vitrum/vit/parse"
 	"github.com/tdewolff/canvas"
 )
 
+// borderTolerance is used to flatten the border before it is split into its sides.
+const borderTolerance = 0.05
+
+// The sides of a rectangle are always listed in the order top, right, bottom, left.
+const (
+	sideTop = iota
+	sideRight
+	sideBottom
+	sideLeft
+)
+
+// The corners of a rectangle are always listed in the order top left, top right, bottom right, bottom left.
+type cornerRadii [4]struct{ x, y float64 }
+
+// rectangleBorder contains the resolved properties of the border of a rectangle.
+type rectangleBorder struct {
+	widths    [4]float64
+	colors    [4]color.RGBA
+	style     Rectangle_BorderStyle
+	placement Rectangle_BorderPlacement
+}
+
 func (r *Rectangle) Draw(ctx vit.DrawingContext, area vit.Rect) error {
 	rect := r.Bounds()
 
+	var grad gradientFill
 	if r.gradient.IsSet() {
-		compDef := r.gradient.Value().ComponentDefinition()
-		instance, err := parse.InstantiateComponent(compDef, r.gradient.Value().Context())
+		var err error
+		grad, err = instantiateGradient(r.gradient.Value())
 		if err != nil {
-			fmt.Println(err)
-			return err
+			// the rectangle is filled with its color instead
+			r.Context().Global.Environment.Logger().Printf("Rectangle: gradient: %v\r\n", err)
 		}
-		instance.UpdateExpressions(nil)
-		grad := instance.(*Gradient).ConstructGradient(canvas.Point{X: rect.X1, Y: rect.Y1}, canvas.Point{X: rect.X1, Y: rect.Y2})
-		ctx.SetFillGradient(grad)
+	}
+
+	radii := r.cornerRadii()
+	shape := roundedRectangle(rect, [4]float64{}, radii)
+	if grad != nil {
+		r.gradientCache.fill(ctx, grad, shape, rect)
 	} else {
+		ctx.Push()
 		ctx.SetFillColor(r.color.Color())
+		ctx.SetStrokeColor(canvas.Transparent)
+		ctx.DrawPath(0, 0, shape)
+		ctx.Pop()
 	}
 
-	borderWidth := float64(r.border.MustGet("width").GetValue().(int))
-	if borderWidth > 0 {
-		ctx.SetStrokeJoiner(canvas.MiterJoin)
-		ctx.SetStrokeColor(r.border.MustGet("color").(*vit.ColorValue).Color())
-		ctx.SetStrokeWidth(float64(r.border.MustGet("width").(*vit.IntValue).Int()))
-		defer ctx.SetStrokeWidth(0)
-		radius := math.Max(r.radius.Float64()-borderWidth/2, 0) // decrease radius. Make sure it can't get below 0
-		ctx.DrawPath(
-			rect.X1+borderWidth/2,
-			rect.Y1+borderWidth/2,
-			canvas.RoundedRectangle(rect.Width()-borderWidth, rect.Height()-borderWidth, radius),
-		)
-	} else {
-		ctx.DrawPath(rect.X1, rect.Y1, canvas.RoundedRectangle(rect.Width(), rect.Height(), r.radius.Float64()))
+	border := r.resolveBorder()
+	if border.visible() {
+		ctx.Push()
+		ctx.SetStrokeColor(canvas.Transparent)
+		for _, piece := range border.pieces(rect, radii) {
+			ctx.SetFillColor(piece.color)
+			ctx.DrawPath(0, 0, piece.path)
+		}
+		ctx.Pop()
 	}
 
 	return r.Root.DrawChildren(ctx, rect)
 }
+
+// cornerRadii returns the radii of all corners. Negative radii are treated as 0.
+func (r *Rectangle) cornerRadii() cornerRadii {
+	var radii cornerRadii
+	for i, value := range []*vit.OptionalValue[*vit.FloatValue]{&r.topLeftRadius, &r.topRightRadius, &r.bottomRightRadius, &r.bottomLeftRadius} {
+		radius := r.radius.Float64()
+		if value.IsSet() {
+			radius = value.Value().Float64()
+		}
+		radius = math.Max(radius, 0)
+		radii[i].x, radii[i].y = radius, radius
+	}
+	return radii
+}
+
+// resolveBorder reads the border group and falls back to the general width and color for all sides that are not set explicitly.
+func (r *Rectangle) resolveBorder() rectangleBorder {
+	border := rectangleBorder{
+		style:     Rectangle_BorderStyle(r.border.MustGet("style").(*vit.IntValue).Int()),
+		placement: Rectangle_BorderPlacement(r.border.MustGet("placement").(*vit.IntValue).Int()),
+	}
+	width := r.border.MustGet("width").(*vit.FloatValue).Float64()
+	col := r.border.MustGet("color").(*vit.ColorValue).RGBAColor()
+	for i, side := range []string{"top", "right", "bottom", "left"} {
+		border.widths[i] = width
+		if value := r.border.MustGet(side).(*vit.OptionalValue[*vit.FloatValue]); value.IsSet() {
+			border.widths[i] = value.Value().Float64()
+		}
+		border.widths[i] = math.Max(border.widths[i], 0)
+		border.colors[i] = col
+		if value := r.border.MustGet(side + "Color").(*vit.OptionalValue[*vit.ColorValue]); value.IsSet() {
+			border.colors[i] = value.Value().RGBAColor()
+		}
+	}
+	return border
+}
+
+// visible returns true if at least one side of the border would be visible.
+func (b rectangleBorder) visible() bool {
+	for i := range b.widths {
+		if b.widths[i] > 0 && b.colors[i].A > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// uniform returns true if all sides have the same width and color.
+func (b rectangleBorder) uniform() bool {
+	for i := 1; i < 4; i++ {
+		if b.widths[i] != b.widths[0] || b.colors[i] != b.colors[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// offsets returns how far the edge of the border that is the given fraction of the way from the inner to the outer edge lies outside of the bounds.
+func (b rectangleBorder) offsets(widths [4]float64, fraction float64) [4]float64 {
+	var outside float64 // the part of the border that lies outside of the bounds
+	switch b.placement {
+	case Rectangle_BorderPlacement_Center:
+		outside = 0.5
+	case Rectangle_BorderPlacement_Outside:
+		outside = 1
+	}
+	var offsets [4]float64
+	for i, width := range widths {
+		offsets[i] = width * (outside - 1 + fraction)
+	}
+	return offsets
+}
+
+type borderPiece struct {
+	path  *canvas.Path
+	color color.RGBA
+}
+
+// pieces returns the filled areas that make up the border.
+// If the sides differ in width or color the border is split along the lines through the outer and inner corners.
+func (b rectangleBorder) pieces(rect vit.Rect, radii cornerRadii) []borderPiece {
+	if b.uniform() {
+		if b.style == Rectangle_BorderStyle_Solid {
+			return []borderPiece{{b.solidShape(rect, radii, b.widths), b.colors[0]}}
+		}
+		shape := &canvas.Path{}
+		for _, element := range b.patternElements(rect, radii, b.widths[0]) {
+			shape = shape.Append(element.path)
+		}
+		return []borderPiece{{shape, b.colors[0]}}
+	}
+
+	outer, inner := b.offsets(b.widths, 1), b.offsets(b.widths, 0)
+	// corners of the outer and inner edges without considering any radii
+	outerBox := [4]float64{rect.Y1 - outer[sideTop], rect.X2 + outer[sideRight], rect.Y2 + outer[sideBottom], rect.X1 - outer[sideLeft]}
+	innerBox := [4]float64{rect.Y1 - inner[sideTop], rect.X2 + inner[sideRight], rect.Y2 + inner[sideBottom], rect.X1 - inner[sideLeft]}
+	if innerBox[sideLeft] > innerBox[sideRight] {
+		innerBox[sideLeft] = (innerBox[sideLeft] + innerBox[sideRight]) / 2
+		innerBox[sideRight] = innerBox[sideLeft]
+	}
+	if innerBox[sideTop] > innerBox[sideBottom] {
+		innerBox[sideTop] = (innerBox[sideTop] + innerBox[sideBottom]) / 2
+		innerBox[sideBottom] = innerBox[sideTop]
+	}
+	var outerCorners, innerCorners [4]canvas.Point
+	for i := range outerCorners {
+		outerCorners[i], innerCorners[i] = boxCorner(outerBox, i), boxCorner(innerBox, i)
+	}
+	center := canvas.Point{X: (innerBox[sideLeft] + innerBox[sideRight]) / 2, Y: (innerBox[sideTop] + innerBox[sideBottom]) / 2}
+
+	var pieces []borderPiece
+	var solid *canvas.Path
+	for side := 0; side < 4; side++ {
+		if b.widths[side] <= 0 || b.colors[side].A == 0 {
+			continue
+		}
+		// The side starts at the corner with the same index and ends at the next one.
+		next := (side + 1) % 4
+		var shape *canvas.Path
+		if b.style == Rectangle_BorderStyle_Solid {
+			// The area of the side is bounded by the lines through the outer and inner corners which are extended to the outside.
+			region := &canvas.Path{}
+			region.MoveTo(center.X, center.Y)
+			for _, p := range []canvas.Point{innerCorners[side], extend(innerCorners[side], outerCorners[side]), extend(innerCorners[next], outerCorners[next]), innerCorners[next]} {
+				region.LineTo(p.X, p.Y)
+			}
+			region.Close()
+			if solid == nil {
+				solid = b.solidShape(rect, radii, b.widths).Flatten(borderTolerance)
+			}
+			shape = solid.And(region)
+		} else {
+			// Dashes and dots are not cut apart. Each one belongs to the side that contains its center.
+			// Points on the line through a corner belong to the side that starts there.
+			shape = &canvas.Path{}
+			for _, element := range b.patternElements(rect, radii, b.widths[side]) {
+				if !leftOf(innerCorners[side], outerCorners[side], element.center) && leftOf(innerCorners[next], outerCorners[next], element.center) {
+					shape = shape.Append(element.path)
+				}
+			}
+		}
+		if !shape.Empty() {
+			pieces = append(pieces, borderPiece{shape, b.colors[side]})
+		}
+	}
+	return pieces
+}
+
+// boxCorner returns the corner with the given index of a box that contains the positions of its sides.
+func boxCorner(box [4]float64, i int) canvas.Point {
+	x, y := box[sideLeft], box[sideTop]
+	if i == 1 || i == 2 {
+		x = box[sideRight]
+	}
+	if i == 2 || i == 3 {
+		y = box[sideBottom]
+	}
+	return canvas.Point{X: x, Y: y}
+}
+
+// leftOf returns true if the point lies to the left of the line from a to b, assuming that the y axis points down.
+func leftOf(a, b, p canvas.Point) bool {
+	d, v := b.Sub(a), p.Sub(a)
+	return d.X*v.Y-d.Y*v.X < 0
+}
+
+// extend returns a point on the line from the inner to the outer point that lies far beyond the outer point.
+func extend(inner, outer canvas.Point) canvas.Point {
+	d := outer.Sub(inner)
+	if d.Length() == 0 {
+		return outer
+	}
+	return outer.Add(d.Norm(d.Length() + 100))
+}
+
+// solidShape returns the area of a solid border with the given widths.
+func (b rectangleBorder) solidShape(rect vit.Rect, radii cornerRadii, widths [4]float64) *canvas.Path {
+	outer := roundedRectangle(rect, b.offsets(widths, 1), radii)
+	inner := roundedRectangle(rect, b.offsets(widths, 0), radii)
+	// the inner edge runs in the opposite direction to cut it out of the outer one
+	return outer.Append(inner.Reverse())
+}
+
+// patternElement is a single dash or dot of a border.
+type patternElement struct {
+	path   *canvas.Path
+	center canvas.Point
+}
+
+// patternElements returns the dashes or dots of a border that has the given width on all sides.
+func (b rectangleBorder) patternElements(rect vit.Rect, radii cornerRadii, width float64) []patternElement {
+	if width <= 0 {
+		return nil
+	}
+	line := roundedRectangle(rect, b.offsets([4]float64{width, width, width, width}, 0.5), radii).Flatten(borderTolerance)
+	length := line.Length()
+	var elements []patternElement
+	if b.style == Rectangle_BorderStyle_Dotted {
+		// dots have a diameter of the width and are separated by the width
+		count := math.Max(1, math.Round(length/(2*width)))
+		for _, p := range pointsAlong(line, length/count) {
+			elements = append(elements, patternElement{canvas.Circle(width/2).Translate(p.X, p.Y), p})
+		}
+		return elements
+	}
+	// dashes are three times as long as the width and separated by twice the width
+	// the line starts at the top left corner which is centered on a dash
+	count := math.Max(1, math.Round(length/(5*width)))
+	period := length / count
+	for _, dash := range line.Dash(period*3/10, period*3/5, period*2/5).Split() {
+		center := dash.SplitAt(dash.Length() / 2)[0].Pos()
+		elements = append(elements, patternElement{dash.Stroke(width, canvas.ButtCap, canvas.MiterJoin, borderTolerance), center})
+	}
+	return elements
+}
+
+// pointsAlong returns points on the polyline that are the given distance apart, starting at the beginning of the line.
+func pointsAlong(line *canvas.Path, distance float64) []canvas.Point {
+	coords := line.Coords()
+	if len(coords) == 0 || distance <= 0 {
+		return nil
+	}
+	if line.Closed() && coords[len(coords)-1] != coords[0] {
+		coords = append(coords, coords[0])
+	}
+	points := []canvas.Point{coords[0]}
+	next := distance // the distance along the line of the next point
+	walked := 0.0
+	for i := 1; i < len(coords); i++ {
+		segment := coords[i].Sub(coords[i-1])
+		length := segment.Length()
+		// the small epsilon prevents a point at the very end of a closed line which would overlap the first one
+		for next+1e-6 < walked+length {
+			points = append(points, coords[i-1].Add(segment.Mul((next-walked)/length)))
+			next += distance
+		}
+		walked += length
+	}
+	return points
+}
+
+// roundedRectangle returns the outline of the rectangle with each side moved to the outside by the given offset.
+// The radii are specified for the edge of the rectangle and are grown or shrunk with the offsets. Corners without a radius stay sharp.
+// Like in CSS all radii are scaled down proportionally if adjacent ones don't fit.
+func roundedRectangle(rect vit.Rect, offsets [4]float64, radii cornerRadii) *canvas.Path {
+	x1, y1 := rect.X1-offsets[sideLeft], rect.Y1-offsets[sideTop]
+	x2, y2 := rect.X2+offsets[sideRight], rect.Y2+offsets[sideBottom]
+	width, height := x2-x1, y2-y1
+	if width <= 0 || height <= 0 {
+		return &canvas.Path{}
+	}
+
+	horizontal := [4]int{sideLeft, sideRight, sideRight, sideLeft}
+	vertical := [4]int{sideTop, sideTop, sideBottom, sideBottom}
+	for i := range radii {
+		if radii[i].x > 0 && radii[i].y > 0 {
+			radii[i].x = math.Max(0, radii[i].x+offsets[horizontal[i]])
+			radii[i].y = math.Max(0, radii[i].y+offsets[vertical[i]])
+		}
+	}
+
+	scale := 1.0
+	for _, fit := range []struct{ size, a, b float64 }{
+		{width, radii[0].x, radii[1].x},
+		{width, radii[3].x, radii[2].x},
+		{height, radii[0].y, radii[3].y},
+		{height, radii[1].y, radii[2].y},
+	} {
+		if fit.a+fit.b > fit.size {
+			scale = math.Min(scale, fit.size/(fit.a+fit.b))
+		}
+	}
+	for i := range radii {
+		radii[i].x *= scale
+		radii[i].y *= scale
+	}
+
+	p := &canvas.Path{}
+	arc := func(i int, x, y float64) {
+		if radii[i].x > 0 && radii[i].y > 0 {
+			p.ArcTo(radii[i].x, radii[i].y, 0, false, true, x, y)
+		} else {
+			p.LineTo(x, y)
+		}
+	}
+	p.MoveTo(x1, y1+radii[0].y)
+	arc(0, x1+radii[0].x, y1)
+	p.LineTo(x2-radii[1].x, y1)
+	arc(1, x2, y1+radii[1].y)
+	p.LineTo(x2, y2-radii[2].y)
+	arc(2, x2-radii[2].x, y2)
+	p.LineTo(x1+radii[3].x, y2)
+	arc(3, x1, y2-radii[3].y)
+	p.Close()
+	return p
+}
@@ -12,14 +12,61 @@ func newFileContextForRectangle(globalCtx *vit.GlobalContext) (*vit.FileContext,
 	return vit.NewFileContext(globalCtx), nil
 }
 
+type Rectangle_BorderStyle uint
+
+const (
+	Rectangle_BorderStyle_Solid  Rectangle_BorderStyle = 0
+	Rectangle_BorderStyle_Dashed Rectangle_BorderStyle = 1
+	Rectangle_BorderStyle_Dotted Rectangle_BorderStyle = 2
+)
+
+func (enum Rectangle_BorderStyle) String() string {
+	switch enum {
+	case Rectangle_BorderStyle_Solid:
+		return "Solid"
+	case Rectangle_BorderStyle_Dashed:
+		return "Dashed"
+	case Rectangle_BorderStyle_Dotted:
+		return "Dotted"
+	default:
+		return "<unknownBorderStyle>"
+	}
+}
+
+type Rectangle_BorderPlacement uint
+
+const (
+	Rectangle_BorderPlacement_Inside  Rectangle_BorderPlacement = 0
+	Rectangle_BorderPlacement_Center  Rectangle_BorderPlacement = 1
+	Rectangle_BorderPlacement_Outside Rectangle_BorderPlacement = 2
+)
+
+func (enum Rectangle_BorderPlacement) String() string {
+	switch enum {
+	case Rectangle_BorderPlacement_Inside:
+		return "Inside"
+	case Rectangle_BorderPlacement_Center:
+		return "Center"
+	case Rectangle_BorderPlacement_Outside:
+		return "Outside"
+	default:
+		return "<unknownBorderPlacement>"
+	}
+}
+
 type Rectangle struct {
 	*Item
 	id string
 
-	color    vit.ColorValue
-	radius   vit.FloatValue
-	border   vit.GroupValue
-	gradient vit.OptionalValue[*vit.ComponentDefValue]
+	color             vit.ColorValue
+	radius            vit.FloatValue
+	topLeftRadius     vit.OptionalValue[*vit.FloatValue]
+	topRightRadius    vit.OptionalValue[*vit.FloatValue]
+	bottomRightRadius vit.OptionalValue[*vit.FloatValue]
+	bottomLeftRadius  vit.OptionalValue[*vit.FloatValue]
+	border            vit.GroupValue
+	gradient          vit.OptionalValue[*vit.ComponentDefValue]
+	gradientCache     gradientCache
 }
 
 // newRectangleInGlobal creates an appropriate file context for the component and then returns a new Rectangle instance.
@@ -34,20 +81,47 @@ func newRectangleInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary p
 }
 func NewRectangle(id string, context *vit.FileContext) *Rectangle {
 	r := &Rectangle{
-		Item:   NewItem("", context),
-		id:     id,
-		color:  *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "Vit.rgb(0, 0, 0)", Position: nil}),
-		radius: *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		Item:              NewItem("", context),
+		id:                id,
+		color:             *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "Vit.rgb(0, 0, 0)", Position: nil}),
+		radius:            *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		topLeftRadius:     *vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+		topRightRadius:    *vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+		bottomRightRadius: *vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+		bottomLeftRadius:  *vit.NewOptionalValue(vit.NewEmptyFloatValue()),
 		border: *vit.NewEmptyGroupValue(map[string]vit.Value{
-			"color": vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"transparent\"", Position: nil}),
-			"width": vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+			"color":       vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"transparent\"", Position: nil}),
+			"width":       vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+			"style":       vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "BorderStyle.Solid", Position: nil}),
+			"placement":   vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "BorderPlacement.Inside", Position: nil}),
+			"top":         vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"right":       vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"bottom":      vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"left":        vit.NewOptionalValue(vit.NewEmptyFloatValue()),
+			"topColor":    vit.NewOptionalValue(vit.NewEmptyColorValue()),
+			"rightColor":  vit.NewOptionalValue(vit.NewEmptyColorValue()),
+			"bottomColor": vit.NewOptionalValue(vit.NewEmptyColorValue()),
+			"leftColor":   vit.NewOptionalValue(vit.NewEmptyColorValue()),
 		}),
-		gradient: *vit.NewOptionalValue(vit.NewEmptyComponentDefValue()),
+		gradient:      *vit.NewOptionalValue(vit.NewEmptyComponentDefValue()),
+		gradientCache: gradientCache{},
 	}
 	// property assignments on embedded components
 	// register listeners for when a property changes
 	// register event listeners
 	// register enumerations
+	r.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "BorderStyle",
+		Position: nil,
+		Values:   map[string]int{"Solid": 0, "Dashed": 1, "Dotted": 2},
+	})
+	r.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "BorderPlacement",
+		Position: nil,
+		Values:   map[string]int{"Inside": 0, "Center": 1, "Outside": 2},
+	})
 	// add child components
 
 	context.RegisterComponent("", r)
@@ -65,6 +139,14 @@ func (r *Rectangle) Property(key string) (vit.Value, bool) {
 		return &r.color, true
 	case "radius":
 		return &r.radius, true
+	case "topLeftRadius":
+		return &r.topLeftRadius, true
+	case "topRightRadius":
+		return &r.topRightRadius, true
+	case "bottomRightRadius":
+		return &r.bottomRightRadius, true
+	case "bottomLeftRadius":
+		return &r.bottomLeftRadius, true
 	case "border":
 		return &r.border, true
 	case "gradient":
@@ -89,6 +171,14 @@ func (r *Rectangle) SetProperty(key string, value interface{}) error {
 		err = r.color.SetValue(value)
 	case "radius":
 		err = r.radius.SetValue(value)
+	case "topLeftRadius":
+		err = r.topLeftRadius.SetValue(value)
+	case "topRightRadius":
+		err = r.topRightRadius.SetValue(value)
+	case "bottomRightRadius":
+		err = r.bottomRightRadius.SetValue(value)
+	case "bottomLeftRadius":
+		err = r.bottomLeftRadius.SetValue(value)
 	case "border":
 		err = r.border.SetValue(value)
 	case "gradient":
@@ -108,6 +198,14 @@ func (r *Rectangle) SetPropertyCode(key string, code vit.Code) error {
 		r.color.SetCode(code)
 	case "radius":
 		r.radius.SetCode(code)
+	case "topLeftRadius":
+		r.topLeftRadius.SetCode(code)
+	case "topRightRadius":
+		r.topRightRadius.SetCode(code)
+	case "bottomRightRadius":
+		r.bottomRightRadius.SetCode(code)
+	case "bottomLeftRadius":
+		r.bottomLeftRadius.SetCode(code)
 	case "border":
 		r.border.SetCode(code)
 	case "gradient":
@@ -131,6 +229,14 @@ func (r *Rectangle) ResolveVariable(key string) (interface{}, bool) {
 		return &r.color, true
 	case "radius":
 		return &r.radius, true
+	case "topLeftRadius":
+		return &r.topLeftRadius, true
+	case "topRightRadius":
+		return &r.topRightRadius, true
+	case "bottomRightRadius":
+		return &r.bottomRightRadius, true
+	case "bottomLeftRadius":
+		return &r.bottomLeftRadius, true
 	case "border":
 		return &r.border, true
 	case "gradient":
@@ -178,6 +284,30 @@ func (r *Rectangle) UpdateExpressions(context vit.Component) (int, vit.ErrorGrou
 			errs.Add(vit.NewPropertyError("Rectangle", "radius", r.id, err))
 		}
 	}
+	if changed, err := r.topLeftRadius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Rectangle", "topLeftRadius", r.id, err))
+		}
+	}
+	if changed, err := r.topRightRadius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Rectangle", "topRightRadius", r.id, err))
+		}
+	}
+	if changed, err := r.bottomRightRadius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Rectangle", "bottomRightRadius", r.id, err))
+		}
+	}
+	if changed, err := r.bottomLeftRadius.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Rectangle", "bottomLeftRadius", r.id, err))
+		}
+	}
 	if changed, err := r.border.Update(context); changed || err != nil {
 		sum++
 		if err != nil {
@@ -214,3 +344,22 @@ func (r *Rectangle) ID() string {
 func (r *Rectangle) Finish() error {
 	return r.RootC().FinishInContext(r)
 }
+
+func (r *Rectangle) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "Solid":
+		return uint(Rectangle_BorderStyle_Solid), true
+	case "Dashed":
+		return uint(Rectangle_BorderStyle_Dashed), true
+	case "Dotted":
+		return uint(Rectangle_BorderStyle_Dotted), true
+	case "Inside":
+		return uint(Rectangle_BorderPlacement_Inside), true
+	case "Center":
+		return uint(Rectangle_BorderPlacement_Center), true
+	case "Outside":
+		return uint(Rectangle_BorderPlacement_Outside), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,116 @@
+package std
+
+import (
+	"image/color"
+	"testing"
+)
+
+const rectangleSource = `import Vit 1.0
+
+Item {
+    width: 500
+    height: 100
+
+    Rectangle {
+        x: 10
+        y: 10
+        width: 80
+        height: 80
+        color: "white"
+        radius: 10
+        topLeftRadius: 0
+        bottomRightRadius: 40
+    }
+
+    Rectangle {
+        x: 110
+        y: 10
+        width: 80
+        height: 80
+        color: "white"
+        border.width: 4
+        border.color: "red"
+        border.top: 10
+        border.leftColor: "blue"
+        border.right: 0
+    }
+
+    Rectangle {
+        x: 210
+        y: 10
+        width: 80
+        height: 80
+        color: "white"
+        border.width: 10
+        border.color: "red"
+        border.placement: Rectangle.Outside
+    }
+
+    Rectangle {
+        x: 310
+        y: 10
+        width: 80
+        height: 80
+        color: "transparent"
+        border.width: 4
+        border.color: "black"
+        border.style: Rectangle.Dashed
+    }
+
+    Rectangle {
+        x: 410
+        y: 10
+        width: 80
+        height: 80
+        color: "white"
+        border.width: 2.5
+        border.color: "black"
+        border.placement: Rectangle.Center
+    }
+}
+`
+
+func TestRectangleBorders(t *testing.T) {
+	manager, _ := loadTestComponent(t, rectangleSource)
+
+	img := renderComponent(t, manager.MainComponent(), 500, 100)
+
+	var (
+		transparent = color.RGBA{0, 0, 0, 0}
+		white       = color.RGBA{255, 255, 255, 255}
+		black       = color.RGBA{0, 0, 0, 255}
+		red         = color.RGBA{255, 0, 0, 255}
+		blue        = color.RGBA{0, 0, 255, 255}
+	)
+	checkPixels(t, img, []pixelTest{
+		// per-corner radii
+		{10, 10, white},
+		{11, 88, transparent},
+		{88, 11, transparent},
+		{80, 80, transparent},
+		{70, 70, white},
+		// per-side widths and colors
+		{150, 14, red},
+		{150, 21, white},
+		{111, 50, blue},
+		{115, 50, white},
+		{188, 50, white},
+		{150, 88, red},
+		// outside placement
+		{205, 50, red},
+		{211, 50, white},
+		{250, 1, red},
+		// dashed border: a dash of 12 units is centered on the top left corner
+		{311, 11, black},
+		{316, 11, black},
+		{311, 16, black},
+		{311, 20, transparent},
+		{320, 11, transparent},
+		{350, 50, transparent},
+		// fractional border centered on the edge
+		{409, 50, black},
+		{410, 50, black},
+		{412, 50, white},
+		{407, 50, transparent},
+	}, 12)
+}
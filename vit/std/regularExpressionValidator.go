@@ -0,0 +1,115 @@
+package std
+
+import (
+	"regexp/syntax"
+	"unicode/utf8"
+)
+
+func (v *RegularExpressionValidator) onRulesChanged(changed func()) {
+	v.rules.changed = changed
+}
+
+func (v *RegularExpressionValidator) compile() {
+	defer v.rules.notify()
+	v.program = nil
+	re, err := syntax.Parse(v.regularExpression.String(), syntax.Perl)
+	if err != nil {
+		v.Context().Global.Environment.Logger().Printf("RegularExpressionValidator: %v\r\n", err)
+		return
+	}
+	v.program, err = syntax.Compile(re.Simplify())
+	if err != nil {
+		v.Context().Global.Environment.Logger().Printf("RegularExpressionValidator: %v\r\n", err)
+	}
+}
+
+// Validate accepts texts that are matched completely by the regular expression.
+// Texts that are the beginning of a possible match are intermediate.
+func (v *RegularExpressionValidator) Validate(text string) ValidationState {
+	if v.program == nil {
+		return AcceptableInput
+	}
+	return matchProgram(v.program, text)
+}
+
+// matchProgram runs the regular expression program on the whole text.
+// Unlike the regexp package it can tell if a text is the beginning of a match.
+func matchProgram(prog *syntax.Prog, text string) ValidationState {
+	var threads, next []uint32
+	visited := make([]bool, len(prog.Inst))
+
+	// add follows all instructions that don't consume a rune and collects the ones that do
+	var add func(list []uint32, pc uint32, context syntax.EmptyOp) []uint32
+	add = func(list []uint32, pc uint32, context syntax.EmptyOp) []uint32 {
+		if visited[pc] {
+			return list
+		}
+		visited[pc] = true
+		inst := &prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			list = add(list, inst.Out, context)
+			return add(list, inst.Arg, context)
+		case syntax.InstCapture, syntax.InstNop:
+			return add(list, inst.Out, context)
+		case syntax.InstEmptyWidth:
+			if syntax.EmptyOp(inst.Arg)&^context == 0 {
+				return add(list, inst.Out, context)
+			}
+			return list
+		case syntax.InstFail:
+			return list
+		default:
+			return append(list, pc)
+		}
+	}
+	reset := func() {
+		for i := range visited {
+			visited[i] = false
+		}
+	}
+
+	first, _ := decodeRune(text)
+	threads = add(threads, uint32(prog.Start), syntax.EmptyOpContext(-1, first))
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		text = text[size:]
+		following, _ := decodeRune(text)
+		reset()
+		next = next[:0]
+		for _, pc := range threads {
+			inst := &prog.Inst[pc]
+			var matches bool
+			switch inst.Op {
+			case syntax.InstRune, syntax.InstRune1:
+				matches = inst.MatchRune(r)
+			case syntax.InstRuneAny:
+				matches = true
+			case syntax.InstRuneAnyNotNL:
+				matches = r != '\n'
+			}
+			if matches {
+				next = add(next, inst.Out, syntax.EmptyOpContext(r, following))
+			}
+		}
+		if len(next) == 0 {
+			return InvalidInput
+		}
+		threads, next = next, threads
+	}
+
+	for _, pc := range threads {
+		if prog.Inst[pc].Op == syntax.InstMatch {
+			return AcceptableInput
+		}
+	}
+	return IntermediateInput
+}
+
+// decodeRune returns the first rune of the text or -1 if it is empty.
+func decodeRune(text string) (rune, int) {
+	if text == "" {
+		return -1, 0
+	}
+	return utf8.DecodeRuneInString(text)
+}
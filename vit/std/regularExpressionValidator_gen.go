@@ -0,0 +1,171 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	"regexp/syntax"
+)
+
+func newFileContextForRegularExpressionValidator(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type RegularExpressionValidator struct {
+	*Item
+	id string
+
+	regularExpression vit.StringValue
+	program           *syntax.Prog
+	rules             rulesListener
+}
+
+// newRegularExpressionValidatorInGlobal creates an appropriate file context for the component and then returns a new RegularExpressionValidator instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newRegularExpressionValidatorInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*RegularExpressionValidator, error) {
+	fileCtx, err := newFileContextForRegularExpressionValidator(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewRegularExpressionValidator(id, fileCtx), nil
+}
+func NewRegularExpressionValidator(id string, context *vit.FileContext) *RegularExpressionValidator {
+	r := &RegularExpressionValidator{
+		Item:              NewItem("", context),
+		id:                id,
+		regularExpression: *vit.NewEmptyStringValue(),
+		program:           nil,
+		rules:             rulesListener{},
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	r.regularExpression.AddDependent(vit.FuncDep(r.compile))
+	// register event listeners
+	// register enumerations
+	// add child components
+
+	context.RegisterComponent("", r)
+
+	return r
+}
+
+func (r *RegularExpressionValidator) String() string {
+	return fmt.Sprintf("RegularExpressionValidator(%s)", r.id)
+}
+
+func (r *RegularExpressionValidator) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "regularExpression":
+		return &r.regularExpression, true
+	default:
+		return r.Item.Property(key)
+	}
+}
+
+func (r *RegularExpressionValidator) MustProperty(key string) vit.Value {
+	v, ok := r.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (r *RegularExpressionValidator) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "regularExpression":
+		err = r.regularExpression.SetValue(value)
+	default:
+		return r.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("RegularExpressionValidator", key, r.id, err)
+	}
+	return nil
+}
+
+func (r *RegularExpressionValidator) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "regularExpression":
+		r.regularExpression.SetCode(code)
+	default:
+		return r.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (r *RegularExpressionValidator) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return r.Item.Event(name)
+	}
+}
+
+func (r *RegularExpressionValidator) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "regularExpression":
+		return &r.regularExpression, true
+	default:
+		return r.Item.ResolveVariable(key)
+	}
+}
+
+func (r *RegularExpressionValidator) AddChild(child vit.Component) {
+	child.SetParent(r)
+	r.AddChildButKeepParent(child)
+}
+
+func (r *RegularExpressionValidator) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range r.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(r)
+			r.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	r.AddChild(addThis)
+}
+
+func (r *RegularExpressionValidator) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = r
+	}
+	// properties
+	if changed, err := r.regularExpression.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("RegularExpressionValidator", "regularExpression", r.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := r.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (r *RegularExpressionValidator) As(target *vit.Component) bool {
+	if _, ok := (*target).(*RegularExpressionValidator); ok {
+		*target = r
+		return true
+	}
+	return r.Item.As(target)
+}
+
+func (r *RegularExpressionValidator) ID() string {
+	return r.id
+}
+
+func (r *RegularExpressionValidator) Finish() error {
+	return r.RootC().FinishInContext(r)
+}
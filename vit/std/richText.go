@@ -0,0 +1,509 @@
+package std
+
+import (
+	"html"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/omniskop/vitrum/vit/vcolor"
+	"github.com/omniskop/vitrum/vit/vfont"
+	"github.com/tdewolff/canvas"
+)
+
+// textSpan is a part of a rich text that is displayed in a single style.
+type textSpan struct {
+	text  string
+	style spanStyle
+}
+
+// spanStyle describes how a textSpan differs from the font of the Text it belongs to.
+type spanStyle struct {
+	bold      bool
+	italic    bool
+	underline bool
+	strikeout bool
+	color     color.Color // nil to use the color of the text
+	scale     float64     // factor for the font size; 0 keeps the size
+	pointSize float64     // overrides the font size if not 0
+	link      string
+}
+
+func (s spanStyle) sizeFactor() float64 {
+	if s.scale == 0 {
+		return 1
+	}
+	return s.scale
+}
+
+// richTextLink is a link in the text of a rich text. Start and end are byte offsets.
+type richTextLink struct {
+	start, end int
+	link       string
+}
+
+// richTextSpans parses the text according to the text format. It returns false if the text should be displayed as plain text.
+func (t *Text) richTextSpans() ([]textSpan, bool) {
+	switch Text_TextFormat(t.textFormat.Int()) {
+	case Text_TextFormat_StyledText:
+		return parseStyledText(t.text.String()), true
+	case Text_TextFormat_MarkdownText:
+		return parseMarkdownText(t.text.String()), true
+	case Text_TextFormat_AutoText:
+		if mightBeStyledText(t.text.String()) {
+			return parseStyledText(t.text.String()), true
+		}
+	}
+	return nil, false
+}
+
+// spanFace loads the font face for a span of rich text.
+func (t *Text) spanFace(style spanStyle) (*canvas.FontFace, error) {
+	family, fontStyle := fontStyle(&t.font, t.color.Color())
+	if style.link != "" {
+		fontStyle.Color = t.linkColor.Color()
+		fontStyle.Underline = true
+	}
+	if style.color != nil {
+		fontStyle.Color = style.color
+	}
+	if style.bold {
+		fontStyle.Weight = vfont.Bold
+	}
+	fontStyle.Italic = fontStyle.Italic || style.italic
+	fontStyle.Underline = fontStyle.Underline || style.underline
+	fontStyle.Strikeout = fontStyle.Strikeout || style.strikeout
+	if style.pointSize > 0 {
+		fontStyle.PointSize = style.pointSize
+	}
+	fontStyle.PointSize *= style.sizeFactor()
+	return vfont.LoadFontFace(family, fontStyle)
+}
+
+// layoutRichText lays out the spans using canvas.RichText.
+// Eliding and the maximumLineCount are not supported for rich text.
+func (t *Text) layoutRichText(spans []textSpan) {
+	layout := &t.textLayout
+	if t.fontFaceData == nil {
+		t.setLayoutSize(0, 0, 0)
+		return
+	}
+
+	rich := canvas.NewRichText(t.fontFaceData)
+	offset := 0
+	for _, span := range spans {
+		face := t.fontFaceData
+		if span.style != (spanStyle{}) {
+			var err error
+			face, err = t.spanFace(span.style)
+			if err != nil {
+				t.Context().Global.Environment.Logger().Printf("Text: font: %v", err)
+				face = t.fontFaceData
+			}
+		}
+		if span.style.link != "" {
+			layout.links = append(layout.links, richTextLink{start: offset, end: offset + len(span.text), link: span.style.link})
+		}
+		rich.WriteFace(face, span.text)
+		offset += len(span.text)
+	}
+
+	var lineStretch float64
+	if Text_LineHeightMode(t.lineHeightMode.Int()) == Text_LineHeightMode_ProportionalHeight {
+		lineStretch = t.lineHeight.Float64() - 1
+	}
+	var hAlign canvas.TextAlign
+	switch Text_HorizontalAlignment(t.horizontalAlignment.Int()) {
+	case Text_HorizontalAlignment_AlignLeft:
+		hAlign = canvas.Left
+	case Text_HorizontalAlignment_AlignHCenter:
+		hAlign = canvas.Center
+	case Text_HorizontalAlignment_AlignRight:
+		hAlign = canvas.Right
+	case Text_HorizontalAlignment_AlignJustify:
+		hAlign = canvas.Justify
+	}
+
+	width := layout.layoutWidth
+	if Text_WrapMode(t.wrapMode.Int()) == Text_WrapMode_NoWrap || math.IsInf(width, 1) {
+		// lines are aligned inside a box that is just wide enough to prevent any wrapping
+		layout.rich = rich.ToText(0, 0, canvas.Left, canvas.Top, 0, lineStretch)
+		natural := layout.rich.Bounds()
+		width = math.Max(natural.X+natural.W+0.01, width)
+		if hAlign == canvas.Left || math.IsInf(width, 1) {
+			width = 0
+		}
+	}
+	if width != 0 {
+		layout.rich = rich.ToText(width, 0, hAlign, canvas.Top, 0, lineStretch)
+	}
+
+	bounds := layout.rich.Bounds()
+	t.setLayoutSize(layout.rich.Lines(), bounds.X+bounds.W, -bounds.Y)
+}
+
+// richTextOrigin returns the position of the top left corner of the rich text.
+func (t *Text) richTextOrigin() (float64, float64) {
+	bounds := t.Bounds()
+	height := t.contentHeight.Float64()
+	switch Text_VerticalAlignment(t.verticalAlignment.Int()) {
+	case Text_VerticalAlignment_AlignVCenter:
+		return bounds.X1, bounds.CenterY() - height/2
+	case Text_VerticalAlignment_AlignBottom:
+		return bounds.X1, bounds.Bottom() - height
+	default:
+		return bounds.X1, bounds.Top()
+	}
+}
+
+// LinkAt returns the link at the given position or an empty string if there is none.
+func (t *Text) LinkAt(x, y float64) string {
+	layout := &t.textLayout
+	if layout.rich == nil || len(layout.links) == 0 {
+		return ""
+	}
+	originX, originY := t.richTextOrigin()
+	// the text is laid out with the y axis pointing upwards
+	x, y = x-originX, originY-y
+
+	var link string
+	layout.rich.WalkSpans(func(spanX, baseline float64, span canvas.TextSpan) {
+		metrics := span.Face.Metrics()
+		if link != "" || !span.IsText() || x < spanX || x > spanX+span.Width || y > baseline+metrics.Ascent || y < baseline-metrics.Descent {
+			return
+		}
+		glyphX := spanX
+		for _, glyph := range span.Glyphs {
+			glyphX += span.Face.MmPerEm * float64(glyph.XAdvance)
+			if x <= glyphX {
+				link = t.linkAtOffset(int(glyph.Cluster))
+				return
+			}
+		}
+	})
+	return link
+}
+
+// linkAtOffset returns the link that contains the given byte offset.
+func (t *Text) linkAtOffset(offset int) string {
+	for _, l := range t.textLayout.links {
+		if offset >= l.start && offset < l.end {
+			return l.link
+		}
+	}
+	return ""
+}
+
+// acceptsMouse returns true if the text contains links and the position is inside of it.
+func (t *Text) acceptsMouse(x, y float64) bool {
+	return len(t.textLayout.links) > 0 && t.Bounds().Contains(x, y)
+}
+
+func (t *Text) handleMouseEvent(e MouseEvent) {
+	layout := &t.textLayout
+	if len(layout.links) == 0 && !layout.mousePressed {
+		return
+	}
+	link := t.LinkAt(float64(e.X), float64(e.Y))
+	if t.hoveredLink.String() != link {
+		t.hoveredLink.SetStringValue(link)
+	}
+
+	left := e.Buttons&MouseArea_MouseButtons_leftButton != 0
+	if left && !layout.mousePressed {
+		layout.mousePressed = true
+		layout.pressedLink = link
+	} else if !left && layout.mousePressed {
+		layout.mousePressed = false
+		if link != "" && link == layout.pressedLink {
+			t.onLinkActivated.Fire(&LinkEvent{Link: link})
+		}
+	}
+}
+
+func (t *Text) mouseExited() {
+	t.textLayout.mousePressed = false
+	if t.hoveredLink.String() != "" {
+		t.hoveredLink.SetStringValue("")
+	}
+}
+
+func (t *Text) cursorShape() (MouseArea_CursorShape, bool) {
+	return MouseArea_CursorShape_PointingHandCursor, t.hoveredLink.String() != ""
+}
+
+// spanWriter collects spans and merges consecutive text with the same style.
+type spanWriter struct {
+	spans []textSpan
+}
+
+func (w *spanWriter) write(text string, style spanStyle) {
+	if text == "" {
+		return
+	}
+	if n := len(w.spans); n > 0 && w.spans[n-1].style == style {
+		w.spans[n-1].text += text
+		return
+	}
+	w.spans = append(w.spans, textSpan{text: text, style: style})
+}
+
+// mightBeStyledText returns true if the text contains something that looks like a tag.
+func mightBeStyledText(text string) bool {
+	for i := 0; i+1 < len(text); i++ {
+		if next := text[i+1]; text[i] == '<' && (next >= 'a' && next <= 'z' || next >= 'A' && next <= 'Z' || next == '/') {
+			return strings.IndexByte(text[i:], '>') > 0
+		}
+	}
+	return false
+}
+
+// parseStyledText parses a small and safe subset of HTML into spans.
+// Supported are the tags b, strong, i, em, u, s, del, strike, a (href), font (color, size), big, small, br and p as well as character references.
+// All other tags are ignored while their content is kept.
+func parseStyledText(text string) []textSpan {
+	var w spanWriter
+	stack := []spanStyle{{}}
+	tags := []string{""}
+	current := func() spanStyle { return stack[len(stack)-1] }
+
+	for len(text) > 0 {
+		start := strings.IndexByte(text, '<')
+		end := -1
+		if start >= 0 {
+			end = strings.IndexByte(text[start:], '>')
+		}
+		if start < 0 || end < 0 {
+			w.write(html.UnescapeString(text), current())
+			break
+		}
+		w.write(html.UnescapeString(text[:start]), current())
+		tag := text[start+1 : start+end]
+		text = text[start+end+1:]
+
+		name, attributes := parseTag(tag)
+		closing := strings.HasPrefix(name, "/")
+		name = strings.TrimPrefix(name, "/")
+		selfClosing := strings.HasSuffix(tag, "/")
+
+		switch {
+		case name == "br":
+			w.write("\n", current())
+			continue
+		case name == "p":
+			if len(w.spans) > 0 && !strings.HasSuffix(w.spans[len(w.spans)-1].text, "\n") {
+				w.write("\n", current())
+			}
+			continue
+		case closing:
+			// close the innermost tag with this name and everything inside of it
+			for i := len(tags) - 1; i > 0; i-- {
+				if tags[i] == name {
+					tags = tags[:i]
+					stack = stack[:i]
+					break
+				}
+			}
+			continue
+		case selfClosing:
+			continue
+		}
+
+		style := current()
+		switch name {
+		case "b", "strong":
+			style.bold = true
+		case "i", "em":
+			style.italic = true
+		case "u":
+			style.underline = true
+		case "s", "del", "strike":
+			style.strikeout = true
+		case "a":
+			style.link = attributes["href"]
+		case "big":
+			style.scale = style.sizeFactor() * 1.2
+		case "small":
+			style.scale = style.sizeFactor() / 1.2
+		case "font":
+			if c, err := vcolor.String(attributes["color"]); err == nil {
+				style.color = c
+			}
+			if size, err := strconv.ParseFloat(strings.TrimSuffix(attributes["size"], "pt"), 64); err == nil && size > 0 {
+				style.pointSize = size
+			}
+		}
+		stack = append(stack, style)
+		tags = append(tags, name)
+	}
+	return w.spans
+}
+
+// parseTag splits the content of a tag into its lower case name and attributes.
+func parseTag(tag string) (string, map[string]string) {
+	tag = strings.TrimSpace(strings.TrimSuffix(tag, "/"))
+	nameEnd := strings.IndexFunc(tag, unicode.IsSpace)
+	if nameEnd < 0 {
+		return strings.ToLower(tag), nil
+	}
+	name := strings.ToLower(tag[:nameEnd])
+	attributes := make(map[string]string)
+	rest := tag[nameEnd:]
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			return name, attributes
+		}
+		keyEnd := strings.IndexFunc(rest, func(r rune) bool { return r == '=' || unicode.IsSpace(r) })
+		if keyEnd < 0 {
+			attributes[strings.ToLower(rest)] = ""
+			return name, attributes
+		}
+		key := strings.ToLower(rest[:keyEnd])
+		rest = strings.TrimLeftFunc(rest[keyEnd:], unicode.IsSpace)
+		if !strings.HasPrefix(rest, "=") {
+			attributes[key] = ""
+			continue
+		}
+		rest = strings.TrimLeftFunc(rest[1:], unicode.IsSpace)
+		var value string
+		if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+			valueEnd := strings.IndexByte(rest[1:], rest[0])
+			if valueEnd < 0 {
+				valueEnd = len(rest) - 1
+			}
+			value = rest[1 : valueEnd+1]
+			if valueEnd+2 < len(rest) {
+				rest = rest[valueEnd+2:]
+			} else {
+				rest = ""
+			}
+		} else {
+			valueEnd := strings.IndexFunc(rest, unicode.IsSpace)
+			if valueEnd < 0 {
+				valueEnd = len(rest)
+			}
+			value = rest[:valueEnd]
+			rest = rest[valueEnd:]
+		}
+		attributes[key] = html.UnescapeString(value)
+	}
+}
+
+// parseMarkdownText parses the inline syntax of Markdown into spans.
+// Supported are emphasis with * and _, strong emphasis with ** and __, strikethrough with ~~, code with ` and links like [text](url).
+// Backslashes escape the following character.
+func parseMarkdownText(text string) []textSpan {
+	var w spanWriter
+	parseMarkdownInline(&w, []rune(text), spanStyle{})
+	return w.spans
+}
+
+func parseMarkdownInline(w *spanWriter, text []rune, style spanStyle) {
+	var plain strings.Builder
+	flush := func() {
+		w.write(plain.String(), style)
+		plain.Reset()
+	}
+
+	for i := 0; i < len(text); i++ {
+		r := text[i]
+		switch {
+		case r == '\\' && i+1 < len(text) && (unicode.IsPunct(text[i+1]) || unicode.IsSymbol(text[i+1])):
+			plain.WriteRune(text[i+1])
+			i++
+			continue
+		case r == '`':
+			if end := indexRunes(text, i+1, "`"); end >= 0 {
+				flush()
+				w.write(string(text[i+1:end]), style)
+				i = end
+				continue
+			}
+		case r == '[':
+			if label, link, end, ok := markdownLink(text, i); ok {
+				flush()
+				inner := style
+				inner.link = link
+				parseMarkdownInline(w, label, inner)
+				i = end
+				continue
+			}
+		case r == '*' || r == '_' || r == '~':
+			delimiter := string(r)
+			if i+1 < len(text) && text[i+1] == r {
+				delimiter += string(r)
+			} else if r == '~' {
+				break
+			}
+			contentStart := i + len(delimiter)
+			if contentStart >= len(text) || unicode.IsSpace(text[contentStart]) {
+				break
+			}
+			end := indexRunes(text, contentStart, delimiter)
+			if end <= contentStart || unicode.IsSpace(text[end-1]) {
+				break
+			}
+			flush()
+			inner := style
+			switch delimiter {
+			case "**", "__":
+				inner.bold = true
+			case "~~":
+				inner.strikeout = true
+			default:
+				inner.italic = true
+			}
+			parseMarkdownInline(w, text[contentStart:end], inner)
+			i = end + len(delimiter) - 1
+			continue
+		}
+		plain.WriteRune(r)
+	}
+	flush()
+}
+
+// markdownLink parses a link like [label](url) that starts at the given position.
+func markdownLink(text []rune, start int) (label []rune, link string, end int, ok bool) {
+	depth := 0
+	for i := start; i < len(text); i++ {
+		switch text[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			if i+1 >= len(text) || text[i+1] != '(' {
+				return nil, "", 0, false
+			}
+			closing := indexRunes(text, i+2, ")")
+			if closing < 0 {
+				return nil, "", 0, false
+			}
+			return text[start+1 : i], strings.TrimSpace(string(text[i+2 : closing])), closing, true
+		}
+	}
+	return nil, "", 0, false
+}
+
+// indexRunes returns the position of the first occurrence of substr in text at or after start or -1.
+func indexRunes(text []rune, start int, substr string) int {
+	sub := []rune(substr)
+	for i := start; i+len(sub) <= len(text); i++ {
+		match := true
+		for j, r := range sub {
+			if text[i+j] != r {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
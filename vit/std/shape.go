@@ -0,0 +1,244 @@
+package std
+
+import (
+	"math"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/tdewolff/canvas"
+)
+
+// shapeTolerance is used to flatten the outline before self intersections are removed for the odd-even fill rule.
+const shapeTolerance = 0.05
+
+// pathElement is implemented by all components that can be used as a child of a Shape to extend its outline.
+type pathElement interface {
+	vit.Component
+	// appendTo adds the element to the path. The path always has a current point.
+	appendTo(path *canvas.Path)
+}
+
+func (s *Shape) childWasAdded(child vit.Component) {
+	s.updatePath()
+}
+
+// updatePath builds the outline from the path data and all path elements and resizes the shape to fit it.
+func (s *Shape) updatePath() {
+	path := &canvas.Path{}
+	if data := s.path.String(); data != "" {
+		var err error
+		path, err = canvas.ParseSVGPath(data)
+		if err != nil {
+			s.Context().Global.Environment.Logger().Printf("invalid path data: %s\r\n", err)
+			path = &canvas.Path{}
+		}
+	}
+	for _, child := range s.Children() {
+		element, ok := child.(pathElement)
+		if !ok {
+			continue
+		}
+		if path.Empty() {
+			path.MoveTo(s.startX.Float64(), s.startY.Float64())
+		}
+		element.appendTo(path)
+	}
+	path = closeCoincidingSubpaths(path)
+	s.outline = path
+
+	if path.Empty() {
+		s.SetContentSize(0, 0)
+		return
+	}
+	// the path is positioned relative to the top left corner of the shape, which is why the origin is always part of the content
+	bounds := path.Bounds()
+	s.SetContentSize(math.Max(0, bounds.X+bounds.W), math.Max(0, bounds.Y+bounds.H))
+}
+
+// closeCoincidingSubpaths closes all subpaths that end where they started so that their ends are joined instead of capped.
+func closeCoincidingSubpaths(path *canvas.Path) *canvas.Path {
+	result := &canvas.Path{}
+	for _, subpath := range path.Split() {
+		coords := subpath.Coords()
+		if !subpath.Closed() && len(coords) > 2 && coords[0].Equals(subpath.Pos()) {
+			subpath.Close()
+		}
+		result = result.Append(subpath)
+	}
+	return result
+}
+
+func (s *Shape) Draw(ctx vit.DrawingContext, area vit.Rect) error {
+	rect := s.Bounds()
+	if s.outline == nil || s.outline.Empty() {
+		return s.Root.DrawChildren(ctx, rect)
+	}
+	path := s.outline.Translate(rect.X1, rect.Y1)
+
+	// Not all renderers support the odd-even fill rule. That's why overlapping parts are removed beforehand, which makes the outline independent of the fill rule.
+	fill := path
+	if Shape_FillRule(s.fillRule.Int()) == Shape_FillRule_OddEvenFill {
+		fill = closedSubpaths(path).Flatten(shapeTolerance).Settle(canvas.EvenOdd)
+	}
+	if s.fillGradient.IsSet() {
+		grad, err := instantiateGradient(s.fillGradient.Value())
+		if err != nil {
+			s.Context().Global.Environment.Logger().Printf("fillGradient: %s\r\n", err)
+		} else {
+			s.gradientCache.fill(ctx, grad, fill, rect)
+		}
+	} else if col := s.fillColor.RGBAColor(); col.A > 0 {
+		ctx.Push()
+		ctx.SetFillColor(col)
+		ctx.SetStrokeColor(canvas.Transparent)
+		ctx.DrawPath(0, 0, fill)
+		ctx.Pop()
+	}
+
+	if width := s.strokeWidth.Float64(); width > 0 && s.strokeColor.RGBAColor().A > 0 {
+		ctx.Push()
+		ctx.SetFillColor(canvas.Transparent)
+		ctx.SetStrokeColor(s.strokeColor.Color())
+		ctx.SetStrokeWidth(width)
+		ctx.SetStrokeCapper(s.capper())
+		ctx.SetStrokeJoiner(s.joiner())
+		if dashes := s.dashes(width); len(dashes) > 0 {
+			ctx.SetDashes(s.dashOffset.Float64()*width, dashes...)
+		}
+		ctx.DrawPath(0, 0, path)
+		ctx.Pop()
+	}
+
+	return s.Root.DrawChildren(ctx, rect)
+}
+
+func (s *Shape) capper() canvas.Capper {
+	switch Shape_CapStyle(s.capStyle.Int()) {
+	case Shape_CapStyle_FlatCap:
+		return canvas.ButtCap
+	case Shape_CapStyle_RoundCap:
+		return canvas.RoundCap
+	}
+	return canvas.SquareCap
+}
+
+func (s *Shape) joiner() canvas.Joiner {
+	switch Shape_JoinStyle(s.joinStyle.Int()) {
+	case Shape_JoinStyle_MiterJoin:
+		return canvas.MiterJoin
+	case Shape_JoinStyle_RoundJoin:
+		return canvas.RoundJoin
+	}
+	return canvas.BevelJoin
+}
+
+// dashes returns the dash pattern scaled by the stroke width. Patterns without any positive length are ignored.
+func (s *Shape) dashes(width float64) []float64 {
+	pattern := floatList(s.dashPattern.GetValue())
+	var total float64
+	for i := range pattern {
+		if pattern[i] < 0 {
+			return nil
+		}
+		pattern[i] *= width
+		total += pattern[i]
+	}
+	if total == 0 {
+		return nil
+	}
+	return pattern
+}
+
+// closedSubpaths returns the path with all subpaths closed, as they would be when filled.
+func closedSubpaths(path *canvas.Path) *canvas.Path {
+	closed := &canvas.Path{}
+	for _, subpath := range path.Split() {
+		if !subpath.Closed() {
+			subpath.Close()
+		}
+		closed = closed.Append(subpath)
+	}
+	return closed
+}
+
+// floatList converts a list from a script into numbers. Values that are not numbers are skipped.
+func floatList(value interface{}) []float64 {
+	var values []interface{}
+	switch value := value.(type) {
+	case []float64:
+		return append([]float64(nil), value...)
+	case []interface{}:
+		values = value
+	default:
+		values = []interface{}{value}
+	}
+	var out []float64
+	for _, v := range values {
+		switch v := v.(type) {
+		case float64:
+			out = append(out, v)
+		case int64:
+			out = append(out, float64(v))
+		case int:
+			out = append(out, float64(v))
+		}
+	}
+	return out
+}
+
+// shapeOf returns the parent of a path element if it is a shape.
+func shapeOf(parent vit.Component) (*Shape, bool) {
+	shape, ok := parent.(*Shape)
+	return shape, ok
+}
+
+func (p *PathMove) elementChanged() {
+	if shape, ok := shapeOf(p.Parent()); ok {
+		shape.updatePath()
+	}
+}
+
+func (p *PathMove) appendTo(path *canvas.Path) {
+	path.MoveTo(p.x.Float64(), p.y.Float64())
+}
+
+func (p *PathLine) elementChanged() {
+	if shape, ok := shapeOf(p.Parent()); ok {
+		shape.updatePath()
+	}
+}
+
+func (p *PathLine) appendTo(path *canvas.Path) {
+	path.LineTo(p.x.Float64(), p.y.Float64())
+}
+
+func (p *PathQuad) elementChanged() {
+	if shape, ok := shapeOf(p.Parent()); ok {
+		shape.updatePath()
+	}
+}
+
+func (p *PathQuad) appendTo(path *canvas.Path) {
+	path.QuadTo(p.controlX.Float64(), p.controlY.Float64(), p.x.Float64(), p.y.Float64())
+}
+
+func (p *PathCubic) elementChanged() {
+	if shape, ok := shapeOf(p.Parent()); ok {
+		shape.updatePath()
+	}
+}
+
+func (p *PathCubic) appendTo(path *canvas.Path) {
+	path.CubeTo(p.control1X.Float64(), p.control1Y.Float64(), p.control2X.Float64(), p.control2Y.Float64(), p.x.Float64(), p.y.Float64())
+}
+
+func (p *PathArc) elementChanged() {
+	if shape, ok := shapeOf(p.Parent()); ok {
+		shape.updatePath()
+	}
+}
+
+func (p *PathArc) appendTo(path *canvas.Path) {
+	// the y axis points down which means that a positive sweep runs clockwise
+	clockwise := PathArc_ArcDirection(p.direction.Int()) == PathArc_ArcDirection_Clockwise
+	path.ArcTo(p.radiusX.Float64(), p.radiusY.Float64(), p.xAxisRotation.Float64(), p.useLargeArc.Bool(), clockwise, p.x.Float64(), p.y.Float64())
+}
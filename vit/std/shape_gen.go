@@ -0,0 +1,452 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	canvas "github.com/tdewolff/canvas"
+)
+
+func newFileContextForShape(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type Shape_JoinStyle uint
+
+const (
+	Shape_JoinStyle_MiterJoin Shape_JoinStyle = 0
+	Shape_JoinStyle_BevelJoin Shape_JoinStyle = 1
+	Shape_JoinStyle_RoundJoin Shape_JoinStyle = 2
+)
+
+func (enum Shape_JoinStyle) String() string {
+	switch enum {
+	case Shape_JoinStyle_MiterJoin:
+		return "MiterJoin"
+	case Shape_JoinStyle_BevelJoin:
+		return "BevelJoin"
+	case Shape_JoinStyle_RoundJoin:
+		return "RoundJoin"
+	default:
+		return "<unknownJoinStyle>"
+	}
+}
+
+type Shape_CapStyle uint
+
+const (
+	Shape_CapStyle_FlatCap   Shape_CapStyle = 0
+	Shape_CapStyle_SquareCap Shape_CapStyle = 1
+	Shape_CapStyle_RoundCap  Shape_CapStyle = 2
+)
+
+func (enum Shape_CapStyle) String() string {
+	switch enum {
+	case Shape_CapStyle_FlatCap:
+		return "FlatCap"
+	case Shape_CapStyle_SquareCap:
+		return "SquareCap"
+	case Shape_CapStyle_RoundCap:
+		return "RoundCap"
+	default:
+		return "<unknownCapStyle>"
+	}
+}
+
+type Shape_FillRule uint
+
+const (
+	Shape_FillRule_WindingFill Shape_FillRule = 0
+	Shape_FillRule_OddEvenFill Shape_FillRule = 1
+)
+
+func (enum Shape_FillRule) String() string {
+	switch enum {
+	case Shape_FillRule_WindingFill:
+		return "WindingFill"
+	case Shape_FillRule_OddEvenFill:
+		return "OddEvenFill"
+	default:
+		return "<unknownFillRule>"
+	}
+}
+
+type Shape struct {
+	*Item
+	id string
+
+	path          vit.StringValue
+	startX        vit.FloatValue
+	startY        vit.FloatValue
+	fillColor     vit.ColorValue
+	fillRule      vit.IntValue
+	fillGradient  vit.OptionalValue[*vit.ComponentDefValue]
+	strokeColor   vit.ColorValue
+	strokeWidth   vit.FloatValue
+	joinStyle     vit.IntValue
+	capStyle      vit.IntValue
+	dashPattern   vit.AnyValue
+	dashOffset    vit.FloatValue
+	outline       *canvas.Path
+	gradientCache gradientCache
+}
+
+// newShapeInGlobal creates an appropriate file context for the component and then returns a new Shape instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newShapeInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*Shape, error) {
+	fileCtx, err := newFileContextForShape(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewShape(id, fileCtx), nil
+}
+func NewShape(id string, context *vit.FileContext) *Shape {
+	s := &Shape{
+		Item:          NewItem("", context),
+		id:            id,
+		path:          *vit.NewEmptyStringValue(),
+		startX:        *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		startY:        *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		fillColor:     *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"white\"", Position: nil}),
+		fillRule:      *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "FillRule.OddEvenFill", Position: nil}),
+		fillGradient:  *vit.NewOptionalValue(vit.NewEmptyComponentDefValue()),
+		strokeColor:   *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"black\"", Position: nil}),
+		strokeWidth:   *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "1", Position: nil}),
+		joinStyle:     *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "JoinStyle.BevelJoin", Position: nil}),
+		capStyle:      *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "CapStyle.SquareCap", Position: nil}),
+		dashPattern:   *vit.NewEmptyAnyValue(),
+		dashOffset:    *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "0", Position: nil}),
+		outline:       nil,
+		gradientCache: gradientCache{},
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	s.path.AddDependent(vit.FuncDep(s.updatePath))
+	s.startX.AddDependent(vit.FuncDep(s.updatePath))
+	s.startY.AddDependent(vit.FuncDep(s.updatePath))
+	// register event listeners
+	// register enumerations
+	s.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "JoinStyle",
+		Position: nil,
+		Values:   map[string]int{"MiterJoin": 0, "BevelJoin": 1, "RoundJoin": 2},
+	})
+	s.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "CapStyle",
+		Position: nil,
+		Values:   map[string]int{"FlatCap": 0, "SquareCap": 1, "RoundCap": 2},
+	})
+	s.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "FillRule",
+		Position: nil,
+		Values:   map[string]int{"WindingFill": 0, "OddEvenFill": 1},
+	})
+	// add child components
+
+	context.RegisterComponent("", s)
+
+	return s
+}
+
+func (s *Shape) String() string {
+	return fmt.Sprintf("Shape(%s)", s.id)
+}
+
+func (s *Shape) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "path":
+		return &s.path, true
+	case "startX":
+		return &s.startX, true
+	case "startY":
+		return &s.startY, true
+	case "fillColor":
+		return &s.fillColor, true
+	case "fillRule":
+		return &s.fillRule, true
+	case "fillGradient":
+		return &s.fillGradient, true
+	case "strokeColor":
+		return &s.strokeColor, true
+	case "strokeWidth":
+		return &s.strokeWidth, true
+	case "joinStyle":
+		return &s.joinStyle, true
+	case "capStyle":
+		return &s.capStyle, true
+	case "dashPattern":
+		return &s.dashPattern, true
+	case "dashOffset":
+		return &s.dashOffset, true
+	default:
+		return s.Item.Property(key)
+	}
+}
+
+func (s *Shape) MustProperty(key string) vit.Value {
+	v, ok := s.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (s *Shape) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "path":
+		err = s.path.SetValue(value)
+	case "startX":
+		err = s.startX.SetValue(value)
+	case "startY":
+		err = s.startY.SetValue(value)
+	case "fillColor":
+		err = s.fillColor.SetValue(value)
+	case "fillRule":
+		err = s.fillRule.SetValue(value)
+	case "fillGradient":
+		err = s.fillGradient.SetValue(value)
+	case "strokeColor":
+		err = s.strokeColor.SetValue(value)
+	case "strokeWidth":
+		err = s.strokeWidth.SetValue(value)
+	case "joinStyle":
+		err = s.joinStyle.SetValue(value)
+	case "capStyle":
+		err = s.capStyle.SetValue(value)
+	case "dashPattern":
+		err = s.dashPattern.SetValue(value)
+	case "dashOffset":
+		err = s.dashOffset.SetValue(value)
+	default:
+		return s.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("Shape", key, s.id, err)
+	}
+	return nil
+}
+
+func (s *Shape) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "path":
+		s.path.SetCode(code)
+	case "startX":
+		s.startX.SetCode(code)
+	case "startY":
+		s.startY.SetCode(code)
+	case "fillColor":
+		s.fillColor.SetCode(code)
+	case "fillRule":
+		s.fillRule.SetCode(code)
+	case "fillGradient":
+		s.fillGradient.SetCode(code)
+	case "strokeColor":
+		s.strokeColor.SetCode(code)
+	case "strokeWidth":
+		s.strokeWidth.SetCode(code)
+	case "joinStyle":
+		s.joinStyle.SetCode(code)
+	case "capStyle":
+		s.capStyle.SetCode(code)
+	case "dashPattern":
+		s.dashPattern.SetCode(code)
+	case "dashOffset":
+		s.dashOffset.SetCode(code)
+	default:
+		return s.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (s *Shape) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	default:
+		return s.Item.Event(name)
+	}
+}
+
+func (s *Shape) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "path":
+		return &s.path, true
+	case "startX":
+		return &s.startX, true
+	case "startY":
+		return &s.startY, true
+	case "fillColor":
+		return &s.fillColor, true
+	case "fillRule":
+		return &s.fillRule, true
+	case "fillGradient":
+		return &s.fillGradient, true
+	case "strokeColor":
+		return &s.strokeColor, true
+	case "strokeWidth":
+		return &s.strokeWidth, true
+	case "joinStyle":
+		return &s.joinStyle, true
+	case "capStyle":
+		return &s.capStyle, true
+	case "dashPattern":
+		return &s.dashPattern, true
+	case "dashOffset":
+		return &s.dashOffset, true
+	default:
+		return s.Item.ResolveVariable(key)
+	}
+}
+
+func (s *Shape) AddChild(child vit.Component) {
+	defer s.childWasAdded(child)
+	child.SetParent(s)
+	s.AddChildButKeepParent(child)
+}
+
+func (s *Shape) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	defer s.childWasAdded(addThis)
+	var targetType vit.Component = afterThis
+
+	for ind, child := range s.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(s)
+			s.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	s.AddChild(addThis)
+}
+
+func (s *Shape) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = s
+	}
+	// properties
+	if changed, err := s.path.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "path", s.id, err))
+		}
+	}
+	if changed, err := s.startX.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "startX", s.id, err))
+		}
+	}
+	if changed, err := s.startY.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "startY", s.id, err))
+		}
+	}
+	if changed, err := s.fillColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "fillColor", s.id, err))
+		}
+	}
+	if changed, err := s.fillRule.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "fillRule", s.id, err))
+		}
+	}
+	if changed, err := s.fillGradient.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "fillGradient", s.id, err))
+		}
+	}
+	if changed, err := s.strokeColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "strokeColor", s.id, err))
+		}
+	}
+	if changed, err := s.strokeWidth.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "strokeWidth", s.id, err))
+		}
+	}
+	if changed, err := s.joinStyle.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "joinStyle", s.id, err))
+		}
+	}
+	if changed, err := s.capStyle.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "capStyle", s.id, err))
+		}
+	}
+	if changed, err := s.dashPattern.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "dashPattern", s.id, err))
+		}
+	}
+	if changed, err := s.dashOffset.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shape", "dashOffset", s.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := s.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (s *Shape) As(target *vit.Component) bool {
+	if _, ok := (*target).(*Shape); ok {
+		*target = s
+		return true
+	}
+	return s.Item.As(target)
+}
+
+func (s *Shape) ID() string {
+	return s.id
+}
+
+func (s *Shape) Finish() error {
+	return s.RootC().FinishInContext(s)
+}
+
+func (s *Shape) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "MiterJoin":
+		return uint(Shape_JoinStyle_MiterJoin), true
+	case "BevelJoin":
+		return uint(Shape_JoinStyle_BevelJoin), true
+	case "RoundJoin":
+		return uint(Shape_JoinStyle_RoundJoin), true
+	case "FlatCap":
+		return uint(Shape_CapStyle_FlatCap), true
+	case "SquareCap":
+		return uint(Shape_CapStyle_SquareCap), true
+	case "RoundCap":
+		return uint(Shape_CapStyle_RoundCap), true
+	case "WindingFill":
+		return uint(Shape_FillRule_WindingFill), true
+	case "OddEvenFill":
+		return uint(Shape_FillRule_OddEvenFill), true
+	default:
+		return nil, false
+	}
+}
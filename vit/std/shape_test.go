@@ -0,0 +1,93 @@
+package std
+
+import (
+	"image/color"
+	"testing"
+)
+
+const shapeSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 100
+
+    Shape {
+        id: triangle
+        x: 10
+        y: 10
+        path: "M0 0 L80 0 L40 80 Z"
+        fillColor: "red"
+        strokeWidth: 0
+    }
+
+    Shape {
+        x: 110
+        y: 10
+        fillColor: "transparent"
+        strokeColor: "blue"
+        strokeWidth: 4
+        capStyle: Shape.FlatCap
+        dashPattern: [2, 1]
+        startX: 0
+        startY: 40
+        PathLine { x: 80; y: 40 }
+    }
+
+    Shape {
+        x: 210
+        y: 10
+        fillColor: "black"
+        strokeWidth: 0
+        fillRule: Shape.OddEvenFill
+        path: "M0 0 H80 V80 H0 Z M20 20 H60 V60 H20 Z"
+    }
+
+    Shape {
+        x: 310
+        y: 10
+        fillColor: "black"
+        strokeWidth: 0
+        fillRule: Shape.WindingFill
+        startX: 0
+        startY: 40
+        PathArc { x: 80; y: 40; radiusX: 40; radiusY: 40 }
+        PathArc { x: 0; y: 40; radiusX: 40; radiusY: 40 }
+    }
+}
+`
+
+func TestShape(t *testing.T) {
+	manager, _ := loadTestComponent(t, shapeSource)
+
+	triangle := findComponents[*Shape](manager.MainComponent())[0]
+	if bounds := triangle.Bounds(); bounds.Width() != 80 || bounds.Height() != 80 {
+		t.Errorf("expected the shape to be sized by its path, got %v", bounds)
+	}
+
+	img := renderComponent(t, manager.MainComponent(), 400, 100)
+
+	var (
+		transparent = color.RGBA{0, 0, 0, 0}
+		black       = color.RGBA{0, 0, 0, 255}
+		red         = color.RGBA{255, 0, 0, 255}
+		blue        = color.RGBA{0, 0, 255, 255}
+	)
+	checkPixels(t, img, []pixelTest{
+		// svg path data
+		{50, 15, red},
+		{50, 80, red},
+		{20, 60, transparent},
+		// dashed line with dashes of 8 and gaps of 4 units
+		{114, 50, blue},
+		{121, 50, transparent},
+		{126, 50, blue},
+		{114, 54, transparent},
+		// odd-even fill rule cuts a hole
+		{215, 50, black},
+		{250, 50, transparent},
+		// arcs form a circle
+		{350, 50, black},
+		{350, 12, black},
+		{312, 12, transparent},
+	}, 12)
+}
@@ -0,0 +1,163 @@
+package std
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+// ShortcutEvent is fired when a shortcut has been activated.
+type ShortcutEvent struct {
+	Sequence string
+}
+
+// keyCombination is a single key together with the modifiers that need to be held down.
+type keyCombination struct {
+	modifiers KeyArea_Modifiers
+	key       string
+}
+
+func (c keyCombination) matches(e *KeyEvent) bool {
+	return c.key == e.Key && c.modifiers == e.Modifiers
+}
+
+// alternative names that can be used in key sequences mapped to the name of the key in a KeyEvent
+var keyNameAliases = map[string]string{
+	"esc":       "Escape",
+	"escape":    "Escape",
+	"return":    "Enter",
+	"enter":     "Enter",
+	"del":       "Delete",
+	"delete":    "Delete",
+	"backspace": "Backspace",
+	"tab":       "Tab",
+	"space":     "Space",
+	"home":      "Home",
+	"end":       "End",
+	"pgup":      "PageUp",
+	"pageup":    "PageUp",
+	"pgdown":    "PageDown",
+	"pagedown":  "PageDown",
+	"left":      "ArrowLeft",
+	"right":     "ArrowRight",
+	"up":        "ArrowUp",
+	"down":      "ArrowDown",
+}
+
+var modifierNames = map[string]KeyArea_Modifiers{
+	"shift":   KeyArea_Modifiers_shiftModifier,
+	"ctrl":    KeyArea_Modifiers_controlModifier,
+	"control": KeyArea_Modifiers_controlModifier,
+	"alt":     KeyArea_Modifiers_altModifier,
+	"super":   KeyArea_Modifiers_superModifier,
+	"meta":    KeyArea_Modifiers_superModifier,
+	"cmd":     KeyArea_Modifiers_superModifier,
+}
+
+// parseKeySequence parses a sequence like "Ctrl+K, Ctrl+C" into its individual key combinations.
+func parseKeySequence(sequence string) ([]keyCombination, error) {
+	if strings.TrimSpace(sequence) == "" {
+		return nil, nil
+	}
+	var combinations []keyCombination
+	for _, part := range strings.Split(sequence, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("empty key combination in sequence %q", sequence)
+		}
+		var combination keyCombination
+		keys := strings.Split(part, "+")
+		if strings.HasSuffix(part, "++") || part == "+" {
+			// the plus key itself is part of the combination
+			keys = append(keys[:len(keys)-2], "+")
+		}
+		for i, key := range keys {
+			key = strings.TrimSpace(key)
+			if i < len(keys)-1 {
+				mod, ok := modifierNames[strings.ToLower(key)]
+				if !ok {
+					return nil, fmt.Errorf("unknown modifier %q in sequence %q", key, sequence)
+				}
+				combination.modifiers |= mod
+				continue
+			}
+			if alias, ok := keyNameAliases[strings.ToLower(key)]; ok {
+				combination.key = alias
+			} else if utf8.RuneCountInString(key) == 1 {
+				combination.key = strings.ToUpper(key)
+			} else if len(key) >= 2 && (key[0] == 'F' || key[0] == 'f') && strings.Trim(key[1:], "0123456789") == "" {
+				combination.key = strings.ToUpper(key)
+			} else {
+				return nil, fmt.Errorf("unknown key %q in sequence %q", key, sequence)
+			}
+		}
+		combinations = append(combinations, combination)
+	}
+	return combinations, nil
+}
+
+func (s *Shortcut) sequenceChanged() {
+	s.progress = 0
+	var err error
+	s.combinations, err = parseKeySequence(s.sequence.String())
+	if err != nil {
+		s.Context().Global.Environment.Logger().Printf("Shortcut: %v", err)
+	}
+}
+
+// isModifierKey returns true if the key is one of the modifier keys.
+func isModifierKey(key string) bool {
+	switch key {
+	case "ShiftLeft", "ShiftRight", "ControlLeft", "ControlRight", "AltLeft", "AltRight", "MetaLeft", "MetaRight", "Super":
+		return true
+	}
+	return false
+}
+
+// handleKeyEvent checks if the event continues the sequence of this shortcut.
+// If the sequence is completed the shortcut will be activated and true will be returned.
+// The focused component is used to determine if the shortcut is active in its context.
+func (s *Shortcut) handleKeyEvent(e *KeyEvent, focused vit.Component) bool {
+	if !s.enabled.Bool() || len(s.combinations) == 0 || !e.Pressed || e.Key == "" || isModifierKey(e.Key) {
+		return false
+	}
+	if e.AutoRepeat && !s.autoRepeat.Bool() {
+		return false
+	}
+	if Shortcut_ShortcutContext(s.context.Int()) == Shortcut_ShortcutContext_ItemShortcut && !isAncestorOf(s.Parent(), focused) {
+		s.progress = 0
+		return false
+	}
+
+	if !s.combinations[s.progress].matches(e) {
+		// the sequence has been interrupted, but this event might start it again
+		s.progress = 0
+		if !s.combinations[0].matches(e) {
+			return false
+		}
+	}
+	s.progress++
+	if s.progress < len(s.combinations) {
+		// the sequence is not finished yet but the key still belongs to this shortcut
+		return true
+	}
+	s.progress = 0
+	s.onActivated.Fire(&ShortcutEvent{Sequence: s.sequence.String()})
+	return true
+}
+
+// isAncestorOf returns true if the ancestor is the component itself or one of its parents.
+func isAncestorOf(ancestor vit.Component, comp vit.Component) bool {
+	if ancestor == nil {
+		return false
+	}
+	for comp != nil {
+		if comp.RootC() == ancestor.RootC() {
+			return true
+		}
+		comp = comp.RootC().Parent()
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+)
+
+func newFileContextForShortcut(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type Shortcut_ShortcutContext uint
+
+const (
+	Shortcut_ShortcutContext_WindowShortcut Shortcut_ShortcutContext = 0
+	Shortcut_ShortcutContext_ItemShortcut   Shortcut_ShortcutContext = 1
+)
+
+func (enum Shortcut_ShortcutContext) String() string {
+	switch enum {
+	case Shortcut_ShortcutContext_WindowShortcut:
+		return "WindowShortcut"
+	case Shortcut_ShortcutContext_ItemShortcut:
+		return "ItemShortcut"
+	default:
+		return "<unknownShortcutContext>"
+	}
+}
+
+type Shortcut struct {
+	*Item
+	id string
+
+	sequence     vit.StringValue
+	context      vit.IntValue
+	enabled      vit.BoolValue
+	autoRepeat   vit.BoolValue
+	combinations []keyCombination
+	progress     int
+
+	onActivated vit.EventAttribute[ShortcutEvent]
+}
+
+// newShortcutInGlobal creates an appropriate file context for the component and then returns a new Shortcut instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newShortcutInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*Shortcut, error) {
+	fileCtx, err := newFileContextForShortcut(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewShortcut(id, fileCtx), nil
+}
+func NewShortcut(id string, context *vit.FileContext) *Shortcut {
+	s := &Shortcut{
+		Item:         NewItem("", context),
+		id:           id,
+		sequence:     *vit.NewEmptyStringValue(),
+		context:      *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "ShortcutContext.WindowShortcut", Position: nil}),
+		enabled:      *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		autoRepeat:   *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		combinations: nil,
+		progress:     0,
+		onActivated:  *vit.NewEventAttribute[ShortcutEvent](),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	s.sequence.AddDependent(vit.FuncDep(s.sequenceChanged))
+	// register event listeners
+	// register enumerations
+	s.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "ShortcutContext",
+		Position: nil,
+		Values:   map[string]int{"WindowShortcut": 0, "ItemShortcut": 1},
+	})
+	// add child components
+
+	context.RegisterComponent("", s)
+
+	return s
+}
+
+func (s *Shortcut) String() string {
+	return fmt.Sprintf("Shortcut(%s)", s.id)
+}
+
+func (s *Shortcut) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "sequence":
+		return &s.sequence, true
+	case "context":
+		return &s.context, true
+	case "enabled":
+		return &s.enabled, true
+	case "autoRepeat":
+		return &s.autoRepeat, true
+	default:
+		return s.Item.Property(key)
+	}
+}
+
+func (s *Shortcut) MustProperty(key string) vit.Value {
+	v, ok := s.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (s *Shortcut) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "sequence":
+		err = s.sequence.SetValue(value)
+	case "context":
+		err = s.context.SetValue(value)
+	case "enabled":
+		err = s.enabled.SetValue(value)
+	case "autoRepeat":
+		err = s.autoRepeat.SetValue(value)
+	default:
+		return s.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("Shortcut", key, s.id, err)
+	}
+	return nil
+}
+
+func (s *Shortcut) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "sequence":
+		s.sequence.SetCode(code)
+	case "context":
+		s.context.SetCode(code)
+	case "enabled":
+		s.enabled.SetCode(code)
+	case "autoRepeat":
+		s.autoRepeat.SetCode(code)
+	default:
+		return s.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (s *Shortcut) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	case "onActivated":
+		return &s.onActivated, true
+	default:
+		return s.Item.Event(name)
+	}
+}
+
+func (s *Shortcut) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "sequence":
+		return &s.sequence, true
+	case "context":
+		return &s.context, true
+	case "enabled":
+		return &s.enabled, true
+	case "autoRepeat":
+		return &s.autoRepeat, true
+	case "onActivated":
+		return &s.onActivated, true
+	default:
+		return s.Item.ResolveVariable(key)
+	}
+}
+
+func (s *Shortcut) AddChild(child vit.Component) {
+	child.SetParent(s)
+	s.AddChildButKeepParent(child)
+}
+
+func (s *Shortcut) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range s.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(s)
+			s.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	s.AddChild(addThis)
+}
+
+func (s *Shortcut) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = s
+	}
+	// properties
+	if changed, err := s.sequence.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shortcut", "sequence", s.id, err))
+		}
+	}
+	if changed, err := s.context.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shortcut", "context", s.id, err))
+		}
+	}
+	if changed, err := s.enabled.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shortcut", "enabled", s.id, err))
+		}
+	}
+	if changed, err := s.autoRepeat.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Shortcut", "autoRepeat", s.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := s.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (s *Shortcut) As(target *vit.Component) bool {
+	if _, ok := (*target).(*Shortcut); ok {
+		*target = s
+		return true
+	}
+	return s.Item.As(target)
+}
+
+func (s *Shortcut) ID() string {
+	return s.id
+}
+
+func (s *Shortcut) Finish() error {
+	return s.RootC().FinishInContext(s)
+}
+
+func (s *Shortcut) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "WindowShortcut":
+		return uint(Shortcut_ShortcutContext_WindowShortcut), true
+	case "ItemShortcut":
+		return uint(Shortcut_ShortcutContext_ItemShortcut), true
+	default:
+		return nil, false
+	}
+}
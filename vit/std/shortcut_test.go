@@ -0,0 +1,128 @@
+package std
+
+import (
+	"reflect"
+	"testing"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+func TestParseKeySequence(t *testing.T) {
+	var tests = []struct {
+		sequence string
+		expected []keyCombination
+		fails    bool
+	}{
+		{"Ctrl+S", []keyCombination{{KeyArea_Modifiers_controlModifier, "S"}}, false},
+		{"ctrl+shift+s", []keyCombination{{KeyArea_Modifiers_controlModifier | KeyArea_Modifiers_shiftModifier, "S"}}, false},
+		{"Ctrl+K, Ctrl+C", []keyCombination{{KeyArea_Modifiers_controlModifier, "K"}, {KeyArea_Modifiers_controlModifier, "C"}}, false},
+		{"Alt+F4", []keyCombination{{KeyArea_Modifiers_altModifier, "F4"}}, false},
+		{"Esc", []keyCombination{{0, "Escape"}}, false},
+		{"Ctrl++", []keyCombination{{KeyArea_Modifiers_controlModifier, "+"}}, false},
+		{"Super+Left", []keyCombination{{KeyArea_Modifiers_superModifier, "ArrowLeft"}}, false},
+		{"", nil, false},
+		{"Hyper+S", nil, true},
+		{"Ctrl+Banana", nil, true},
+		{"Ctrl+S,", nil, true},
+	}
+	for _, test := range tests {
+		combinations, err := parseKeySequence(test.sequence)
+		if test.fails {
+			if err == nil {
+				t.Errorf("expected sequence %q to fail", test.sequence)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("sequence %q: %v", test.sequence, err)
+			continue
+		}
+		if !reflect.DeepEqual(combinations, test.expected) {
+			t.Errorf("sequence %q: expected %v, got %v", test.sequence, test.expected, combinations)
+		}
+	}
+}
+
+const shortcutSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 400
+
+    Shortcut {
+        sequence: "Ctrl+K, Ctrl+C"
+    }
+
+    Item {
+        Shortcut {
+            sequence: "Ctrl+S"
+            context: Shortcut.ItemShortcut
+        }
+        Rectangle {}
+    }
+
+    KeyArea {
+        focus: true
+    }
+}
+`
+
+func TestShortcut(t *testing.T) {
+	manager, handler := loadTestComponent(t, shortcutSource)
+	root := manager.MainComponent()
+
+	shortcuts := findComponents[*Shortcut](root)
+	windowShortcut, itemShortcut := shortcuts[0], shortcuts[1]
+	keyArea := findComponents[*KeyArea](root)[0]
+
+	var windowActivations, itemActivations, keyPresses int
+	windowShortcut.onActivated.AddListener(vit.ListenerCB(func(e *ShortcutEvent) { windowActivations++ }))
+	itemShortcut.onActivated.AddListener(vit.ListenerCB(func(e *ShortcutEvent) { itemActivations++ }))
+	keyArea.onKeyDown.AddListener(vit.ListenerCB(func(e *KeyEvent) { keyPresses++ }))
+
+	press := func(key string, mods KeyArea_Modifiers) bool {
+		return handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: key, Modifiers: mods})
+	}
+
+	// an interrupted sequence doesn't activate the shortcut
+	press("K", KeyArea_Modifiers_controlModifier)
+	press("X", 0)
+	press("C", KeyArea_Modifiers_controlModifier)
+	if windowActivations != 0 {
+		t.Errorf("interrupted sequence activated the shortcut")
+	}
+
+	// modifier key presses don't interrupt the sequence
+	if !press("K", KeyArea_Modifiers_controlModifier) {
+		t.Errorf("first part of the sequence has not been accepted")
+	}
+	press("ControlLeft", KeyArea_Modifiers_controlModifier)
+	press("C", KeyArea_Modifiers_controlModifier)
+	if windowActivations != 1 {
+		t.Errorf("expected the shortcut to be activated once, got %d", windowActivations)
+	}
+
+	// item shortcuts require the focus to be inside of their item
+	press("S", KeyArea_Modifiers_controlModifier)
+	if itemActivations != 0 {
+		t.Errorf("item shortcut has been activated without focus")
+	}
+	handler.RequestFocus(focusableComponent{findComponents[*Rectangle](root)[0]})
+	press("S", KeyArea_Modifiers_controlModifier)
+	if itemActivations != 1 {
+		t.Errorf("item shortcut has not been activated with focus")
+	}
+
+	// only key presses that weren't accepted by a shortcut reach key areas
+	if keyPresses != 4 {
+		t.Errorf("expected 4 key presses to reach the key area, got %d", keyPresses)
+	}
+}
+
+// focusableComponent makes any component focusable
+type focusableComponent struct {
+	vit.Component
+}
+
+func (focusableComponent) Focus() {}
+func (focusableComponent) Blur()  {}
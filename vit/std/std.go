@@ -20,6 +20,29 @@ import (
 //go:generate ./gencmd -i Image.vit -o image_gen.go -p github.com/omniskop/vitrum/vit/std
 //go:generate ./gencmd -i Gradient.vit -o gradient_gen.go -p github.com/omniskop/vitrum/vit/std
 //go:generate ./gencmd -i GradientStop.vit -o gradientStop_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i RadialGradient.vit -o radialGradient_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i ConicalGradient.vit -o conicalGradient_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i Drag.vit -o drag_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i DropArea.vit -o dropArea_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i Shortcut.vit -o shortcut_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i FocusScope.vit -o focusScope_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i TextInput.vit -o textInput_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i TextEdit.vit -o textEdit_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i IntValidator.vit -o intValidator_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i DoubleValidator.vit -o doubleValidator_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i RegularExpressionValidator.vit -o regularExpressionValidator_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i FontLoader.vit -o fontLoader_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i DropShadow.vit -o dropShadow_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i GaussianBlur.vit -o gaussianBlur_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i Shape.vit -o shape_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i PathMove.vit -o pathMove_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i PathLine.vit -o pathLine_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i PathQuad.vit -o pathQuad_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i PathCubic.vit -o pathCubic_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i PathArc.vit -o pathArc_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i Canvas.vit -o canvas_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i AnimatedImage.vit -o animatedImage_gen.go -p github.com/omniskop/vitrum/vit/std
+//go:generate ./gencmd -i BorderImage.vit -o borderImage_gen.go -p github.com/omniskop/vitrum/vit/std
 //go:generate rm ./gencmd
 
 func init() {
@@ -30,7 +53,7 @@ type StdLib struct {
 }
 
 func (l StdLib) ComponentNames() []string {
-	return []string{"Item", "Rectangle", "Repeater", "Container", "Row", "Column", "Grid", "Text", "MouseArea", "KeyArea", "Rotation", "Image", "Gradient", "GradientStop"}
+	return []string{"Item", "Rectangle", "Repeater", "Container", "Row", "Column", "Grid", "Text", "MouseArea", "KeyArea", "Rotation", "Image", "Gradient", "GradientStop", "RadialGradient", "ConicalGradient", "Drag", "DropArea", "Shortcut", "FocusScope", "TextInput", "TextEdit", "IntValidator", "DoubleValidator", "RegularExpressionValidator", "FontLoader", "DropShadow", "GaussianBlur", "Shape", "PathMove", "PathLine", "PathQuad", "PathCubic", "PathArc", "Canvas", "AnimatedImage", "BorderImage"}
 }
 
 func (l StdLib) NewComponent(name string, id string, globalCtx *vit.GlobalContext) (vit.Component, bool) {
@@ -67,6 +90,52 @@ func (l StdLib) NewComponent(name string, id string, globalCtx *vit.GlobalContex
 		comp, err = newGradientInGlobal(id, globalCtx, l)
 	case "GradientStop":
 		comp, err = newGradientStopInGlobal(id, globalCtx, l)
+	case "RadialGradient":
+		comp, err = newRadialGradientInGlobal(id, globalCtx, l)
+	case "ConicalGradient":
+		comp, err = newConicalGradientInGlobal(id, globalCtx, l)
+	case "Drag":
+		comp, err = newDragInGlobal(id, globalCtx, l)
+	case "DropArea":
+		comp, err = newDropAreaInGlobal(id, globalCtx, l)
+	case "Shortcut":
+		comp, err = newShortcutInGlobal(id, globalCtx, l)
+	case "FocusScope":
+		comp, err = newFocusScopeInGlobal(id, globalCtx, l)
+	case "TextInput":
+		comp, err = newTextInputInGlobal(id, globalCtx, l)
+	case "TextEdit":
+		comp, err = newTextEditInGlobal(id, globalCtx, l)
+	case "IntValidator":
+		comp, err = newIntValidatorInGlobal(id, globalCtx, l)
+	case "DoubleValidator":
+		comp, err = newDoubleValidatorInGlobal(id, globalCtx, l)
+	case "RegularExpressionValidator":
+		comp, err = newRegularExpressionValidatorInGlobal(id, globalCtx, l)
+	case "FontLoader":
+		comp, err = newFontLoaderInGlobal(id, globalCtx, l)
+	case "DropShadow":
+		comp, err = newDropShadowInGlobal(id, globalCtx, l)
+	case "GaussianBlur":
+		comp, err = newGaussianBlurInGlobal(id, globalCtx, l)
+	case "Shape":
+		comp, err = newShapeInGlobal(id, globalCtx, l)
+	case "PathMove":
+		comp, err = newPathMoveInGlobal(id, globalCtx, l)
+	case "PathLine":
+		comp, err = newPathLineInGlobal(id, globalCtx, l)
+	case "PathQuad":
+		comp, err = newPathQuadInGlobal(id, globalCtx, l)
+	case "PathCubic":
+		comp, err = newPathCubicInGlobal(id, globalCtx, l)
+	case "PathArc":
+		comp, err = newPathArcInGlobal(id, globalCtx, l)
+	case "Canvas":
+		comp, err = newCanvasInGlobal(id, globalCtx, l)
+	case "AnimatedImage":
+		comp, err = newAnimatedImageInGlobal(id, globalCtx, l)
+	case "BorderImage":
+		comp, err = newBorderImageInGlobal(id, globalCtx, l)
 	default:
 		return nil, false
 	}
@@ -87,8 +156,35 @@ func (l StdLib) StaticAttribute(componentName string, attributeName string) (int
 		return (*MouseArea)(nil).staticAttribute(attributeName)
 	case "Rotation":
 		return (*Rotation)(nil).staticAttribute(attributeName)
-	case "Image":
+	case "Image", "AnimatedImage":
+		return (*Image)(nil).staticAttribute(attributeName)
+	case "BorderImage":
+		if value, ok := (*BorderImage)(nil).staticAttribute(attributeName); ok {
+			return value, true
+		}
 		return (*Image)(nil).staticAttribute(attributeName)
+	case "Gradient":
+		return (*Gradient)(nil).staticAttribute(attributeName)
+	case "Drag":
+		return (*Drag)(nil).staticAttribute(attributeName)
+	case "KeyArea":
+		return (*KeyArea)(nil).staticAttribute(attributeName)
+	case "Shortcut":
+		return (*Shortcut)(nil).staticAttribute(attributeName)
+	case "TextInput":
+		return (*TextInput)(nil).staticAttribute(attributeName)
+	case "TextEdit":
+		return (*TextEdit)(nil).staticAttribute(attributeName)
+	case "DoubleValidator":
+		return (*DoubleValidator)(nil).staticAttribute(attributeName)
+	case "FontLoader":
+		return (*FontLoader)(nil).staticAttribute(attributeName)
+	case "Rectangle":
+		return (*Rectangle)(nil).staticAttribute(attributeName)
+	case "Shape":
+		return (*Shape)(nil).staticAttribute(attributeName)
+	case "PathArc":
+		return (*PathArc)(nil).staticAttribute(attributeName)
 	}
 	return nil, false
 }
@@ -1,7 +1,9 @@
 package std
 
 import (
-	"fmt"
+	"image/color"
+	"math"
+	"unicode"
 	"unicode/utf8"
 
 	vit "github.com/omniskop/vitrum/vit"
@@ -9,36 +11,151 @@ import (
 	"github.com/tdewolff/canvas"
 )
 
+// LinkEvent is fired by a Text when a link has been clicked.
+type LinkEvent struct {
+	Link string
+}
+
+// textLayoutState holds the lines of a Text after it has been laid out.
+type textLayoutState struct {
+	runes       []rune
+	lines       []textLine
+	lineHeight  float64
+	layoutWidth float64 // the width the lines have been wrapped for
+	truncated   bool    // true if lines have been dropped because of the maximumLineCount
+
+	rich         *canvas.Text // the laid out text if a rich text format is used
+	links        []richTextLink
+	pressedLink  string
+	mousePressed bool
+}
+
 func (t *Text) updateFont() {
 	familyName := t.font.MustGet("family").GetValue().(string)
 	if familyName == "" {
 		t.fontData = nil
 		t.fontFaceData = nil
+		t.updateLayout()
 		return
 	}
 	if t.fontData != nil && t.fontData.Name() != familyName {
 		t.fontData = canvas.NewFontFamily(familyName)
 	}
 
-	var weight Text_FontWeight = Text_FontWeight(t.font.MustGet("weight").GetValue().(int))
-	if t.font.MustGet("bold").GetValue().(bool) {
+	var err error
+	t.fontFaceData, err = loadFont(&t.font, t.color.Color())
+	if err != nil {
+		t.fontFaceData = nil
+		t.Context().Global.Environment.Logger().Printf("Text: font: %v\r\n", err)
+	}
+	t.updateLayout()
+}
+
+func (t *Text) boundsChanged() {
+	if width, ok := t.explicitWidth(); ok && width != t.textLayout.layoutWidth {
+		t.updateLayout()
+	}
+}
+
+// updateLayout breaks the text into lines and updates the size of the content.
+func (t *Text) updateLayout() {
+	layout := &t.textLayout
+	layout.runes = []rune(t.text.String())
+	layout.layoutWidth = math.Inf(1)
+	if width, ok := t.explicitWidth(); ok {
+		layout.layoutWidth = width
+	}
+	layout.rich = nil
+	layout.links = nil
+	if spans, ok := t.richTextSpans(); ok {
+		t.layoutRichText(spans)
+		return
+	}
+
+	var mode textWrapMode
+	switch Text_WrapMode(t.wrapMode.Int()) {
+	case Text_WrapMode_NoWrap:
+		mode = textNoWrap
+	case Text_WrapMode_WordWrap:
+		mode = textWordWrap
+	case Text_WrapMode_WrapAnywhere:
+		mode = textWrapAnywhere
+	default:
+		mode = textWrapWordOrRune
+	}
+
+	var tabWidth float64
+	layout.lineHeight = 0
+	if t.fontFaceData != nil {
+		tabWidth = 4 * t.fontFaceData.TextWidth(" ")
+		layout.lineHeight = t.fontFaceData.LineHeight()
+	}
+	if Text_LineHeightMode(t.lineHeightMode.Int()) == Text_LineHeightMode_FixedHeight {
+		layout.lineHeight = t.lineHeight.Float64()
+	} else {
+		layout.lineHeight *= t.lineHeight.Float64()
+	}
+
+	layout.lines = layoutText(layout.runes, t.fontFaceData, layout.layoutWidth, mode, tabWidth)
+	layout.truncated = false
+	if max := t.maximumLineCount.Int(); max >= 1 && len(layout.lines) > max {
+		layout.lines = layout.lines[:max]
+		layout.truncated = true
+	}
+
+	var width float64
+	for _, line := range layout.lines {
+		width = math.Max(width, line.offsets[trimmedLength(layout.runes[line.start:line.end])])
+	}
+	height := float64(len(layout.lines)) * layout.lineHeight
+
+	t.setLayoutSize(len(layout.lines), width, height)
+}
+
+// setLayoutSize updates the properties that describe the size of the laid out text.
+func (t *Text) setLayoutSize(lineCount int, width, height float64) {
+	if t.lineCount.Int() != lineCount {
+		t.lineCount.SetIntValue(lineCount)
+	}
+	if t.contentWidth.Float64() != width {
+		t.contentWidth.SetFloatValue(width)
+	}
+	if t.contentHeight.Float64() != height {
+		t.contentHeight.SetFloatValue(height)
+	}
+	t.SetContentSize(width, height)
+}
+
+// trimmedLength returns the number of runes without trailing whitespace.
+func trimmedLength(runes []rune) int {
+	n := len(runes)
+	for n > 0 && unicode.IsSpace(runes[n-1]) {
+		n--
+	}
+	return n
+}
+
+// loadFont loads the font face that is described by a font group property using the given color.
+func loadFont(font *vit.GroupValue, col color.Color) (*canvas.FontFace, error) {
+	family, style := fontStyle(font, col)
+	return vfont.LoadFontFace(family, style)
+}
+
+// fontStyle returns the font family and style that are described by a font group property.
+func fontStyle(font *vit.GroupValue, col color.Color) (string, vfont.Style) {
+	var weight Text_FontWeight = Text_FontWeight(font.MustGet("weight").GetValue().(int))
+	if font.MustGet("bold").GetValue().(bool) {
 		weight = Text_FontWeight_Bold
 	}
 
-	var err error
-	t.fontFaceData, err = vfont.LoadFontFace(familyName, vfont.Style{
-		Color:     t.color.Color(),
-		PointSize: t.font.MustGet("pointSize").GetValue().(float64),
-		Italic:    t.font.MustGet("italic").GetValue().(bool),
-		Underline: t.font.MustGet("underline").GetValue().(bool),
-		Strikeout: t.font.MustGet("strikeout").GetValue().(bool),
+	return font.MustGet("family").GetValue().(string), vfont.Style{
+		Color:     col,
+		PointSize: font.MustGet("pointSize").GetValue().(float64),
+		Italic:    font.MustGet("italic").GetValue().(bool),
+		Underline: font.MustGet("underline").GetValue().(bool),
+		Strikeout: font.MustGet("strikeout").GetValue().(bool),
 		Weight:    vfont.Weight(weight),
-	})
-	if err != nil {
-		fmt.Println("Text: font:", err)
 	}
-
-	t.SetContentSize(t.fontFaceData.TextWidth(t.text.String()), t.fontFaceData.LineHeight())
 }
 
 func (t *Text) Draw(ctx vit.DrawingContext, area vit.Rect) error {
@@ -46,68 +163,120 @@ func (t *Text) Draw(ctx vit.DrawingContext, area vit.Rect) error {
 		return t.Root.DrawChildren(ctx, area)
 	}
 
-	compBounds := t.Bounds()
-	var drawBounds vit.Rect
+	bounds := t.Bounds()
+	if t.textLayout.rich != nil {
+		x, y := t.richTextOrigin()
+		ctx.DrawText(x, y, t.textLayout.rich)
+		return t.Root.DrawChildren(ctx, area)
+	}
 
-	// calculate alignment
-	var hAlign canvas.TextAlign
-	switch Text_HorizontalAlignment(t.horizontalAlignment.Int()) {
-	case Text_HorizontalAlignment_AlignLeft:
-		hAlign = canvas.Left
-		drawBounds.X1 = compBounds.Left()
-	case Text_HorizontalAlignment_AlignHCenter:
-		hAlign = canvas.Center
-		drawBounds.X1 = compBounds.CenterX()
-	case Text_HorizontalAlignment_AlignRight:
-		hAlign = canvas.Right
-		drawBounds.X1 = compBounds.Right()
+	runes := t.textLayout.runes
+	lines := t.textLayout.lines
+	lineHeight := t.textLayout.lineHeight
+	elide := Text_Elide(t.elide.Int())
+	alignment := Text_HorizontalAlignment(t.horizontalAlignment.Int())
+
+	// with eliding enabled only lines that fit into an explicitly set height are shown
+	visible := len(lines)
+	if elide != Text_Elide_ElideNone && t.height.Float64() != 0 && lineHeight > 0 {
+		if n := int(bounds.Height()/lineHeight + 1e-9); n < visible {
+			visible = n
+			if visible < 1 {
+				visible = 1
+			}
+		}
 	}
-	var vAlign canvas.TextAlign
+	linesHidden := visible < len(lines) || t.textLayout.truncated
+
+	var y float64
 	switch Text_VerticalAlignment(t.verticalAlignment.Int()) {
 	case Text_VerticalAlignment_AlignTop:
-		vAlign = canvas.Top
-		drawBounds.Y1 = compBounds.Top()
+		y = bounds.Top()
 	case Text_VerticalAlignment_AlignVCenter:
-		vAlign = canvas.Center
-		drawBounds.Y1 = compBounds.CenterY()
+		y = bounds.CenterY() - float64(visible)*lineHeight/2
 	case Text_VerticalAlignment_AlignBottom:
-		vAlign = canvas.Bottom
-		drawBounds.Y1 = compBounds.Bottom()
+		y = bounds.Bottom() - float64(visible)*lineHeight
 	}
 
-	drawBounds.X2 = drawBounds.X1
-	drawBounds.Y2 = drawBounds.Y1
+	alignedX := func(width float64) float64 {
+		switch alignment {
+		case Text_HorizontalAlignment_AlignHCenter:
+			return bounds.CenterX() - width/2
+		case Text_HorizontalAlignment_AlignRight:
+			return bounds.Right() - width
+		default:
+			return bounds.Left()
+		}
+	}
 
-	var textString = t.text.String()
+	for i, line := range lines[:visible] {
+		lineRunes := runes[line.start:line.end]
+		length := trimmedLength(lineRunes)
+		width := line.offsets[length]
+		lastVisible := i == visible-1
 
-	// calculate size
-	if Text_Elide(t.elide.Int()) != Text_Elide_ElideNone {
-		textWidth := t.fontFaceData.TextWidth(textString)
-		if textWidth > compBounds.Width() {
-			switch Text_Elide(t.elide.Int()) {
-			case Text_Elide_ElideLeft:
-				textString = t.elideTextLeft(textString, compBounds.Width())
-			case Text_Elide_ElideMiddle:
-				textString = t.elideTextMiddle(textString, compBounds.Width())
-			case Text_Elide_ElideRight:
-				textString = t.elideTextRight(textString, compBounds.Width())
-			}
+		if elide != Text_Elide_ElideNone && (width > bounds.Width() || lastVisible && linesHidden) {
+			elided := t.elideLine(elide, string(lineRunes[:length]), bounds.Width(), lastVisible && linesHidden)
+			ctx.DrawText(alignedX(t.fontFaceData.TextWidth(elided)), y, canvas.NewTextBox(t.fontFaceData, elided, 0, 0, canvas.Left, canvas.Top, 0, 0))
+		} else if alignment == Text_HorizontalAlignment_AlignJustify && !(lastVisible && !linesHidden) && !t.endsParagraph(line) {
+			t.drawJustified(ctx, lineRunes[:length], line.offsets, bounds.Left(), y, bounds.Width())
+		} else {
+			drawRunes(ctx, t.fontFaceData, lineRunes, line.offsets, 0, length, alignedX(width), y, canvas.Top)
 		}
+		y += lineHeight
 	}
 
-	textElement := canvas.NewTextBox(
-		t.fontFaceData,
-		textString,
-		drawBounds.Width(),
-		drawBounds.Height(),
-		hAlign,
-		vAlign,
-		0, 0,
-	)
+	return t.Root.DrawChildren(ctx, area)
+}
 
-	ctx.DrawText(drawBounds.X1, drawBounds.Y1, textElement)
+// endsParagraph returns true if the line is followed by a line break or the end of the text.
+func (t *Text) endsParagraph(line textLine) bool {
+	return line.end == len(t.textLayout.runes) || t.textLayout.runes[line.end] == '\n'
+}
 
-	return t.Root.DrawChildren(ctx, area)
+// drawJustified draws the words of a line with the space between them stretched to fill the whole width.
+func (t *Text) drawJustified(ctx vit.DrawingContext, runes []rune, offsets []float64, x, y, width float64) {
+	isWordStart := func(i int) bool {
+		return i > 0 && unicode.IsSpace(runes[i-1]) && !unicode.IsSpace(runes[i])
+	}
+	gaps := 0
+	for i := range runes {
+		if isWordStart(i) {
+			gaps++
+		}
+	}
+	if gaps == 0 {
+		drawRunes(ctx, t.fontFaceData, runes, offsets, 0, len(runes), x, y, canvas.Top)
+		return
+	}
+	extra := (width - offsets[len(runes)]) / float64(gaps)
+	wordStart := 0
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) && !isWordStart(i) {
+			continue
+		}
+		drawRunes(ctx, t.fontFaceData, runes, offsets, wordStart, i, x, y, canvas.Top)
+		x += extra
+		wordStart = i
+	}
+}
+
+// elideLine shortens a line that doesn't fit into the given width. If more lines follow that are not shown the line will always be elided on the right.
+func (t *Text) elideLine(elide Text_Elide, line string, maxWidth float64, linesHidden bool) string {
+	if linesHidden {
+		if t.fontFaceData.TextWidth(line+"...") <= maxWidth {
+			return line + "..."
+		}
+		return t.elideTextRight(line, maxWidth)
+	}
+	switch elide {
+	case Text_Elide_ElideLeft:
+		return t.elideTextLeft(line, maxWidth)
+	case Text_Elide_ElideMiddle:
+		return t.elideTextMiddle(line, maxWidth)
+	default:
+		return t.elideTextRight(line, maxWidth)
+	}
 }
 
 func (t *Text) elideTextLeft(textString string, maxWidth float64) string {
@@ -0,0 +1,496 @@
+package std
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/tdewolff/canvas"
+)
+
+// textEditState holds the internal state of a TextEdit that is not exposed as properties.
+type textEditState struct {
+	lines         []textLine
+	layoutWidth   float64 // width the lines have been wrapped for
+	scrollX       float64 // how far the text has been scrolled to keep the cursor visible
+	scrollY       float64
+	preferredX    float64 // horizontal offset the cursor tries to keep when moving between lines; negative if not set
+	mousePressed  bool
+	containsMouse bool
+}
+
+func (t *TextEdit) wasCompleted(*struct{}) {
+	t.activeFocus.AddDependent(vit.FuncDep(t.activeFocusChanged))
+}
+
+func (t *TextEdit) activeFocusChanged() {
+	active := t.activeFocus.Bool()
+	t.cursorVisible.SetBoolValue(active)
+	if !active {
+		t.state.mousePressed = false
+		t.onEditingFinished.Fire(&TextInputEvent{Text: t.text.String()})
+	}
+}
+
+func (t *TextEdit) textChanged() {
+	if t.text.String() == t.editor.String() {
+		return
+	}
+	t.editor.setText(t.text.String())
+	t.updateLayout()
+	t.syncProperties()
+}
+
+func (t *TextEdit) cursorPositionChanged() {
+	if t.cursorPosition.Int() == t.editor.cursor {
+		return
+	}
+	t.editor.setCursor(t.cursorPosition.Int(), false)
+	t.state.preferredX = -1
+	t.syncProperties()
+}
+
+func (t *TextEdit) updateFont() {
+	var err error
+	t.fontFaceData, err = loadFont(&t.font, t.color.Color())
+	if err != nil {
+		t.fontFaceData = nil
+		t.selectedFontFaceData = nil
+		t.Context().Global.Environment.Logger().Printf("TextEdit: font: %v", err)
+	} else {
+		t.selectedFontFaceData, _ = loadFont(&t.font, t.selectedTextColor.Color())
+	}
+	t.updateLayout()
+}
+
+func (t *TextEdit) boundsChanged() {
+	if t.Bounds().Width() != t.state.layoutWidth {
+		t.updateLayout()
+	}
+}
+
+// lineHeight returns the height of a single line or 0 if no font is available.
+func (t *TextEdit) lineHeight() float64 {
+	if t.fontFaceData == nil {
+		return 0
+	}
+	return t.fontFaceData.LineHeight()
+}
+
+// updateLayout splits the text into lines according to the wrap mode and the width of the item.
+func (t *TextEdit) updateLayout() {
+	width := t.Bounds().Width()
+	t.state.layoutWidth = width
+	wrapWidth := width
+	if width <= 0 {
+		// without a width there is nothing to wrap against
+		wrapWidth = math.Inf(1)
+	}
+
+	var mode textWrapMode
+	switch TextEdit_WrapMode(t.wrapMode.Int()) {
+	case TextEdit_WrapMode_NoWrap:
+		mode = textNoWrap
+	case TextEdit_WrapMode_WordWrap:
+		mode = textWordWrap
+	case TextEdit_WrapMode_WrapAnywhere:
+		mode = textWrapAnywhere
+	default:
+		mode = textWrapWordOrRune
+	}
+
+	var tabWidth float64
+	if t.fontFaceData != nil {
+		tabWidth = float64(t.tabWidth.Int()) * t.fontFaceData.TextWidth(" ")
+	}
+
+	t.state.lines = layoutText(t.editor.text, t.fontFaceData, wrapWidth, mode, tabWidth)
+	if t.lineCount.Int() != len(t.state.lines) {
+		t.lineCount.SetIntValue(len(t.state.lines))
+	}
+	var contentWidth float64
+	for _, line := range t.state.lines {
+		contentWidth = math.Max(contentWidth, line.width())
+	}
+	t.SetContentSize(contentWidth, float64(len(t.state.lines))*t.lineHeight())
+	t.ensureCursorVisible()
+}
+
+// syncProperties writes the state of the editor back into the properties.
+func (t *TextEdit) syncProperties() {
+	if text := t.editor.String(); t.text.String() != text {
+		t.text.SetStringValue(text)
+	}
+	if t.cursorPosition.Int() != t.editor.cursor {
+		t.cursorPosition.SetIntValue(t.editor.cursor)
+	}
+	start, end := t.editor.selection()
+	if t.selectionStart.Int() != start {
+		t.selectionStart.SetIntValue(start)
+	}
+	if t.selectionEnd.Int() != end {
+		t.selectionEnd.SetIntValue(end)
+	}
+	if selected := t.editor.selectedText(); t.selectedText.String() != selected {
+		t.selectedText.SetStringValue(selected)
+	}
+	t.ensureCursorVisible()
+}
+
+// lineAt returns the index of the visual line that contains the given position.
+// A position at which a line has been wrapped belongs to the following line.
+func (t *TextEdit) lineAt(pos int) int {
+	lines := t.state.lines
+	for i := len(lines) - 1; i > 0; i-- {
+		if pos >= lines[i].start {
+			return i
+		}
+	}
+	return 0
+}
+
+// cursorX returns the horizontal offset of the cursor relative to the start of its line.
+func (t *TextEdit) cursorX() float64 {
+	line := t.state.lines[t.lineAt(t.editor.cursor)]
+	return line.offsets[clampInt(t.editor.cursor-line.start, 0, len(line.offsets)-1)]
+}
+
+// ensureCursorVisible scrolls the text so that the cursor is inside the bounds of the item.
+func (t *TextEdit) ensureCursorVisible() {
+	if len(t.state.lines) == 0 {
+		return
+	}
+	bounds := t.Bounds()
+	lineHeight := t.lineHeight()
+
+	cursorY := float64(t.lineAt(t.editor.cursor)) * lineHeight
+	if cursorY+lineHeight-t.state.scrollY > bounds.Height() {
+		t.state.scrollY = cursorY + lineHeight - bounds.Height()
+	}
+	if cursorY < t.state.scrollY {
+		t.state.scrollY = cursorY
+	}
+	if maxY := float64(len(t.state.lines))*lineHeight - bounds.Height(); t.state.scrollY > maxY {
+		t.state.scrollY = math.Max(maxY, 0)
+	}
+
+	cursorX := t.cursorX()
+	if cursorX-t.state.scrollX > bounds.Width() {
+		t.state.scrollX = cursorX - bounds.Width()
+	}
+	if cursorX < t.state.scrollX {
+		t.state.scrollX = cursorX
+	}
+}
+
+// PositionAt returns the cursor position that is closest to the given point.
+func (t *TextEdit) PositionAt(x, y float64) int {
+	bounds := t.Bounds()
+	lineHeight := t.lineHeight()
+	index := len(t.state.lines) - 1
+	if lineHeight > 0 {
+		index = clampInt(int(math.Floor((y-bounds.Y1+t.state.scrollY)/lineHeight)), 0, len(t.state.lines)-1)
+	}
+	return t.positionInLine(index, x-bounds.X1+t.state.scrollX)
+}
+
+// positionInLine returns the position in the given line that is closest to the horizontal offset.
+// The position at which a line has been wrapped is skipped as it is displayed in the next line.
+func (t *TextEdit) positionInLine(index int, x float64) int {
+	line := t.state.lines[index]
+	pos := line.positionAt(x)
+	if pos == line.end && index+1 < len(t.state.lines) && t.state.lines[index+1].start == line.end && pos > line.start {
+		pos--
+	}
+	return pos
+}
+
+// moveVertically moves the cursor by the given number of lines while keeping its horizontal position.
+func (t *TextEdit) moveVertically(lines int, selecting bool) {
+	if t.state.preferredX < 0 {
+		t.state.preferredX = t.cursorX()
+	}
+	current := t.lineAt(t.editor.cursor)
+	target := current + lines
+	switch {
+	case target < 0:
+		t.editor.setCursor(0, selecting)
+	case target >= len(t.state.lines):
+		t.editor.setCursor(len(t.editor.text), selecting)
+	default:
+		t.editor.setCursor(t.positionInLine(target, t.state.preferredX), selecting)
+	}
+}
+
+// visibleLines returns how many lines fit into the bounds of the item.
+func (t *TextEdit) visibleLines() int {
+	if lineHeight := t.lineHeight(); lineHeight > 0 {
+		if n := int(t.Bounds().Height() / lineHeight); n > 1 {
+			return n
+		}
+	}
+	return 1
+}
+
+// Select selects the text between start and end.
+func (t *TextEdit) Select(start, end int) {
+	t.editor.selectRange(start, end)
+	t.syncProperties()
+}
+
+// SelectAll selects the whole text.
+func (t *TextEdit) SelectAll() {
+	t.editor.selectAll()
+	t.syncProperties()
+}
+
+// Insert replaces the selection with the given text as if it has been typed by the user.
+func (t *TextEdit) Insert(text string) {
+	t.edit(func() bool { return t.editor.insert(sanitizeText(text), -1) })
+}
+
+// Copy copies the selected text into the clipboard.
+func (t *TextEdit) Copy() {
+	if !t.editor.hasSelection() {
+		return
+	}
+	t.Context().Global.Environment.Clipboard().SetText(t.editor.selectedText())
+}
+
+// Cut moves the selected text into the clipboard.
+func (t *TextEdit) Cut() {
+	if t.readOnly.Bool() {
+		return
+	}
+	t.Copy()
+	t.edit(t.editor.removeSelection)
+}
+
+// Paste replaces the selection with the content of the clipboard.
+func (t *TextEdit) Paste() {
+	if t.readOnly.Bool() {
+		return
+	}
+	t.Insert(t.Context().Global.Environment.Clipboard().Text())
+}
+
+// edit executes a function that modifies the text and notifies everyone about the change.
+func (t *TextEdit) edit(f func() bool) {
+	t.state.preferredX = -1
+	if !f() {
+		t.syncProperties()
+		return
+	}
+	t.updateLayout()
+	t.syncProperties()
+	t.onTextEdited.Fire(&TextInputEvent{Text: t.text.String()})
+}
+
+func (t *TextEdit) handleKeyEvent(e *KeyEvent) {
+	if !e.Pressed {
+		return
+	}
+	if e.IsText() {
+		text := sanitizeText(e.Text)
+		if text == "" {
+			return
+		}
+		e.Accepted = true
+		if !t.readOnly.Bool() {
+			t.Insert(text)
+		}
+		return
+	}
+
+	shortcut := isShortcutModifier(e.Modifiers)
+	word := isWordModifier(e.Modifiers)
+	selecting := e.Modifiers&KeyArea_Modifiers_shiftModifier != 0
+	readOnly := t.readOnly.Bool()
+	vertical := false
+
+	switch e.Key {
+	case "ArrowLeft":
+		t.editor.moveLeft(word, selecting)
+	case "ArrowRight":
+		t.editor.moveRight(word, selecting)
+	case "ArrowUp":
+		t.moveVertically(-1, selecting)
+		vertical = true
+	case "ArrowDown":
+		t.moveVertically(1, selecting)
+		vertical = true
+	case "PageUp":
+		t.moveVertically(-t.visibleLines(), selecting)
+		vertical = true
+	case "PageDown":
+		t.moveVertically(t.visibleLines(), selecting)
+		vertical = true
+	case "Home":
+		if shortcut {
+			t.editor.setCursor(0, selecting)
+		} else {
+			t.editor.setCursor(t.state.lines[t.lineAt(t.editor.cursor)].start, selecting)
+		}
+	case "End":
+		if shortcut {
+			t.editor.setCursor(len(t.editor.text), selecting)
+		} else {
+			index := t.lineAt(t.editor.cursor)
+			t.editor.setCursor(t.positionInLine(index, math.Inf(1)), selecting)
+		}
+	case "Backspace":
+		if !readOnly {
+			t.edit(func() bool { return t.editor.deleteBackward(word) })
+		}
+	case "Delete":
+		if !readOnly {
+			t.edit(func() bool { return t.editor.deleteForward(word) })
+		}
+	case "Enter", "NumpadEnter":
+		if !readOnly {
+			t.Insert("\n")
+		}
+	case "Tab":
+		// tabs with modifiers like shift+tab are left to the focus navigation
+		if t.tabChangesFocus.Bool() || e.Modifiers != 0 {
+			return
+		}
+		if !readOnly {
+			t.Insert("\t")
+		}
+	case "A":
+		if !shortcut {
+			return
+		}
+		t.editor.selectAll()
+	case "C":
+		if !shortcut {
+			return
+		}
+		t.Copy()
+	case "X":
+		if !shortcut {
+			return
+		}
+		t.Cut()
+	case "V":
+		if !shortcut {
+			return
+		}
+		t.Paste()
+	default:
+		return
+	}
+	e.Accepted = true
+	if !vertical {
+		t.state.preferredX = -1
+	}
+	t.syncProperties()
+}
+
+func (t *TextEdit) acceptsMouse(x, y float64) bool {
+	return t.Bounds().Contains(x, y)
+}
+
+func (t *TextEdit) handleMouseEvent(e MouseEvent) {
+	x, y := float64(e.X), float64(e.Y)
+	t.state.containsMouse = t.Bounds().Contains(x, y)
+	left := e.Buttons&MouseArea_MouseButtons_leftButton != 0
+	if !left {
+		t.state.mousePressed = false
+		return
+	}
+	if t.state.mousePressed {
+		if t.selectByMouse.Bool() {
+			t.editor.setCursor(t.PositionAt(x, y), true)
+			t.syncProperties()
+		}
+		return
+	}
+	if !t.state.containsMouse {
+		return
+	}
+	t.state.mousePressed = true
+	t.state.preferredX = -1
+	t.ForceActiveFocus(MouseFocusReason)
+	t.editor.setCursor(t.PositionAt(x, y), false)
+	t.syncProperties()
+}
+
+func (t *TextEdit) mouseExited() {
+	t.state.containsMouse = false
+	t.state.mousePressed = false
+}
+
+func (t *TextEdit) cursorShape() (MouseArea_CursorShape, bool) {
+	return MouseArea_CursorShape_IBeamCursor, t.state.containsMouse || t.state.mousePressed
+}
+
+func (t *TextEdit) Draw(ctx vit.DrawingContext, area vit.Rect) error {
+	if t.fontFaceData == nil {
+		return t.Root.DrawChildren(ctx, area)
+	}
+
+	bounds := t.Bounds()
+	if bounds.Width() != t.state.layoutWidth {
+		t.updateLayout()
+	}
+	t.ensureCursorVisible()
+	runes := t.editor.text
+	lineHeight := t.lineHeight()
+	originX := bounds.X1 - t.state.scrollX
+	scrollX := t.state.scrollX
+	selectionStart, selectionEnd := t.editor.selection()
+	cursorLine := t.lineAt(t.editor.cursor)
+
+	for i, line := range t.state.lines {
+		top := bounds.Y1 + float64(i)*lineHeight - t.state.scrollY
+		// only lines and characters that are completely inside of the bounds are drawn
+		if top < bounds.Y1-0.5 || top+lineHeight > bounds.Y2+0.5 {
+			continue
+		}
+		offsets := line.offsets
+		first := 0
+		for first < len(offsets)-1 && offsets[first] < scrollX-0.5 {
+			first++
+		}
+		last := len(offsets) - 1
+		for last > first && offsets[last] > scrollX+bounds.Width()+0.5 {
+			last--
+		}
+
+		start := clampInt(selectionStart-line.start, first, last)
+		end := clampInt(selectionEnd-line.start, first, last)
+		if start < end {
+			ctx.SetFillColor(t.selectionColor.Color())
+			ctx.DrawPath(originX+offsets[start], top, canvas.Rectangle(offsets[end]-offsets[start], lineHeight))
+		}
+
+		lineRunes := runes[line.start:line.end]
+		drawRunes(ctx, t.fontFaceData, lineRunes, offsets, first, start, originX, top, canvas.Top)
+		drawRunes(ctx, t.selectedFontFaceData, lineRunes, offsets, start, end, originX, top, canvas.Top)
+		drawRunes(ctx, t.fontFaceData, lineRunes, offsets, end, last, originX, top, canvas.Top)
+
+		if i == cursorLine && t.cursorVisible.Bool() && !t.readOnly.Bool() {
+			ctx.SetFillColor(t.cursorColor.Color())
+			ctx.DrawPath(originX+t.cursorX(), top, canvas.Rectangle(1, lineHeight))
+		}
+	}
+
+	return t.Root.DrawChildren(ctx, area)
+}
+
+// sanitizeText removes all control characters except line breaks and tabs from text that should be inserted into a multi-line text.
+func sanitizeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Map(func(r rune) rune {
+		if r == '\r' {
+			return '\n'
+		}
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return -1
+		}
+		return r
+	}, text)
+}
@@ -0,0 +1,509 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	canvas "github.com/tdewolff/canvas"
+)
+
+func newFileContextForTextEdit(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type TextEdit_WrapMode uint
+
+const (
+	TextEdit_WrapMode_NoWrap       TextEdit_WrapMode = 0
+	TextEdit_WrapMode_WordWrap     TextEdit_WrapMode = 1
+	TextEdit_WrapMode_WrapAnywhere TextEdit_WrapMode = 2
+	TextEdit_WrapMode_Wrap         TextEdit_WrapMode = 3
+)
+
+func (enum TextEdit_WrapMode) String() string {
+	switch enum {
+	case TextEdit_WrapMode_NoWrap:
+		return "NoWrap"
+	case TextEdit_WrapMode_WordWrap:
+		return "WordWrap"
+	case TextEdit_WrapMode_WrapAnywhere:
+		return "WrapAnywhere"
+	case TextEdit_WrapMode_Wrap:
+		return "Wrap"
+	default:
+		return "<unknownWrapMode>"
+	}
+}
+
+type TextEdit struct {
+	*Item
+	id string
+
+	text                 vit.StringValue
+	color                vit.ColorValue
+	selectionColor       vit.ColorValue
+	selectedTextColor    vit.ColorValue
+	cursorColor          vit.ColorValue
+	font                 vit.GroupValue
+	wrapMode             vit.IntValue
+	tabWidth             vit.IntValue
+	cursorPosition       vit.IntValue
+	selectionStart       vit.IntValue
+	selectionEnd         vit.IntValue
+	selectedText         vit.StringValue
+	lineCount            vit.IntValue
+	cursorVisible        vit.BoolValue
+	readOnly             vit.BoolValue
+	selectByMouse        vit.BoolValue
+	tabChangesFocus      vit.BoolValue
+	editor               textEditor
+	state                textEditState
+	fontFaceData         *canvas.FontFace
+	selectedFontFaceData *canvas.FontFace
+
+	onEditingFinished vit.EventAttribute[TextInputEvent]
+	onTextEdited      vit.EventAttribute[TextInputEvent]
+}
+
+// newTextEditInGlobal creates an appropriate file context for the component and then returns a new TextEdit instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newTextEditInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*TextEdit, error) {
+	fileCtx, err := newFileContextForTextEdit(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewTextEdit(id, fileCtx), nil
+}
+func NewTextEdit(id string, context *vit.FileContext) *TextEdit {
+	t := &TextEdit{
+		Item:              NewItem("", context),
+		id:                id,
+		text:              *vit.NewEmptyStringValue(),
+		color:             *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"black\"", Position: nil}),
+		selectionColor:    *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "Vit.rgb(0, 120, 215)", Position: nil}),
+		selectedTextColor: *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"white\"", Position: nil}),
+		cursorColor:       *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"black\"", Position: nil}),
+		font: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"bold":      vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"italic":    vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"strikeout": vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"underline": vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"pixelSize": vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "12", Position: nil}),
+			"pointSize": vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "12", Position: nil}),
+			"family":    vit.NewStringValueFromCode(vit.Code{FileCtx: context, Code: "\"Arial\"", Position: nil}),
+			"weight":    vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "400", Position: nil}),
+		}),
+		wrapMode:             *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "WrapMode.Wrap", Position: nil}),
+		tabWidth:             *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "4", Position: nil}),
+		cursorPosition:       *vit.NewEmptyIntValue(),
+		selectionStart:       *vit.NewEmptyIntValue(),
+		selectionEnd:         *vit.NewEmptyIntValue(),
+		selectedText:         *vit.NewEmptyStringValue(),
+		lineCount:            *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "1", Position: nil}),
+		cursorVisible:        *vit.NewEmptyBoolValue(),
+		readOnly:             *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		selectByMouse:        *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		tabChangesFocus:      *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		editor:               textEditor{},
+		state:                textEditState{preferredX: -1},
+		fontFaceData:         nil,
+		selectedFontFaceData: nil,
+		onEditingFinished:    *vit.NewEventAttribute[TextInputEvent](),
+		onTextEdited:         *vit.NewEventAttribute[TextInputEvent](),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	t.text.AddDependent(vit.FuncDep(t.textChanged))
+	t.font.AddDependent(vit.FuncDep(t.updateFont))
+	t.wrapMode.AddDependent(vit.FuncDep(t.updateLayout))
+	t.tabWidth.AddDependent(vit.FuncDep(t.updateLayout))
+	t.cursorPosition.AddDependent(vit.FuncDep(t.cursorPositionChanged))
+	t.Item.AddBoundsDependency(vit.FuncDep(t.boundsChanged))
+	// register event listeners
+	var event vit.Listenable
+	var listener vit.Evaluater
+	event, _ = t.Root.Event("onCompleted")
+	listener = event.CreateListener(vit.Code{FileCtx: context, Code: "function() {}", Position: nil})
+	t.AddListenerFunction(listener)
+	event.(*vit.EventAttribute[struct{}]).AddListener(vit.ListenerCB[struct{}](t.wasCompleted))
+	// register enumerations
+	t.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "WrapMode",
+		Position: nil,
+		Values:   map[string]int{"NoWrap": 0, "WordWrap": 1, "WrapAnywhere": 2, "Wrap": 3},
+	})
+	// add child components
+
+	context.RegisterComponent("", t)
+
+	return t
+}
+
+func (t *TextEdit) String() string {
+	return fmt.Sprintf("TextEdit(%s)", t.id)
+}
+
+func (t *TextEdit) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "text":
+		return &t.text, true
+	case "color":
+		return &t.color, true
+	case "selectionColor":
+		return &t.selectionColor, true
+	case "selectedTextColor":
+		return &t.selectedTextColor, true
+	case "cursorColor":
+		return &t.cursorColor, true
+	case "font":
+		return &t.font, true
+	case "wrapMode":
+		return &t.wrapMode, true
+	case "tabWidth":
+		return &t.tabWidth, true
+	case "cursorPosition":
+		return &t.cursorPosition, true
+	case "selectionStart":
+		return &t.selectionStart, true
+	case "selectionEnd":
+		return &t.selectionEnd, true
+	case "selectedText":
+		return &t.selectedText, true
+	case "lineCount":
+		return &t.lineCount, true
+	case "cursorVisible":
+		return &t.cursorVisible, true
+	case "readOnly":
+		return &t.readOnly, true
+	case "selectByMouse":
+		return &t.selectByMouse, true
+	case "tabChangesFocus":
+		return &t.tabChangesFocus, true
+	default:
+		return t.Item.Property(key)
+	}
+}
+
+func (t *TextEdit) MustProperty(key string) vit.Value {
+	v, ok := t.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (t *TextEdit) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "text":
+		err = t.text.SetValue(value)
+	case "color":
+		err = t.color.SetValue(value)
+	case "selectionColor":
+		err = t.selectionColor.SetValue(value)
+	case "selectedTextColor":
+		err = t.selectedTextColor.SetValue(value)
+	case "cursorColor":
+		err = t.cursorColor.SetValue(value)
+	case "font":
+		err = t.font.SetValue(value)
+	case "wrapMode":
+		err = t.wrapMode.SetValue(value)
+	case "tabWidth":
+		err = t.tabWidth.SetValue(value)
+	case "cursorPosition":
+		err = t.cursorPosition.SetValue(value)
+	case "selectionStart":
+		err = t.selectionStart.SetValue(value)
+	case "selectionEnd":
+		err = t.selectionEnd.SetValue(value)
+	case "selectedText":
+		err = t.selectedText.SetValue(value)
+	case "lineCount":
+		err = t.lineCount.SetValue(value)
+	case "cursorVisible":
+		err = t.cursorVisible.SetValue(value)
+	case "readOnly":
+		err = t.readOnly.SetValue(value)
+	case "selectByMouse":
+		err = t.selectByMouse.SetValue(value)
+	case "tabChangesFocus":
+		err = t.tabChangesFocus.SetValue(value)
+	default:
+		return t.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("TextEdit", key, t.id, err)
+	}
+	return nil
+}
+
+func (t *TextEdit) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "text":
+		t.text.SetCode(code)
+	case "color":
+		t.color.SetCode(code)
+	case "selectionColor":
+		t.selectionColor.SetCode(code)
+	case "selectedTextColor":
+		t.selectedTextColor.SetCode(code)
+	case "cursorColor":
+		t.cursorColor.SetCode(code)
+	case "font":
+		t.font.SetCode(code)
+	case "wrapMode":
+		t.wrapMode.SetCode(code)
+	case "tabWidth":
+		t.tabWidth.SetCode(code)
+	case "cursorPosition":
+		t.cursorPosition.SetCode(code)
+	case "selectionStart":
+		t.selectionStart.SetCode(code)
+	case "selectionEnd":
+		t.selectionEnd.SetCode(code)
+	case "selectedText":
+		t.selectedText.SetCode(code)
+	case "lineCount":
+		t.lineCount.SetCode(code)
+	case "cursorVisible":
+		t.cursorVisible.SetCode(code)
+	case "readOnly":
+		t.readOnly.SetCode(code)
+	case "selectByMouse":
+		t.selectByMouse.SetCode(code)
+	case "tabChangesFocus":
+		t.tabChangesFocus.SetCode(code)
+	default:
+		return t.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (t *TextEdit) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	case "onEditingFinished":
+		return &t.onEditingFinished, true
+	case "onTextEdited":
+		return &t.onTextEdited, true
+	default:
+		return t.Item.Event(name)
+	}
+}
+
+func (t *TextEdit) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "text":
+		return &t.text, true
+	case "color":
+		return &t.color, true
+	case "selectionColor":
+		return &t.selectionColor, true
+	case "selectedTextColor":
+		return &t.selectedTextColor, true
+	case "cursorColor":
+		return &t.cursorColor, true
+	case "font":
+		return &t.font, true
+	case "wrapMode":
+		return &t.wrapMode, true
+	case "tabWidth":
+		return &t.tabWidth, true
+	case "cursorPosition":
+		return &t.cursorPosition, true
+	case "selectionStart":
+		return &t.selectionStart, true
+	case "selectionEnd":
+		return &t.selectionEnd, true
+	case "selectedText":
+		return &t.selectedText, true
+	case "lineCount":
+		return &t.lineCount, true
+	case "cursorVisible":
+		return &t.cursorVisible, true
+	case "readOnly":
+		return &t.readOnly, true
+	case "selectByMouse":
+		return &t.selectByMouse, true
+	case "tabChangesFocus":
+		return &t.tabChangesFocus, true
+	case "onEditingFinished":
+		return &t.onEditingFinished, true
+	case "onTextEdited":
+		return &t.onTextEdited, true
+	default:
+		return t.Item.ResolveVariable(key)
+	}
+}
+
+func (t *TextEdit) AddChild(child vit.Component) {
+	child.SetParent(t)
+	t.AddChildButKeepParent(child)
+}
+
+func (t *TextEdit) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range t.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(t)
+			t.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	t.AddChild(addThis)
+}
+
+func (t *TextEdit) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = t
+	}
+	// properties
+	if changed, err := t.text.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "text", t.id, err))
+		}
+	}
+	if changed, err := t.color.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "color", t.id, err))
+		}
+	}
+	if changed, err := t.selectionColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "selectionColor", t.id, err))
+		}
+	}
+	if changed, err := t.selectedTextColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "selectedTextColor", t.id, err))
+		}
+	}
+	if changed, err := t.cursorColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "cursorColor", t.id, err))
+		}
+	}
+	if changed, err := t.font.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "font", t.id, err))
+		}
+	}
+	if changed, err := t.wrapMode.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "wrapMode", t.id, err))
+		}
+	}
+	if changed, err := t.tabWidth.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "tabWidth", t.id, err))
+		}
+	}
+	if changed, err := t.cursorPosition.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "cursorPosition", t.id, err))
+		}
+	}
+	if changed, err := t.selectionStart.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "selectionStart", t.id, err))
+		}
+	}
+	if changed, err := t.selectionEnd.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "selectionEnd", t.id, err))
+		}
+	}
+	if changed, err := t.selectedText.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "selectedText", t.id, err))
+		}
+	}
+	if changed, err := t.lineCount.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "lineCount", t.id, err))
+		}
+	}
+	if changed, err := t.cursorVisible.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "cursorVisible", t.id, err))
+		}
+	}
+	if changed, err := t.readOnly.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "readOnly", t.id, err))
+		}
+	}
+	if changed, err := t.selectByMouse.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "selectByMouse", t.id, err))
+		}
+	}
+	if changed, err := t.tabChangesFocus.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextEdit", "tabChangesFocus", t.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := t.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (t *TextEdit) As(target *vit.Component) bool {
+	if _, ok := (*target).(*TextEdit); ok {
+		*target = t
+		return true
+	}
+	return t.Item.As(target)
+}
+
+func (t *TextEdit) ID() string {
+	return t.id
+}
+
+func (t *TextEdit) Finish() error {
+	return t.RootC().FinishInContext(t)
+}
+
+func (t *TextEdit) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "NoWrap":
+		return uint(TextEdit_WrapMode_NoWrap), true
+	case "WordWrap":
+		return uint(TextEdit_WrapMode_WordWrap), true
+	case "WrapAnywhere":
+		return uint(TextEdit_WrapMode_WrapAnywhere), true
+	case "Wrap":
+		return uint(TextEdit_WrapMode_Wrap), true
+	default:
+		return nil, false
+	}
+}
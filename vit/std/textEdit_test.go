@@ -0,0 +1,124 @@
+package std
+
+import (
+	"testing"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+)
+
+const textEditSource = `import Vit 1.0
+
+Item {
+    width: 800
+    height: 800
+
+    TextEdit {
+        width: 800
+        height: 400
+        text: "third line\nab\nthird line"
+        focus: true
+        wrapMode: TextEdit.NoWrap
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+}
+`
+
+func TestTextEditEditing(t *testing.T) {
+	manager, handler := loadTestComponent(t, textEditSource)
+	edit := findComponents[*TextEdit](manager.MainComponent())[0]
+
+	press := func(key string, mods KeyArea_Modifiers) bool {
+		return handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: key, Modifiers: mods})
+	}
+	expectCursor := func(cursor int) {
+		t.Helper()
+		if edit.cursorPosition.Int() != cursor {
+			t.Errorf("expected cursor at %d, got %d", cursor, edit.cursorPosition.Int())
+		}
+	}
+
+	if edit.lineCount.Int() != 3 {
+		t.Errorf("expected 3 lines, got %d", edit.lineCount.Int())
+	}
+
+	// moving between lines keeps the column if possible
+	edit.cursorPosition.SetIntValue(6)
+	press("ArrowDown", 0)
+	expectCursor(13) // end of the short line
+	press("ArrowDown", 0)
+	expectCursor(20)
+	press("ArrowUp", KeyArea_Modifiers_shiftModifier)
+	expectCursor(13)
+	if edit.selectedText.String() != "\nthird " {
+		t.Errorf("unexpected selection %q", edit.selectedText.String())
+	}
+	press("Home", KeyArea_Modifiers_controlModifier)
+	expectCursor(0)
+	press("End", 0)
+	expectCursor(10)
+
+	// enter and tab insert characters
+	press("Enter", 0)
+	if !press("Tab", 0) {
+		t.Errorf("tab has not been accepted")
+	}
+	if edit.text.String() != "third line\n\t\nab\nthird line" {
+		t.Errorf("unexpected text %q", edit.text.String())
+	}
+	if edit.lineCount.Int() != 4 {
+		t.Errorf("expected 4 lines, got %d", edit.lineCount.Int())
+	}
+	// shift+tab is left to the focus navigation
+	press("Tab", KeyArea_Modifiers_shiftModifier)
+	if edit.text.String() != "third line\n\t\nab\nthird line" {
+		t.Errorf("shift+tab changed the text to %q", edit.text.String())
+	}
+
+	edit.tabChangesFocus.SetBoolValue(true)
+	if press("Tab", 0) {
+		t.Errorf("tab has been accepted although it should change the focus")
+	}
+}
+
+func TestTextEditWrapping(t *testing.T) {
+	manager, _ := loadTestComponent(t, textEditSource)
+	edit := findComponents[*TextEdit](manager.MainComponent())[0]
+	if edit.fontFaceData == nil {
+		t.Fatal("the test font has not been loaded")
+	}
+
+	edit.width.SetFloatValue(200)
+	edit.text.SetStringValue("one two three four five six seven eight nine ten")
+	edit.wrapMode.SetIntValue(int(TextEdit_WrapMode_WordWrap))
+	if edit.lineCount.Int() < 2 {
+		t.Fatalf("expected the text to be wrapped, got %d lines", edit.lineCount.Int())
+	}
+	for i, line := range edit.state.lines {
+		if line.width() > 200 && line.end-line.start > 1 {
+			// trailing whitespace may exceed the width
+			if text := string(edit.editor.text[line.start:line.end]); text[len(text)-1] != ' ' {
+				t.Errorf("line %d %q is wider than the item", i, text)
+			}
+		}
+		if i > 0 && edit.editor.text[line.start-1] != ' ' {
+			t.Errorf("line %d has not been wrapped between words", i)
+		}
+	}
+
+	edit.wrapMode.SetIntValue(int(TextEdit_WrapMode_NoWrap))
+	if edit.lineCount.Int() != 1 {
+		t.Errorf("expected a single line without wrapping, got %d", edit.lineCount.Int())
+	}
+
+	// a single long word is broken anywhere
+	edit.text.SetStringValue("abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz")
+	edit.wrapMode.SetIntValue(int(TextEdit_WrapMode_Wrap))
+	if edit.lineCount.Int() < 2 {
+		t.Errorf("expected the word to be broken, got %d lines", edit.lineCount.Int())
+	}
+	edit.wrapMode.SetIntValue(int(TextEdit_WrapMode_WordWrap))
+	if edit.lineCount.Int() != 1 {
+		t.Errorf("expected the word to stay in a single line, got %d lines", edit.lineCount.Int())
+	}
+}
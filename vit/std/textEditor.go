@@ -0,0 +1,232 @@
+package std
+
+import "unicode"
+
+// textEditor implements the editing commands that are shared by all components that let the user edit text.
+// All positions are measured in runes.
+type textEditor struct {
+	text   []rune
+	cursor int
+	anchor int // the other end of the selection; equal to the cursor if nothing is selected
+}
+
+// setText replaces the whole text while keeping the cursor and selection in bounds.
+func (e *textEditor) setText(text string) {
+	e.text = []rune(text)
+	e.cursor = e.clamp(e.cursor)
+	e.anchor = e.clamp(e.anchor)
+}
+
+func (e *textEditor) String() string {
+	return string(e.text)
+}
+
+func (e *textEditor) clamp(pos int) int {
+	if pos < 0 {
+		return 0
+	}
+	if pos > len(e.text) {
+		return len(e.text)
+	}
+	return pos
+}
+
+// setCursor moves the cursor to the given position. If selecting is true the selection will be extended up to the new position.
+func (e *textEditor) setCursor(pos int, selecting bool) {
+	e.cursor = e.clamp(pos)
+	if !selecting {
+		e.anchor = e.cursor
+	}
+}
+
+// selectRange selects the text between start and end and places the cursor at the end.
+func (e *textEditor) selectRange(start, end int) {
+	e.anchor = e.clamp(start)
+	e.cursor = e.clamp(end)
+}
+
+func (e *textEditor) selectAll() {
+	e.selectRange(0, len(e.text))
+}
+
+func (e *textEditor) hasSelection() bool {
+	return e.cursor != e.anchor
+}
+
+// selection returns the start and end of the selection with start <= end.
+func (e *textEditor) selection() (int, int) {
+	if e.anchor < e.cursor {
+		return e.anchor, e.cursor
+	}
+	return e.cursor, e.anchor
+}
+
+func (e *textEditor) selectedText() string {
+	start, end := e.selection()
+	return string(e.text[start:end])
+}
+
+// selectWordAt selects the word that contains the given position.
+func (e *textEditor) selectWordAt(pos int) {
+	pos = e.clamp(pos)
+	start, end := pos, pos
+	for start > 0 && isWordRune(e.text[start-1]) {
+		start--
+	}
+	for end < len(e.text) && isWordRune(e.text[end]) {
+		end++
+	}
+	e.selectRange(start, end)
+}
+
+// insert replaces the selection with the given text. At most maxLength runes will be kept in total if maxLength is not negative.
+// It returns false if nothing changed.
+func (e *textEditor) insert(text string, maxLength int) bool {
+	start, end := e.selection()
+	runes := []rune(text)
+	if maxLength >= 0 {
+		available := maxLength - (len(e.text) - (end - start))
+		if available < 0 {
+			available = 0
+		}
+		if len(runes) > available {
+			runes = runes[:available]
+		}
+	}
+	if len(runes) == 0 && start == end {
+		return false
+	}
+	newText := make([]rune, 0, len(e.text)-(end-start)+len(runes))
+	newText = append(newText, e.text[:start]...)
+	newText = append(newText, runes...)
+	newText = append(newText, e.text[end:]...)
+	e.text = newText
+	e.setCursor(start+len(runes), false)
+	return true
+}
+
+// removeSelection deletes the selected text. It returns false if nothing was selected.
+func (e *textEditor) removeSelection() bool {
+	if !e.hasSelection() {
+		return false
+	}
+	return e.insert("", -1)
+}
+
+// deleteBackward deletes the selection or otherwise the character or word in front of the cursor.
+func (e *textEditor) deleteBackward(word bool) bool {
+	if e.removeSelection() {
+		return true
+	}
+	if e.cursor == 0 {
+		return false
+	}
+	if word {
+		e.anchor = e.previousWord(e.cursor)
+	} else {
+		e.anchor = e.cursor - 1
+	}
+	return e.removeSelection()
+}
+
+// deleteForward deletes the selection or otherwise the character or word after the cursor.
+func (e *textEditor) deleteForward(word bool) bool {
+	if e.removeSelection() {
+		return true
+	}
+	if e.cursor == len(e.text) {
+		return false
+	}
+	if word {
+		e.anchor = e.nextWord(e.cursor)
+	} else {
+		e.anchor = e.cursor + 1
+	}
+	return e.removeSelection()
+}
+
+// moveLeft moves the cursor one character or word to the left.
+// Without selecting an existing selection collapses to its start instead.
+func (e *textEditor) moveLeft(word, selecting bool) {
+	if !selecting && e.hasSelection() && !word {
+		start, _ := e.selection()
+		e.setCursor(start, false)
+		return
+	}
+	if word {
+		e.setCursor(e.previousWord(e.cursor), selecting)
+	} else {
+		e.setCursor(e.cursor-1, selecting)
+	}
+}
+
+// moveRight moves the cursor one character or word to the right.
+// Without selecting an existing selection collapses to its end instead.
+func (e *textEditor) moveRight(word, selecting bool) {
+	if !selecting && e.hasSelection() && !word {
+		_, end := e.selection()
+		e.setCursor(end, false)
+		return
+	}
+	if word {
+		e.setCursor(e.nextWord(e.cursor), selecting)
+	} else {
+		e.setCursor(e.cursor+1, selecting)
+	}
+}
+
+// previousWord returns the position of the start of the word in front of pos.
+func (e *textEditor) previousWord(pos int) int {
+	pos = e.clamp(pos)
+	for pos > 0 && !isWordRune(e.text[pos-1]) {
+		pos--
+	}
+	for pos > 0 && isWordRune(e.text[pos-1]) {
+		pos--
+	}
+	return pos
+}
+
+// nextWord returns the position of the end of the word after pos.
+func (e *textEditor) nextWord(pos int) int {
+	pos = e.clamp(pos)
+	for pos < len(e.text) && !isWordRune(e.text[pos]) {
+		pos++
+	}
+	for pos < len(e.text) && isWordRune(e.text[pos]) {
+		pos++
+	}
+	return pos
+}
+
+// lineStart returns the position of the first character of the line that contains pos.
+func (e *textEditor) lineStart(pos int) int {
+	pos = e.clamp(pos)
+	for pos > 0 && e.text[pos-1] != '\n' {
+		pos--
+	}
+	return pos
+}
+
+// lineEnd returns the position after the last character of the line that contains pos.
+func (e *textEditor) lineEnd(pos int) int {
+	pos = e.clamp(pos)
+	for pos < len(e.text) && e.text[pos] != '\n' {
+		pos++
+	}
+	return pos
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// isShortcutModifier returns true if the modifiers contain the key that is used for keyboard shortcuts like copy and paste.
+func isShortcutModifier(mods KeyArea_Modifiers) bool {
+	return mods&(KeyArea_Modifiers_controlModifier|KeyArea_Modifiers_superModifier) != 0
+}
+
+// isWordModifier returns true if the modifiers contain the key that is used to move the cursor by words.
+func isWordModifier(mods KeyArea_Modifiers) bool {
+	return mods&(KeyArea_Modifiers_controlModifier|KeyArea_Modifiers_altModifier) != 0
+}
@@ -0,0 +1,526 @@
+package std
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/omniskop/vitrum/vit/parse"
+	"github.com/tdewolff/canvas"
+)
+
+// TextInputEvent is fired by text inputs when the user finished or accepted their input.
+type TextInputEvent struct {
+	Text string
+}
+
+// textInputState holds the internal state of a TextInput that is not exposed as properties.
+type textInputState struct {
+	offsets       []float64 // horizontal offset of every rune of the display text; contains one additional entry for the end of the text
+	scrollX       float64   // how far the text has been scrolled to the left to keep the cursor visible
+	mousePressed  bool
+	containsMouse bool
+	validator     Validator     // either instantiated from the validator property or set through SetValidator
+	validatorItem vit.Component // the validator if it has been added as a child
+}
+
+func (t *TextInput) wasCompleted(*struct{}) {
+	t.activeFocus.AddDependent(vit.FuncDep(t.activeFocusChanged))
+}
+
+func (t *TextInput) activeFocusChanged() {
+	active := t.activeFocus.Bool()
+	t.cursorVisible.SetBoolValue(active)
+	if !active {
+		t.state.mousePressed = false
+		t.onEditingFinished.Fire(&TextInputEvent{Text: t.text.String()})
+	}
+	if TextInput_EchoMode(t.echoMode.Int()) == TextInput_EchoMode_PasswordEchoOnEdit {
+		t.updateDisplayText()
+	}
+}
+
+func (t *TextInput) textChanged() {
+	if t.text.String() == t.plainText() {
+		return
+	}
+	t.setEditorText(t.text.String())
+	t.syncProperties()
+}
+
+func (t *TextInput) cursorPositionChanged() {
+	if t.cursorPosition.Int() == t.editor.cursor {
+		return
+	}
+	t.editor.setCursor(t.cursorPosition.Int(), false)
+	t.syncProperties()
+}
+
+func (t *TextInput) maximumLengthChanged() {
+	if max := t.maximumLength.Int(); max >= 0 && len(t.editor.text) > max && !t.mask.active() {
+		t.editor.setText(string(t.editor.text[:max]))
+		t.syncProperties()
+	}
+}
+
+func (t *TextInput) validatorChanged() {
+	var validator Validator
+	if def := t.validator.ComponentDefinition(); def != nil {
+		instance, err := parse.InstantiateComponent(def, t.validator.Context())
+		if err != nil {
+			t.Context().Global.Environment.Logger().Printf("TextInput: validator: %v\r\n", err)
+		} else if v, ok := instance.(Validator); ok {
+			validator = v
+		} else {
+			t.Context().Global.Environment.Logger().Printf("TextInput: validator: %s is not a validator\r\n", def.BaseName)
+		}
+	}
+	t.SetValidator(validator)
+}
+
+// SetValidator sets the validator that restricts the text the user is allowed to enter.
+// It replaces any validator that has been set through the validator property. Nil removes the validator.
+// A validator component that isn't part of the component tree yet is added as a child so that its properties are kept up to date.
+func (t *TextInput) SetValidator(validator Validator) {
+	if previous, ok := t.state.validator.(changingValidator); ok {
+		previous.onRulesChanged(nil)
+	}
+	if t.state.validatorItem != nil {
+		t.RemoveChild(t.state.validatorItem)
+		t.state.validatorItem = nil
+	}
+
+	t.state.validator = validator
+	if comp, ok := validator.(vit.Component); ok && comp.RootC().Parent() == nil {
+		t.AddChild(comp)
+		t.state.validatorItem = comp
+	}
+	if v, ok := validator.(changingValidator); ok {
+		// the text is validated again when the properties of the validator change
+		v.onRulesChanged(t.syncProperties)
+	}
+	t.syncProperties()
+}
+
+// validate checks the given text with the current validator.
+func (t *TextInput) validate(text string) ValidationState {
+	if t.state.validator == nil {
+		return AcceptableInput
+	}
+	return t.state.validator.Validate(text)
+}
+
+func (t *TextInput) inputMaskChanged() {
+	text := t.plainText()
+	t.mask = parseMask(t.inputMask.String())
+	t.setEditorText(text)
+	t.syncProperties()
+}
+
+// setEditorText replaces the text of the editor. If an input mask is set the text will be fitted into it.
+func (t *TextInput) setEditorText(text string) {
+	if t.mask.active() {
+		t.editor.setText(string(t.mask.format(text)))
+	} else {
+		t.editor.setText(text)
+	}
+}
+
+// plainText returns the text of the editor without the blank characters of the input mask.
+func (t *TextInput) plainText() string {
+	if t.mask.active() {
+		return t.mask.plain(t.editor.text)
+	}
+	return t.editor.String()
+}
+
+func (t *TextInput) updateFont() {
+	var err error
+	t.fontFaceData, err = loadFont(&t.font, t.color.Color())
+	if err != nil {
+		t.fontFaceData = nil
+		t.selectedFontFaceData = nil
+		t.Context().Global.Environment.Logger().Printf("TextInput: font: %v", err)
+	} else {
+		t.selectedFontFaceData, _ = loadFont(&t.font, t.selectedTextColor.Color())
+	}
+	t.updateLayout()
+}
+
+// updateDisplayText updates the text that is actually drawn according to the echo mode.
+func (t *TextInput) updateDisplayText() {
+	var display string
+	switch TextInput_EchoMode(t.echoMode.Int()) {
+	case TextInput_EchoMode_Normal:
+		display = t.editor.String()
+	case TextInput_EchoMode_Password:
+		display = t.maskedText()
+	case TextInput_EchoMode_NoEcho:
+		display = ""
+	case TextInput_EchoMode_PasswordEchoOnEdit:
+		if t.activeFocus.Bool() {
+			display = t.editor.String()
+		} else {
+			display = t.maskedText()
+		}
+	}
+	if t.displayText.String() != display {
+		t.displayText.SetStringValue(display)
+	}
+	t.updateLayout()
+}
+
+// maskedText returns the display text of the password modes. Every rune is replaced by exactly one rune
+// so that positions in the display text match the positions in the actual text. Only the first rune of passwordCharacter is used.
+func (t *TextInput) maskedText() string {
+	mask, _ := utf8.DecodeRuneInString(t.passwordCharacter.String())
+	if mask == utf8.RuneError {
+		mask = '•'
+	}
+	return strings.Repeat(string(mask), len(t.editor.text))
+}
+
+// updateLayout measures the position of every character of the display text.
+func (t *TextInput) updateLayout() {
+	runes := []rune(t.displayText.String())
+	t.state.offsets = measureRunes(runes, t.fontFaceData, 0)
+	if t.fontFaceData == nil {
+		t.SetContentSize(0, 0)
+		return
+	}
+	t.SetContentSize(t.state.offsets[len(runes)], t.fontFaceData.LineHeight())
+	t.ensureCursorVisible()
+}
+
+// syncProperties writes the state of the editor back into the properties.
+func (t *TextInput) syncProperties() {
+	text := t.plainText()
+	if t.text.String() != text {
+		t.text.SetStringValue(text)
+	}
+	if t.cursorPosition.Int() != t.editor.cursor {
+		t.cursorPosition.SetIntValue(t.editor.cursor)
+	}
+	start, end := t.editor.selection()
+	if t.selectionStart.Int() != start {
+		t.selectionStart.SetIntValue(start)
+	}
+	if t.selectionEnd.Int() != end {
+		t.selectionEnd.SetIntValue(end)
+	}
+	if selected := t.editor.selectedText(); t.selectedText.String() != selected {
+		t.selectedText.SetStringValue(selected)
+	}
+	acceptable := t.validate(text) == AcceptableInput && (!t.mask.active() || t.mask.complete(t.editor.text))
+	t.acceptableInput.SetBoolValue(acceptable)
+	t.updateDisplayText()
+}
+
+// displayCursor returns the position of the cursor in the display text.
+func (t *TextInput) displayCursor() int {
+	if t.editor.cursor >= len(t.state.offsets) {
+		return len(t.state.offsets) - 1
+	}
+	return t.editor.cursor
+}
+
+// ensureCursorVisible scrolls the text horizontally so that the cursor is inside the bounds of the input.
+func (t *TextInput) ensureCursorVisible() {
+	width := t.Bounds().Width()
+	total := t.state.offsets[len(t.state.offsets)-1]
+	if total <= width {
+		t.state.scrollX = 0
+		return
+	}
+	cursorX := t.state.offsets[t.displayCursor()]
+	if cursorX-t.state.scrollX > width {
+		t.state.scrollX = cursorX - width
+	}
+	if cursorX < t.state.scrollX {
+		t.state.scrollX = cursorX
+	}
+	if total-t.state.scrollX < width {
+		t.state.scrollX = total - width
+	}
+}
+
+// PositionAt returns the cursor position that is closest to the given x coordinate.
+func (t *TextInput) PositionAt(x float64) int {
+	if TextInput_EchoMode(t.echoMode.Int()) == TextInput_EchoMode_NoEcho {
+		return 0
+	}
+	x = x - t.Bounds().X1 + t.state.scrollX
+	offsets := t.state.offsets
+	for i := 0; i < len(offsets)-1; i++ {
+		if x < (offsets[i]+offsets[i+1])/2 {
+			return i
+		}
+	}
+	return len(offsets) - 1
+}
+
+// Select selects the text between start and end.
+func (t *TextInput) Select(start, end int) {
+	t.editor.selectRange(start, end)
+	t.syncProperties()
+}
+
+// SelectAll selects the whole text.
+func (t *TextInput) SelectAll() {
+	t.editor.selectAll()
+	t.syncProperties()
+}
+
+// Insert replaces the selection with the given text as if it has been typed by the user.
+func (t *TextInput) Insert(text string) {
+	t.edit(func() bool {
+		if t.mask.active() {
+			return t.mask.insert(&t.editor, sanitizeLine(text))
+		}
+		return t.editor.insert(sanitizeLine(text), t.maximumLength.Int())
+	})
+}
+
+// Copy copies the selected text into the clipboard. Nothing will be copied if the text is hidden by the echo mode.
+func (t *TextInput) Copy() {
+	if !t.editor.hasSelection() || TextInput_EchoMode(t.echoMode.Int()) != TextInput_EchoMode_Normal {
+		return
+	}
+	t.Context().Global.Environment.Clipboard().SetText(t.editor.selectedText())
+}
+
+// Cut moves the selected text into the clipboard.
+func (t *TextInput) Cut() {
+	if t.readOnly.Bool() || TextInput_EchoMode(t.echoMode.Int()) != TextInput_EchoMode_Normal {
+		return
+	}
+	t.Copy()
+	t.edit(func() bool {
+		if t.mask.active() {
+			return t.mask.removeSelection(&t.editor)
+		}
+		return t.editor.removeSelection()
+	})
+}
+
+// Paste replaces the selection with the content of the clipboard.
+func (t *TextInput) Paste() {
+	if t.readOnly.Bool() {
+		return
+	}
+	t.Insert(t.Context().Global.Environment.Clipboard().Text())
+}
+
+// edit executes a function that modifies the text and notifies everyone about the change.
+// Changes that the validator considers invalid are reverted.
+func (t *TextInput) edit(f func() bool) {
+	previous := t.editor
+	if !f() {
+		t.syncProperties()
+		return
+	}
+	if t.validate(t.plainText()) == InvalidInput {
+		t.editor = previous
+		t.syncProperties()
+		return
+	}
+	t.syncProperties()
+	t.onTextEdited.Fire(&TextInputEvent{Text: t.text.String()})
+}
+
+// accept is called when the user presses enter. Nothing happens if the input is not acceptable.
+func (t *TextInput) accept() {
+	if !t.acceptableInput.Bool() {
+		return
+	}
+	event := TextInputEvent{Text: t.text.String()}
+	t.onAccepted.Fire(&event)
+	t.onEditingFinished.Fire(&event)
+}
+
+func (t *TextInput) handleKeyEvent(e *KeyEvent) {
+	if !e.Pressed {
+		return
+	}
+	if e.IsText() {
+		text := sanitizeLine(e.Text)
+		if text == "" {
+			return
+		}
+		e.Accepted = true
+		if !t.readOnly.Bool() {
+			t.Insert(text)
+		}
+		return
+	}
+
+	shortcut := isShortcutModifier(e.Modifiers)
+	word := isWordModifier(e.Modifiers)
+	selecting := e.Modifiers&KeyArea_Modifiers_shiftModifier != 0
+	readOnly := t.readOnly.Bool()
+
+	switch e.Key {
+	case "ArrowLeft":
+		t.editor.moveLeft(word, selecting)
+	case "ArrowRight":
+		t.editor.moveRight(word, selecting)
+	case "Home":
+		t.editor.setCursor(t.editor.lineStart(t.editor.cursor), selecting)
+	case "End":
+		t.editor.setCursor(t.editor.lineEnd(t.editor.cursor), selecting)
+	case "Backspace":
+		if !readOnly {
+			t.edit(func() bool {
+				if t.mask.active() {
+					return t.mask.deleteBackward(&t.editor, word)
+				}
+				return t.editor.deleteBackward(word)
+			})
+		}
+	case "Delete":
+		if !readOnly {
+			t.edit(func() bool {
+				if t.mask.active() {
+					return t.mask.deleteForward(&t.editor, word)
+				}
+				return t.editor.deleteForward(word)
+			})
+		}
+	case "Enter", "NumpadEnter":
+		t.accept()
+	case "A":
+		if !shortcut {
+			return
+		}
+		t.editor.selectAll()
+	case "C":
+		if !shortcut {
+			return
+		}
+		t.Copy()
+	case "X":
+		if !shortcut {
+			return
+		}
+		t.Cut()
+	case "V":
+		if !shortcut {
+			return
+		}
+		t.Paste()
+	default:
+		return
+	}
+	e.Accepted = true
+	t.syncProperties()
+}
+
+func (t *TextInput) acceptsMouse(x, y float64) bool {
+	return t.Bounds().Contains(x, y)
+}
+
+func (t *TextInput) handleMouseEvent(e MouseEvent) {
+	x, y := float64(e.X), float64(e.Y)
+	t.state.containsMouse = t.Bounds().Contains(x, y)
+	left := e.Buttons&MouseArea_MouseButtons_leftButton != 0
+	if !left {
+		t.state.mousePressed = false
+		return
+	}
+	if t.state.mousePressed {
+		if t.selectByMouse.Bool() {
+			t.editor.setCursor(t.PositionAt(x), true)
+			t.syncProperties()
+		}
+		return
+	}
+	if !t.state.containsMouse {
+		return
+	}
+	t.state.mousePressed = true
+	t.ForceActiveFocus(MouseFocusReason)
+	t.editor.setCursor(t.PositionAt(x), false)
+	t.syncProperties()
+}
+
+func (t *TextInput) mouseExited() {
+	t.state.containsMouse = false
+	t.state.mousePressed = false
+}
+
+func (t *TextInput) cursorShape() (MouseArea_CursorShape, bool) {
+	return MouseArea_CursorShape_IBeamCursor, t.state.containsMouse || t.state.mousePressed
+}
+
+func (t *TextInput) Draw(ctx vit.DrawingContext, area vit.Rect) error {
+	if t.fontFaceData == nil {
+		return t.Root.DrawChildren(ctx, area)
+	}
+
+	bounds := t.Bounds()
+	t.ensureCursorVisible()
+	offsets := t.state.offsets
+	runes := []rune(t.displayText.String())
+	scrollX := t.state.scrollX
+	lineHeight := t.fontFaceData.LineHeight()
+	originX := bounds.X1 - scrollX
+	centerY := bounds.CenterY()
+
+	// only characters that are completely inside of the bounds are drawn
+	first := 0
+	for first < len(runes) && offsets[first] < scrollX-0.5 {
+		first++
+	}
+	last := len(runes)
+	for last > first && offsets[last] > scrollX+bounds.Width()+0.5 {
+		last--
+	}
+
+	start, end := t.editor.selection()
+	start, end = clampInt(start, first, last), clampInt(end, first, last)
+	if TextInput_EchoMode(t.echoMode.Int()) == TextInput_EchoMode_NoEcho {
+		start, end = 0, 0
+	}
+	if start < end {
+		ctx.SetFillColor(t.selectionColor.Color())
+		ctx.DrawPath(originX+offsets[start], centerY-lineHeight/2, canvas.Rectangle(offsets[end]-offsets[start], lineHeight))
+	}
+
+	drawPart := func(face *canvas.FontFace, from, to int) {
+		if from >= to || face == nil {
+			return
+		}
+		ctx.DrawText(originX+offsets[from], centerY, canvas.NewTextBox(face, string(runes[from:to]), 0, 0, canvas.Left, canvas.Center, 0, 0))
+	}
+	drawPart(t.fontFaceData, first, start)
+	drawPart(t.selectedFontFaceData, start, end)
+	drawPart(t.fontFaceData, end, last)
+
+	if t.cursorVisible.Bool() && !t.readOnly.Bool() {
+		ctx.SetFillColor(t.cursorColor.Color())
+		ctx.DrawPath(originX+offsets[t.displayCursor()], centerY-lineHeight/2, canvas.Rectangle(1, lineHeight))
+	}
+
+	return t.Root.DrawChildren(ctx, area)
+}
+
+// sanitizeLine removes all control characters from text that should be inserted into a single line.
+func sanitizeLine(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, text)
+}
+
+func clampInt(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -0,0 +1,567 @@
+// Code generated by vitrum gencmd. DO NOT EDIT.
+
+package std
+
+import (
+	"fmt"
+	vit "github.com/omniskop/vitrum/vit"
+	parse "github.com/omniskop/vitrum/vit/parse"
+	canvas "github.com/tdewolff/canvas"
+)
+
+func newFileContextForTextInput(globalCtx *vit.GlobalContext) (*vit.FileContext, error) {
+	return vit.NewFileContext(globalCtx), nil
+}
+
+type TextInput_EchoMode uint
+
+const (
+	TextInput_EchoMode_Normal             TextInput_EchoMode = 0
+	TextInput_EchoMode_Password           TextInput_EchoMode = 1
+	TextInput_EchoMode_NoEcho             TextInput_EchoMode = 2
+	TextInput_EchoMode_PasswordEchoOnEdit TextInput_EchoMode = 3
+)
+
+func (enum TextInput_EchoMode) String() string {
+	switch enum {
+	case TextInput_EchoMode_Normal:
+		return "Normal"
+	case TextInput_EchoMode_Password:
+		return "Password"
+	case TextInput_EchoMode_NoEcho:
+		return "NoEcho"
+	case TextInput_EchoMode_PasswordEchoOnEdit:
+		return "PasswordEchoOnEdit"
+	default:
+		return "<unknownEchoMode>"
+	}
+}
+
+type TextInput struct {
+	*Item
+	id string
+
+	text                 vit.StringValue
+	color                vit.ColorValue
+	selectionColor       vit.ColorValue
+	selectedTextColor    vit.ColorValue
+	cursorColor          vit.ColorValue
+	font                 vit.GroupValue
+	cursorPosition       vit.IntValue
+	selectionStart       vit.IntValue
+	selectionEnd         vit.IntValue
+	selectedText         vit.StringValue
+	displayText          vit.StringValue
+	cursorVisible        vit.BoolValue
+	maximumLength        vit.IntValue
+	echoMode             vit.IntValue
+	passwordCharacter    vit.StringValue
+	readOnly             vit.BoolValue
+	selectByMouse        vit.BoolValue
+	validator            vit.ComponentDefValue
+	inputMask            vit.StringValue
+	acceptableInput      vit.BoolValue
+	editor               textEditor
+	state                textInputState
+	mask                 textMask
+	fontFaceData         *canvas.FontFace
+	selectedFontFaceData *canvas.FontFace
+
+	onAccepted        vit.EventAttribute[TextInputEvent]
+	onEditingFinished vit.EventAttribute[TextInputEvent]
+	onTextEdited      vit.EventAttribute[TextInputEvent]
+}
+
+// newTextInputInGlobal creates an appropriate file context for the component and then returns a new TextInput instance.
+// The returned error will only be set if a library import that is required by the component fails.
+func newTextInputInGlobal(id string, globalCtx *vit.GlobalContext, thisLibrary parse.Library) (*TextInput, error) {
+	fileCtx, err := newFileContextForTextInput(globalCtx)
+	if err != nil {
+		return nil, err
+	}
+	parse.AddLibraryToContainer(thisLibrary, &fileCtx.KnownComponents)
+	return NewTextInput(id, fileCtx), nil
+}
+func NewTextInput(id string, context *vit.FileContext) *TextInput {
+	t := &TextInput{
+		Item:              NewItem("", context),
+		id:                id,
+		text:              *vit.NewEmptyStringValue(),
+		color:             *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"black\"", Position: nil}),
+		selectionColor:    *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "Vit.rgb(0, 120, 215)", Position: nil}),
+		selectedTextColor: *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"white\"", Position: nil}),
+		cursorColor:       *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "\"black\"", Position: nil}),
+		font: *vit.NewEmptyGroupValue(map[string]vit.Value{
+			"bold":      vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"italic":    vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"strikeout": vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"underline": vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+			"pixelSize": vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "12", Position: nil}),
+			"pointSize": vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "12", Position: nil}),
+			"family":    vit.NewStringValueFromCode(vit.Code{FileCtx: context, Code: "\"Arial\"", Position: nil}),
+			"weight":    vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "400", Position: nil}),
+		}),
+		cursorPosition:       *vit.NewEmptyIntValue(),
+		selectionStart:       *vit.NewEmptyIntValue(),
+		selectionEnd:         *vit.NewEmptyIntValue(),
+		selectedText:         *vit.NewEmptyStringValue(),
+		displayText:          *vit.NewEmptyStringValue(),
+		cursorVisible:        *vit.NewEmptyBoolValue(),
+		maximumLength:        *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "32767", Position: nil}),
+		echoMode:             *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "EchoMode.Normal", Position: nil}),
+		passwordCharacter:    *vit.NewStringValueFromCode(vit.Code{FileCtx: context, Code: "\"•\"", Position: nil}),
+		readOnly:             *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "false", Position: nil}),
+		selectByMouse:        *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		validator:            *vit.NewEmptyComponentDefValue(),
+		inputMask:            *vit.NewEmptyStringValue(),
+		acceptableInput:      *vit.NewBoolValueFromCode(vit.Code{FileCtx: context, Code: "true", Position: nil}),
+		editor:               textEditor{},
+		state:                textInputState{},
+		mask:                 textMask{},
+		fontFaceData:         nil,
+		selectedFontFaceData: nil,
+		onAccepted:           *vit.NewEventAttribute[TextInputEvent](),
+		onEditingFinished:    *vit.NewEventAttribute[TextInputEvent](),
+		onTextEdited:         *vit.NewEventAttribute[TextInputEvent](),
+	}
+	// property assignments on embedded components
+	// register listeners for when a property changes
+	t.text.AddDependent(vit.FuncDep(t.textChanged))
+	t.font.AddDependent(vit.FuncDep(t.updateFont))
+	t.cursorPosition.AddDependent(vit.FuncDep(t.cursorPositionChanged))
+	t.maximumLength.AddDependent(vit.FuncDep(t.maximumLengthChanged))
+	t.echoMode.AddDependent(vit.FuncDep(t.updateDisplayText))
+	t.passwordCharacter.AddDependent(vit.FuncDep(t.updateDisplayText))
+	t.validator.AddDependent(vit.FuncDep(t.validatorChanged))
+	t.inputMask.AddDependent(vit.FuncDep(t.inputMaskChanged))
+	// register event listeners
+	var event vit.Listenable
+	var listener vit.Evaluater
+	event, _ = t.Root.Event("onCompleted")
+	listener = event.CreateListener(vit.Code{FileCtx: context, Code: "function() {}", Position: nil})
+	t.AddListenerFunction(listener)
+	event.(*vit.EventAttribute[struct{}]).AddListener(vit.ListenerCB[struct{}](t.wasCompleted))
+	// register enumerations
+	t.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "EchoMode",
+		Position: nil,
+		Values:   map[string]int{"Normal": 0, "Password": 1, "NoEcho": 2, "PasswordEchoOnEdit": 3},
+	})
+	// add child components
+
+	context.RegisterComponent("", t)
+
+	return t
+}
+
+func (t *TextInput) String() string {
+	return fmt.Sprintf("TextInput(%s)", t.id)
+}
+
+func (t *TextInput) Property(key string) (vit.Value, bool) {
+	switch key {
+	case "text":
+		return &t.text, true
+	case "color":
+		return &t.color, true
+	case "selectionColor":
+		return &t.selectionColor, true
+	case "selectedTextColor":
+		return &t.selectedTextColor, true
+	case "cursorColor":
+		return &t.cursorColor, true
+	case "font":
+		return &t.font, true
+	case "cursorPosition":
+		return &t.cursorPosition, true
+	case "selectionStart":
+		return &t.selectionStart, true
+	case "selectionEnd":
+		return &t.selectionEnd, true
+	case "selectedText":
+		return &t.selectedText, true
+	case "displayText":
+		return &t.displayText, true
+	case "cursorVisible":
+		return &t.cursorVisible, true
+	case "maximumLength":
+		return &t.maximumLength, true
+	case "echoMode":
+		return &t.echoMode, true
+	case "passwordCharacter":
+		return &t.passwordCharacter, true
+	case "readOnly":
+		return &t.readOnly, true
+	case "selectByMouse":
+		return &t.selectByMouse, true
+	case "validator":
+		return &t.validator, true
+	case "inputMask":
+		return &t.inputMask, true
+	case "acceptableInput":
+		return &t.acceptableInput, true
+	default:
+		return t.Item.Property(key)
+	}
+}
+
+func (t *TextInput) MustProperty(key string) vit.Value {
+	v, ok := t.Property(key)
+	if !ok {
+		panic(fmt.Errorf("MustProperty called with unknown key %q", key))
+	}
+	return v
+}
+
+func (t *TextInput) SetProperty(key string, value interface{}) error {
+	var err error
+	switch key {
+	case "text":
+		err = t.text.SetValue(value)
+	case "color":
+		err = t.color.SetValue(value)
+	case "selectionColor":
+		err = t.selectionColor.SetValue(value)
+	case "selectedTextColor":
+		err = t.selectedTextColor.SetValue(value)
+	case "cursorColor":
+		err = t.cursorColor.SetValue(value)
+	case "font":
+		err = t.font.SetValue(value)
+	case "cursorPosition":
+		err = t.cursorPosition.SetValue(value)
+	case "selectionStart":
+		err = t.selectionStart.SetValue(value)
+	case "selectionEnd":
+		err = t.selectionEnd.SetValue(value)
+	case "selectedText":
+		err = t.selectedText.SetValue(value)
+	case "displayText":
+		err = t.displayText.SetValue(value)
+	case "cursorVisible":
+		err = t.cursorVisible.SetValue(value)
+	case "maximumLength":
+		err = t.maximumLength.SetValue(value)
+	case "echoMode":
+		err = t.echoMode.SetValue(value)
+	case "passwordCharacter":
+		err = t.passwordCharacter.SetValue(value)
+	case "readOnly":
+		err = t.readOnly.SetValue(value)
+	case "selectByMouse":
+		err = t.selectByMouse.SetValue(value)
+	case "validator":
+		err = t.validator.SetValue(value)
+	case "inputMask":
+		err = t.inputMask.SetValue(value)
+	case "acceptableInput":
+		err = t.acceptableInput.SetValue(value)
+	default:
+		return t.Item.SetProperty(key, value)
+	}
+	if err != nil {
+		return vit.NewPropertyError("TextInput", key, t.id, err)
+	}
+	return nil
+}
+
+func (t *TextInput) SetPropertyCode(key string, code vit.Code) error {
+	switch key {
+	case "text":
+		t.text.SetCode(code)
+	case "color":
+		t.color.SetCode(code)
+	case "selectionColor":
+		t.selectionColor.SetCode(code)
+	case "selectedTextColor":
+		t.selectedTextColor.SetCode(code)
+	case "cursorColor":
+		t.cursorColor.SetCode(code)
+	case "font":
+		t.font.SetCode(code)
+	case "cursorPosition":
+		t.cursorPosition.SetCode(code)
+	case "selectionStart":
+		t.selectionStart.SetCode(code)
+	case "selectionEnd":
+		t.selectionEnd.SetCode(code)
+	case "selectedText":
+		t.selectedText.SetCode(code)
+	case "displayText":
+		t.displayText.SetCode(code)
+	case "cursorVisible":
+		t.cursorVisible.SetCode(code)
+	case "maximumLength":
+		t.maximumLength.SetCode(code)
+	case "echoMode":
+		t.echoMode.SetCode(code)
+	case "passwordCharacter":
+		t.passwordCharacter.SetCode(code)
+	case "readOnly":
+		t.readOnly.SetCode(code)
+	case "selectByMouse":
+		t.selectByMouse.SetCode(code)
+	case "validator":
+		t.validator.SetCode(code)
+	case "inputMask":
+		t.inputMask.SetCode(code)
+	case "acceptableInput":
+		t.acceptableInput.SetCode(code)
+	default:
+		return t.Item.SetPropertyCode(key, code)
+	}
+	return nil
+}
+
+func (t *TextInput) Event(name string) (vit.Listenable, bool) {
+	switch name {
+	case "onAccepted":
+		return &t.onAccepted, true
+	case "onEditingFinished":
+		return &t.onEditingFinished, true
+	case "onTextEdited":
+		return &t.onTextEdited, true
+	default:
+		return t.Item.Event(name)
+	}
+}
+
+func (t *TextInput) ResolveVariable(key string) (interface{}, bool) {
+	switch key {
+	case "text":
+		return &t.text, true
+	case "color":
+		return &t.color, true
+	case "selectionColor":
+		return &t.selectionColor, true
+	case "selectedTextColor":
+		return &t.selectedTextColor, true
+	case "cursorColor":
+		return &t.cursorColor, true
+	case "font":
+		return &t.font, true
+	case "cursorPosition":
+		return &t.cursorPosition, true
+	case "selectionStart":
+		return &t.selectionStart, true
+	case "selectionEnd":
+		return &t.selectionEnd, true
+	case "selectedText":
+		return &t.selectedText, true
+	case "displayText":
+		return &t.displayText, true
+	case "cursorVisible":
+		return &t.cursorVisible, true
+	case "maximumLength":
+		return &t.maximumLength, true
+	case "echoMode":
+		return &t.echoMode, true
+	case "passwordCharacter":
+		return &t.passwordCharacter, true
+	case "readOnly":
+		return &t.readOnly, true
+	case "selectByMouse":
+		return &t.selectByMouse, true
+	case "validator":
+		return &t.validator, true
+	case "inputMask":
+		return &t.inputMask, true
+	case "acceptableInput":
+		return &t.acceptableInput, true
+	case "onAccepted":
+		return &t.onAccepted, true
+	case "onEditingFinished":
+		return &t.onEditingFinished, true
+	case "onTextEdited":
+		return &t.onTextEdited, true
+	default:
+		return t.Item.ResolveVariable(key)
+	}
+}
+
+func (t *TextInput) AddChild(child vit.Component) {
+	child.SetParent(t)
+	t.AddChildButKeepParent(child)
+}
+
+func (t *TextInput) AddChildAfter(afterThis vit.Component, addThis vit.Component) {
+	var targetType vit.Component = afterThis
+
+	for ind, child := range t.Children() {
+		if child.As(&targetType) {
+			addThis.SetParent(t)
+			t.AddChildAtButKeepParent(addThis, ind+1)
+			return
+		}
+	}
+	t.AddChild(addThis)
+}
+
+func (t *TextInput) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
+	var sum int
+	var errs vit.ErrorGroup
+
+	if context == nil {
+		context = t
+	}
+	// properties
+	if changed, err := t.text.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "text", t.id, err))
+		}
+	}
+	if changed, err := t.color.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "color", t.id, err))
+		}
+	}
+	if changed, err := t.selectionColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "selectionColor", t.id, err))
+		}
+	}
+	if changed, err := t.selectedTextColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "selectedTextColor", t.id, err))
+		}
+	}
+	if changed, err := t.cursorColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "cursorColor", t.id, err))
+		}
+	}
+	if changed, err := t.font.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "font", t.id, err))
+		}
+	}
+	if changed, err := t.cursorPosition.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "cursorPosition", t.id, err))
+		}
+	}
+	if changed, err := t.selectionStart.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "selectionStart", t.id, err))
+		}
+	}
+	if changed, err := t.selectionEnd.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "selectionEnd", t.id, err))
+		}
+	}
+	if changed, err := t.selectedText.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "selectedText", t.id, err))
+		}
+	}
+	if changed, err := t.displayText.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "displayText", t.id, err))
+		}
+	}
+	if changed, err := t.cursorVisible.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "cursorVisible", t.id, err))
+		}
+	}
+	if changed, err := t.maximumLength.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "maximumLength", t.id, err))
+		}
+	}
+	if changed, err := t.echoMode.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "echoMode", t.id, err))
+		}
+	}
+	if changed, err := t.passwordCharacter.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "passwordCharacter", t.id, err))
+		}
+	}
+	if changed, err := t.readOnly.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "readOnly", t.id, err))
+		}
+	}
+	if changed, err := t.selectByMouse.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "selectByMouse", t.id, err))
+		}
+	}
+	if changed, err := t.validator.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "validator", t.id, err))
+		}
+	}
+	if changed, err := t.inputMask.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "inputMask", t.id, err))
+		}
+	}
+	if changed, err := t.acceptableInput.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("TextInput", "acceptableInput", t.id, err))
+		}
+	}
+
+	// methods
+
+	n, err := t.Item.UpdateExpressions(context)
+	sum += n
+	errs.AddGroup(err)
+	return sum, errs
+}
+
+func (t *TextInput) As(target *vit.Component) bool {
+	if _, ok := (*target).(*TextInput); ok {
+		*target = t
+		return true
+	}
+	return t.Item.As(target)
+}
+
+func (t *TextInput) ID() string {
+	return t.id
+}
+
+func (t *TextInput) Finish() error {
+	return t.RootC().FinishInContext(t)
+}
+
+func (t *TextInput) staticAttribute(name string) (interface{}, bool) {
+	switch name {
+	case "Normal":
+		return uint(TextInput_EchoMode_Normal), true
+	case "Password":
+		return uint(TextInput_EchoMode_Password), true
+	case "NoEcho":
+		return uint(TextInput_EchoMode_NoEcho), true
+	case "PasswordEchoOnEdit":
+		return uint(TextInput_EchoMode_PasswordEchoOnEdit), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,164 @@
+package std
+
+import (
+	"testing"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+const textInputSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 400
+
+    TextInput {
+        width: 300
+        height: 30
+        text: "hello world"
+        focus: true
+        maximumLength: 20
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+}
+`
+
+func TestTextInputEditing(t *testing.T) {
+	manager, handler := loadTestComponent(t, textInputSource)
+	input := findComponents[*TextInput](manager.MainComponent())[0]
+
+	var accepted, edited int
+	input.onAccepted.AddListener(vit.ListenerCB(func(e *TextInputEvent) { accepted++ }))
+	input.onTextEdited.AddListener(vit.ListenerCB(func(e *TextInputEvent) { edited++ }))
+
+	press := func(key string, mods KeyArea_Modifiers) {
+		handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: key, Modifiers: mods})
+	}
+	write := func(text string) {
+		handler.TriggerKeyEvent(KeyEvent{Pressed: true, Text: text})
+	}
+	expect := func(text string, cursor, selectionStart, selectionEnd int) {
+		t.Helper()
+		if input.text.String() != text {
+			t.Errorf("expected text %q, got %q", text, input.text.String())
+		}
+		if input.cursorPosition.Int() != cursor {
+			t.Errorf("expected cursor at %d, got %d", cursor, input.cursorPosition.Int())
+		}
+		if input.selectionStart.Int() != selectionStart || input.selectionEnd.Int() != selectionEnd {
+			t.Errorf("expected selection %d-%d, got %d-%d", selectionStart, selectionEnd, input.selectionStart.Int(), input.selectionEnd.Int())
+		}
+	}
+
+	press("End", 0)
+	expect("hello world", 11, 11, 11)
+	press("ArrowLeft", KeyArea_Modifiers_controlModifier|KeyArea_Modifiers_shiftModifier)
+	expect("hello world", 6, 6, 11)
+	if input.selectedText.String() != "world" {
+		t.Errorf("expected selected text %q, got %q", "world", input.selectedText.String())
+	}
+
+	// copy and paste
+	press("X", KeyArea_Modifiers_controlModifier)
+	expect("hello ", 6, 6, 6)
+	if clip := handler.Clipboard().Text(); clip != "world" {
+		t.Errorf("expected clipboard to contain %q, got %q", "world", clip)
+	}
+	press("Home", 0)
+	press("V", KeyArea_Modifiers_controlModifier)
+	expect("worldhello ", 5, 5, 5)
+
+	// typing replaces the selection
+	press("ArrowRight", KeyArea_Modifiers_shiftModifier)
+	write("-")
+	expect("world-ello ", 6, 6, 6)
+
+	// deleting
+	press("Backspace", KeyArea_Modifiers_controlModifier)
+	expect("ello ", 0, 0, 0)
+	press("Delete", 0)
+	expect("llo ", 0, 0, 0)
+
+	// the maximum length is respected
+	handler.Clipboard().SetText("0123456789abcdefghij")
+	press("A", KeyArea_Modifiers_controlModifier)
+	expect("llo ", 4, 0, 4)
+	press("V", KeyArea_Modifiers_controlModifier)
+	press("V", KeyArea_Modifiers_controlModifier)
+	expect("0123456789abcdefghij", 20, 20, 20)
+
+	press("Enter", 0)
+	if accepted != 1 {
+		t.Errorf("expected the input to be accepted once, got %d", accepted)
+	}
+	if edited != 6 {
+		t.Errorf("expected 6 edits, got %d", edited)
+	}
+
+	// password mode hides the text and prevents copying
+	input.echoMode.SetIntValue(int(TextInput_EchoMode_Password))
+	if input.displayText.String() != "••••••••••••••••••••" {
+		t.Errorf("unexpected display text %q", input.displayText.String())
+	}
+	handler.Clipboard().SetText("")
+	press("A", KeyArea_Modifiers_controlModifier)
+	press("C", KeyArea_Modifiers_controlModifier)
+	if handler.Clipboard().Text() != "" {
+		t.Errorf("password has been copied")
+	}
+
+	// every character is masked by a single rune so that positions in both texts match
+	input.passwordCharacter.SetStringValue("*#")
+	if input.displayText.String() != "********************" {
+		t.Errorf("unexpected display text %q with a multi-rune password character", input.displayText.String())
+	}
+	input.passwordCharacter.SetStringValue("")
+	if input.displayText.String() != "••••••••••••••••••••" {
+		t.Errorf("unexpected display text %q with an empty password character", input.displayText.String())
+	}
+	if len(input.state.offsets) != len(input.editor.text)+1 {
+		t.Errorf("got %d offsets for %d characters", len(input.state.offsets), len(input.editor.text))
+	}
+	press("Home", 0)
+	press("ArrowRight", 0)
+	if x := input.state.offsets[1] + input.Bounds().X1; input.PositionAt(x) != input.cursorPosition.Int() {
+		t.Errorf("position %d at the cursor doesn't match the cursor position %d", input.PositionAt(x), input.cursorPosition.Int())
+	}
+
+	// tab is not consumed by the input
+	if handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: "Tab"}) {
+		t.Errorf("tab has been accepted by the input")
+	}
+}
+
+func TestTextInputMouse(t *testing.T) {
+	manager, handler := loadTestComponent(t, textInputSource)
+	input := findComponents[*TextInput](manager.MainComponent())[0]
+	if input.fontFaceData == nil {
+		t.Fatal("the test font has not been loaded")
+	}
+	input.ForceActiveFocus(OtherFocusReason)
+
+	offsets := input.state.offsets
+	left := func(i int) int { return int(offsets[i]) + 1 }
+
+	// click to position the cursor
+	handler.TriggerMouseEvent(MouseEvent{X: left(2), Y: 10, Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: left(2), Y: 10})
+	if input.cursorPosition.Int() != 2 {
+		t.Errorf("expected the cursor at 2, got %d", input.cursorPosition.Int())
+	}
+
+	// drag to select
+	handler.TriggerMouseEvent(MouseEvent{X: left(1), Y: 10, Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: left(4), Y: 10, Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: left(4), Y: 10})
+	if input.selectedText.String() != "ell" {
+		t.Errorf("expected %q to be selected, got %q", "ell", input.selectedText.String())
+	}
+	if shape := handler.CursorShape(); shape != MouseArea_CursorShape_IBeamCursor {
+		t.Errorf("expected an i-beam cursor above the input, got %d", shape)
+	}
+}
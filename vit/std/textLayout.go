@@ -0,0 +1,162 @@
+package std
+
+import (
+	"math"
+	"unicode"
+
+	vit "github.com/omniskop/vitrum/vit"
+	"github.com/tdewolff/canvas"
+)
+
+// textWrapMode describes where lines may be broken when laying out text.
+type textWrapMode int
+
+const (
+	textNoWrap         textWrapMode = iota // lines are only broken at line breaks
+	textWordWrap                           // lines are broken between words
+	textWrapAnywhere                       // lines are broken at any character
+	textWrapWordOrRune                     // lines are broken between words if possible and at any character otherwise
+)
+
+// textLine is a single visual line of laid out text.
+type textLine struct {
+	start, end int       // runes of the text that belong to this line; a line break is not part of the line
+	offsets    []float64 // horizontal offset of every rune relative to the start of the line; contains one additional entry for the end of the line
+}
+
+func (l textLine) width() float64 {
+	return l.offsets[len(l.offsets)-1]
+}
+
+// positionAt returns the position in the text that is closest to the given horizontal offset.
+func (l textLine) positionAt(x float64) int {
+	for i := 0; i < len(l.offsets)-1; i++ {
+		if x < (l.offsets[i]+l.offsets[i+1])/2 {
+			return l.start + i
+		}
+	}
+	return l.end
+}
+
+// layoutText splits the text into lines that are at most width wide.
+// Tabs advance to the next multiple of tabWidth. If face is nil all characters are treated as having no width.
+func layoutText(text []rune, face *canvas.FontFace, width float64, mode textWrapMode, tabWidth float64) []textLine {
+	var lines []textLine
+	start := 0
+	for {
+		end := start
+		for end < len(text) && text[end] != '\n' {
+			end++
+		}
+		lines = append(lines, wrapParagraph(text, start, end, face, width, mode, tabWidth)...)
+		if end == len(text) {
+			return lines
+		}
+		start = end + 1
+	}
+}
+
+// wrapParagraph lays out the runes between start and end which don't contain any line breaks.
+func wrapParagraph(text []rune, start, end int, face *canvas.FontFace, width float64, mode textWrapMode, tabWidth float64) []textLine {
+	newLine := func(from, to int) textLine {
+		return textLine{start: from, end: to, offsets: measureRunes(text[from:to], face, tabWidth)}
+	}
+	if mode == textNoWrap || math.IsInf(width, 1) {
+		return []textLine{newLine(start, end)}
+	}
+
+	paragraph := text[start:end]
+	offsets := measureRunes(paragraph, face, tabWidth)
+	var lines []textLine
+	lineStart := 0
+	for {
+		fits := lineStart
+		for fits < len(paragraph) && offsets[fits+1]-offsets[lineStart] <= width {
+			fits++
+		}
+		if fits == len(paragraph) {
+			return append(lines, newLine(start+lineStart, end))
+		}
+		if fits == lineStart {
+			fits++ // every line contains at least one character
+		}
+
+		lineEnd := fits
+		if mode != textWrapAnywhere {
+			if unicode.IsSpace(paragraph[fits]) {
+				// whitespace at the end of a line is kept even if it doesn't fit
+				for lineEnd < len(paragraph) && unicode.IsSpace(paragraph[lineEnd]) {
+					lineEnd++
+				}
+			} else {
+				for lineEnd > lineStart && !unicode.IsSpace(paragraph[lineEnd-1]) {
+					lineEnd--
+				}
+				if lineEnd == lineStart {
+					// the word is longer than a whole line
+					if mode == textWordWrap {
+						lineEnd = fits
+						for lineEnd < len(paragraph) && !unicode.IsSpace(paragraph[lineEnd]) {
+							lineEnd++
+						}
+						for lineEnd < len(paragraph) && unicode.IsSpace(paragraph[lineEnd]) {
+							lineEnd++
+						}
+					} else {
+						lineEnd = fits
+					}
+				}
+			}
+		}
+		lines = append(lines, newLine(start+lineStart, start+lineEnd))
+		if lineEnd == len(paragraph) {
+			// the paragraph ended with whitespace that has been appended to the last line
+			return lines
+		}
+		lineStart = lineEnd
+	}
+}
+
+// measureRunes returns the horizontal offset of every rune and one additional entry for the end of the text.
+// Words are measured as a whole to respect kerning.
+func measureRunes(runes []rune, face *canvas.FontFace, tabWidth float64) []float64 {
+	offsets := make([]float64, len(runes)+1)
+	if face == nil {
+		return offsets
+	}
+	wordStart := 0
+	for i, r := range runes {
+		switch {
+		case r == '\t':
+			x := offsets[i]
+			if tabWidth > 0 {
+				x = (math.Floor(x/tabWidth+1e-9) + 1) * tabWidth
+			}
+			offsets[i+1] = x
+			wordStart = i + 1
+		case unicode.IsSpace(r):
+			offsets[i+1] = offsets[i] + face.TextWidth(string(r))
+			wordStart = i + 1
+		default:
+			offsets[i+1] = offsets[wordStart] + face.TextWidth(string(runes[wordStart:i+1]))
+		}
+	}
+	return offsets
+}
+
+// drawRunes draws the runes between from and to at the given offsets. Tabs are skipped.
+func drawRunes(ctx vit.DrawingContext, face *canvas.FontFace, runes []rune, offsets []float64, from, to int, x, y float64, vAlign canvas.TextAlign) {
+	if face == nil {
+		return
+	}
+	segmentStart := from
+	for i := from; i <= to; i++ {
+		if i < to && runes[i] != '\t' {
+			continue
+		}
+		if segmentStart < i {
+			ctx.DrawText(x+offsets[segmentStart], y, canvas.NewTextBox(face, string(runes[segmentStart:i]), 0, 0, canvas.Left, vAlign, 0, 0))
+		}
+		segmentStart = i + 1
+	}
+}
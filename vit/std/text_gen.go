@@ -19,6 +19,7 @@ const (
 	Text_HorizontalAlignment_AlignLeft    Text_HorizontalAlignment = 0
 	Text_HorizontalAlignment_AlignHCenter Text_HorizontalAlignment = 1
 	Text_HorizontalAlignment_AlignRight   Text_HorizontalAlignment = 2
+	Text_HorizontalAlignment_AlignJustify Text_HorizontalAlignment = 3
 )
 
 func (enum Text_HorizontalAlignment) String() string {
@@ -29,6 +30,8 @@ func (enum Text_HorizontalAlignment) String() string {
 		return "AlignHCenter"
 	case Text_HorizontalAlignment_AlignRight:
 		return "AlignRight"
+	case Text_HorizontalAlignment_AlignJustify:
+		return "AlignJustify"
 	default:
 		return "<unknownHorizontalAlignment>"
 	}
@@ -104,6 +107,72 @@ func (enum Text_FontWeight) String() string {
 	}
 }
 
+type Text_WrapMode uint
+
+const (
+	Text_WrapMode_NoWrap       Text_WrapMode = 0
+	Text_WrapMode_WordWrap     Text_WrapMode = 1
+	Text_WrapMode_WrapAnywhere Text_WrapMode = 2
+	Text_WrapMode_Wrap         Text_WrapMode = 3
+)
+
+func (enum Text_WrapMode) String() string {
+	switch enum {
+	case Text_WrapMode_NoWrap:
+		return "NoWrap"
+	case Text_WrapMode_WordWrap:
+		return "WordWrap"
+	case Text_WrapMode_WrapAnywhere:
+		return "WrapAnywhere"
+	case Text_WrapMode_Wrap:
+		return "Wrap"
+	default:
+		return "<unknownWrapMode>"
+	}
+}
+
+type Text_LineHeightMode uint
+
+const (
+	Text_LineHeightMode_ProportionalHeight Text_LineHeightMode = 0
+	Text_LineHeightMode_FixedHeight        Text_LineHeightMode = 1
+)
+
+func (enum Text_LineHeightMode) String() string {
+	switch enum {
+	case Text_LineHeightMode_ProportionalHeight:
+		return "ProportionalHeight"
+	case Text_LineHeightMode_FixedHeight:
+		return "FixedHeight"
+	default:
+		return "<unknownLineHeightMode>"
+	}
+}
+
+type Text_TextFormat uint
+
+const (
+	Text_TextFormat_PlainText    Text_TextFormat = 0
+	Text_TextFormat_StyledText   Text_TextFormat = 1
+	Text_TextFormat_MarkdownText Text_TextFormat = 2
+	Text_TextFormat_AutoText     Text_TextFormat = 3
+)
+
+func (enum Text_TextFormat) String() string {
+	switch enum {
+	case Text_TextFormat_PlainText:
+		return "PlainText"
+	case Text_TextFormat_StyledText:
+		return "StyledText"
+	case Text_TextFormat_MarkdownText:
+		return "MarkdownText"
+	case Text_TextFormat_AutoText:
+		return "AutoText"
+	default:
+		return "<unknownTextFormat>"
+	}
+}
+
 type Text_Elide uint
 
 const (
@@ -138,8 +207,21 @@ type Text struct {
 	verticalAlignment   vit.IntValue
 	font                vit.GroupValue
 	elide               vit.IntValue
+	wrapMode            vit.IntValue
+	maximumLineCount    vit.IntValue
+	lineHeight          vit.FloatValue
+	lineHeightMode      vit.IntValue
+	textFormat          vit.IntValue
+	linkColor           vit.ColorValue
+	hoveredLink         vit.StringValue
+	lineCount           vit.IntValue
+	contentWidth        vit.FloatValue
+	contentHeight       vit.FloatValue
 	fontData            *canvas.FontFamily
 	fontFaceData        *canvas.FontFace
+	textLayout          textLayoutState
+
+	onLinkActivated vit.EventAttribute[LinkEvent]
 }
 
 // newTextInGlobal creates an appropriate file context for the component and then returns a new Text instance.
@@ -170,20 +252,40 @@ func NewText(id string, context *vit.FileContext) *Text {
 			"family":    vit.NewStringValueFromCode(vit.Code{FileCtx: context, Code: "\"Arial\"", Position: nil}),
 			"weight":    vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "FontWeight.Normal", Position: nil}),
 		}),
-		elide:        *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Elide.ElideNone", Position: nil}),
-		fontData:     nil,
-		fontFaceData: nil,
+		elide:            *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "Elide.ElideNone", Position: nil}),
+		wrapMode:         *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "WrapMode.NoWrap", Position: nil}),
+		maximumLineCount: *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "2147483647", Position: nil}),
+		lineHeight:       *vit.NewFloatValueFromCode(vit.Code{FileCtx: context, Code: "1", Position: nil}),
+		lineHeightMode:   *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "LineHeightMode.ProportionalHeight", Position: nil}),
+		textFormat:       *vit.NewIntValueFromCode(vit.Code{FileCtx: context, Code: "TextFormat.PlainText", Position: nil}),
+		linkColor:        *vit.NewColorValueFromCode(vit.Code{FileCtx: context, Code: "Vit.rgb(0, 102, 204)", Position: nil}),
+		hoveredLink:      *vit.NewEmptyStringValue(),
+		lineCount:        *vit.NewEmptyIntValue(),
+		contentWidth:     *vit.NewEmptyFloatValue(),
+		contentHeight:    *vit.NewEmptyFloatValue(),
+		fontData:         nil,
+		fontFaceData:     nil,
+		textLayout:       textLayoutState{},
+		onLinkActivated:  *vit.NewEventAttribute[LinkEvent](),
 	}
 	// property assignments on embedded components
 	// register listeners for when a property changes
+	t.text.AddDependent(vit.FuncDep(t.updateLayout))
 	t.font.AddDependent(vit.FuncDep(t.updateFont))
+	t.wrapMode.AddDependent(vit.FuncDep(t.updateLayout))
+	t.maximumLineCount.AddDependent(vit.FuncDep(t.updateLayout))
+	t.lineHeight.AddDependent(vit.FuncDep(t.updateLayout))
+	t.lineHeightMode.AddDependent(vit.FuncDep(t.updateLayout))
+	t.textFormat.AddDependent(vit.FuncDep(t.updateLayout))
+	t.linkColor.AddDependent(vit.FuncDep(t.updateLayout))
+	t.Item.AddBoundsDependency(vit.FuncDep(t.boundsChanged))
 	// register event listeners
 	// register enumerations
 	t.DefineEnum(vit.Enumeration{
 		Embedded: true,
 		Name:     "HorizontalAlignment",
 		Position: nil,
-		Values:   map[string]int{"AlignLeft": 0, "AlignHCenter": 1, "AlignRight": 2},
+		Values:   map[string]int{"AlignLeft": 0, "AlignHCenter": 1, "AlignRight": 2, "AlignJustify": 3},
 	})
 	t.DefineEnum(vit.Enumeration{
 		Embedded: true,
@@ -197,6 +299,24 @@ func NewText(id string, context *vit.FileContext) *Text {
 		Position: nil,
 		Values:   map[string]int{"Thin": 100, "ExtraLight": 200, "UltraLight": 200, "Light": 300, "Normal": 400, "Regular": 400, "Medium": 500, "DemiBold": 600, "SemiBold": 600, "Bold": 700, "ExtraBold": 800, "UltraBold": 800, "Black": 900, "Heavy": 900},
 	})
+	t.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "WrapMode",
+		Position: nil,
+		Values:   map[string]int{"NoWrap": 0, "WordWrap": 1, "WrapAnywhere": 2, "Wrap": 3},
+	})
+	t.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "LineHeightMode",
+		Position: nil,
+		Values:   map[string]int{"ProportionalHeight": 0, "FixedHeight": 1},
+	})
+	t.DefineEnum(vit.Enumeration{
+		Embedded: true,
+		Name:     "TextFormat",
+		Position: nil,
+		Values:   map[string]int{"PlainText": 0, "StyledText": 1, "MarkdownText": 2, "AutoText": 3},
+	})
 	t.DefineEnum(vit.Enumeration{
 		Embedded: true,
 		Name:     "Elide",
@@ -228,6 +348,26 @@ func (t *Text) Property(key string) (vit.Value, bool) {
 		return &t.font, true
 	case "elide":
 		return &t.elide, true
+	case "wrapMode":
+		return &t.wrapMode, true
+	case "maximumLineCount":
+		return &t.maximumLineCount, true
+	case "lineHeight":
+		return &t.lineHeight, true
+	case "lineHeightMode":
+		return &t.lineHeightMode, true
+	case "textFormat":
+		return &t.textFormat, true
+	case "linkColor":
+		return &t.linkColor, true
+	case "hoveredLink":
+		return &t.hoveredLink, true
+	case "lineCount":
+		return &t.lineCount, true
+	case "contentWidth":
+		return &t.contentWidth, true
+	case "contentHeight":
+		return &t.contentHeight, true
 	default:
 		return t.Item.Property(key)
 	}
@@ -256,6 +396,26 @@ func (t *Text) SetProperty(key string, value interface{}) error {
 		err = t.font.SetValue(value)
 	case "elide":
 		err = t.elide.SetValue(value)
+	case "wrapMode":
+		err = t.wrapMode.SetValue(value)
+	case "maximumLineCount":
+		err = t.maximumLineCount.SetValue(value)
+	case "lineHeight":
+		err = t.lineHeight.SetValue(value)
+	case "lineHeightMode":
+		err = t.lineHeightMode.SetValue(value)
+	case "textFormat":
+		err = t.textFormat.SetValue(value)
+	case "linkColor":
+		err = t.linkColor.SetValue(value)
+	case "hoveredLink":
+		err = t.hoveredLink.SetValue(value)
+	case "lineCount":
+		err = t.lineCount.SetValue(value)
+	case "contentWidth":
+		err = t.contentWidth.SetValue(value)
+	case "contentHeight":
+		err = t.contentHeight.SetValue(value)
 	default:
 		return t.Item.SetProperty(key, value)
 	}
@@ -279,6 +439,26 @@ func (t *Text) SetPropertyCode(key string, code vit.Code) error {
 		t.font.SetCode(code)
 	case "elide":
 		t.elide.SetCode(code)
+	case "wrapMode":
+		t.wrapMode.SetCode(code)
+	case "maximumLineCount":
+		t.maximumLineCount.SetCode(code)
+	case "lineHeight":
+		t.lineHeight.SetCode(code)
+	case "lineHeightMode":
+		t.lineHeightMode.SetCode(code)
+	case "textFormat":
+		t.textFormat.SetCode(code)
+	case "linkColor":
+		t.linkColor.SetCode(code)
+	case "hoveredLink":
+		t.hoveredLink.SetCode(code)
+	case "lineCount":
+		t.lineCount.SetCode(code)
+	case "contentWidth":
+		t.contentWidth.SetCode(code)
+	case "contentHeight":
+		t.contentHeight.SetCode(code)
 	default:
 		return t.Item.SetPropertyCode(key, code)
 	}
@@ -287,6 +467,8 @@ func (t *Text) SetPropertyCode(key string, code vit.Code) error {
 
 func (t *Text) Event(name string) (vit.Listenable, bool) {
 	switch name {
+	case "onLinkActivated":
+		return &t.onLinkActivated, true
 	default:
 		return t.Item.Event(name)
 	}
@@ -306,6 +488,28 @@ func (t *Text) ResolveVariable(key string) (interface{}, bool) {
 		return &t.font, true
 	case "elide":
 		return &t.elide, true
+	case "wrapMode":
+		return &t.wrapMode, true
+	case "maximumLineCount":
+		return &t.maximumLineCount, true
+	case "lineHeight":
+		return &t.lineHeight, true
+	case "lineHeightMode":
+		return &t.lineHeightMode, true
+	case "textFormat":
+		return &t.textFormat, true
+	case "linkColor":
+		return &t.linkColor, true
+	case "hoveredLink":
+		return &t.hoveredLink, true
+	case "lineCount":
+		return &t.lineCount, true
+	case "contentWidth":
+		return &t.contentWidth, true
+	case "contentHeight":
+		return &t.contentHeight, true
+	case "onLinkActivated":
+		return &t.onLinkActivated, true
 	default:
 		return t.Item.ResolveVariable(key)
 	}
@@ -373,6 +577,66 @@ func (t *Text) UpdateExpressions(context vit.Component) (int, vit.ErrorGroup) {
 			errs.Add(vit.NewPropertyError("Text", "elide", t.id, err))
 		}
 	}
+	if changed, err := t.wrapMode.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "wrapMode", t.id, err))
+		}
+	}
+	if changed, err := t.maximumLineCount.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "maximumLineCount", t.id, err))
+		}
+	}
+	if changed, err := t.lineHeight.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "lineHeight", t.id, err))
+		}
+	}
+	if changed, err := t.lineHeightMode.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "lineHeightMode", t.id, err))
+		}
+	}
+	if changed, err := t.textFormat.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "textFormat", t.id, err))
+		}
+	}
+	if changed, err := t.linkColor.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "linkColor", t.id, err))
+		}
+	}
+	if changed, err := t.hoveredLink.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "hoveredLink", t.id, err))
+		}
+	}
+	if changed, err := t.lineCount.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "lineCount", t.id, err))
+		}
+	}
+	if changed, err := t.contentWidth.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "contentWidth", t.id, err))
+		}
+	}
+	if changed, err := t.contentHeight.Update(context); changed || err != nil {
+		sum++
+		if err != nil {
+			errs.Add(vit.NewPropertyError("Text", "contentHeight", t.id, err))
+		}
+	}
 
 	// methods
 
@@ -406,6 +670,8 @@ func (t *Text) staticAttribute(name string) (interface{}, bool) {
 		return uint(Text_HorizontalAlignment_AlignHCenter), true
 	case "AlignRight":
 		return uint(Text_HorizontalAlignment_AlignRight), true
+	case "AlignJustify":
+		return uint(Text_HorizontalAlignment_AlignJustify), true
 	case "AlignTop":
 		return uint(Text_VerticalAlignment_AlignTop), true
 	case "AlignVCenter":
@@ -440,6 +706,26 @@ func (t *Text) staticAttribute(name string) (interface{}, bool) {
 		return uint(Text_FontWeight_Black), true
 	case "Heavy":
 		return uint(Text_FontWeight_Heavy), true
+	case "NoWrap":
+		return uint(Text_WrapMode_NoWrap), true
+	case "WordWrap":
+		return uint(Text_WrapMode_WordWrap), true
+	case "WrapAnywhere":
+		return uint(Text_WrapMode_WrapAnywhere), true
+	case "Wrap":
+		return uint(Text_WrapMode_Wrap), true
+	case "ProportionalHeight":
+		return uint(Text_LineHeightMode_ProportionalHeight), true
+	case "FixedHeight":
+		return uint(Text_LineHeightMode_FixedHeight), true
+	case "PlainText":
+		return uint(Text_TextFormat_PlainText), true
+	case "StyledText":
+		return uint(Text_TextFormat_StyledText), true
+	case "MarkdownText":
+		return uint(Text_TextFormat_MarkdownText), true
+	case "AutoText":
+		return uint(Text_TextFormat_AutoText), true
 	case "ElideNone":
 		return uint(Text_Elide_ElideNone), true
 	case "ElideLeft":
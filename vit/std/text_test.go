@@ -0,0 +1,260 @@
+package std
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+const textSource = `import Vit 1.0
+
+Item {
+    width: 800
+    height: 800
+
+    Text {
+        id: wrapped
+        width: 150
+        text: "one two three four five six seven eight nine ten"
+        wrapMode: Text.WordWrap
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+
+    Text {
+        id: natural
+        text: "first\nsecond line"
+        lineHeight: 2
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+}
+`
+
+func TestTextLayout(t *testing.T) {
+	manager, _ := loadTestComponent(t, textSource)
+	texts := findComponents[*Text](manager.MainComponent())
+	wrapped, natural := texts[0], texts[1]
+	if wrapped.fontFaceData == nil {
+		t.Fatal("the test font has not been loaded")
+	}
+
+	lines := wrapped.lineCount.Int()
+	if lines < 2 {
+		t.Fatalf("expected the text to be wrapped, got %d lines", lines)
+	}
+	if width := wrapped.contentWidth.Float64(); width > 150 || width == 0 {
+		t.Errorf("expected the content to fit into the width, got %v", width)
+	}
+	lineHeight := wrapped.fontFaceData.LineHeight()
+	if height := wrapped.contentHeight.Float64(); height != float64(lines)*lineHeight {
+		t.Errorf("expected a content height of %v, got %v", float64(lines)*lineHeight, height)
+	}
+
+	// the width of the item limits the lines
+	wrapped.width.SetFloatValue(300)
+	if wrapped.lineCount.Int() >= lines {
+		t.Errorf("expected less lines in a wider item, got %d", wrapped.lineCount.Int())
+	}
+
+	wrapped.width.SetFloatValue(150)
+	wrapped.maximumLineCount.SetIntValue(1)
+	if wrapped.lineCount.Int() != 1 || !wrapped.textLayout.truncated {
+		t.Errorf("expected a single truncated line, got %d", wrapped.lineCount.Int())
+	}
+
+	// text without a width is not wrapped but line breaks are respected
+	if natural.lineCount.Int() != 2 {
+		t.Errorf("expected 2 lines, got %d", natural.lineCount.Int())
+	}
+	if height := natural.contentHeight.Float64(); height != 4*lineHeight {
+		t.Errorf("expected a content height of %v, got %v", 4*lineHeight, height)
+	}
+	if width := natural.contentWidth.Float64(); width != natural.fontFaceData.TextWidth("second line") {
+		t.Errorf("expected the content to be as wide as the longest line, got %v", width)
+	}
+	natural.text.SetStringValue("first\nsecond line that is a lot longer")
+	if natural.lineCount.Int() != 2 || natural.Bounds().Width() != natural.contentWidth.Float64() {
+		t.Errorf("expected the item to grow with its text")
+	}
+}
+
+func TestTextElide(t *testing.T) {
+	manager, _ := loadTestComponent(t, textSource)
+	wrapped := findComponents[*Text](manager.MainComponent())[0]
+	if wrapped.fontFaceData == nil {
+		t.Fatal("the test font has not been loaded")
+	}
+
+	line := "one two three"
+	if elided := wrapped.elideLine(Text_Elide_ElideRight, line, 1000, true); elided != line+"..." {
+		t.Errorf("expected the last line to end with an ellipsis, got %q", elided)
+	}
+	width := wrapped.fontFaceData.TextWidth("one two")
+	elided := wrapped.elideLine(Text_Elide_ElideLeft, line, width, false)
+	if len(elided) < 4 || elided[:3] != "..." || wrapped.fontFaceData.TextWidth(elided) > width {
+		t.Errorf("unexpected left elided text %q", elided)
+	}
+}
+
+func TestRichTextParsing(t *testing.T) {
+	styled := parseStyledText(`plain <b>bold <i>both</i></b> <font color="red" size=20>red</font> <a href='https://example.com'>link</a>&amp;<br/>end`)
+	expected := []textSpan{
+		{"plain ", spanStyle{}},
+		{"bold ", spanStyle{bold: true}},
+		{"both", spanStyle{bold: true, italic: true}},
+		{" ", spanStyle{}},
+		{"red", spanStyle{color: color.RGBA{255, 0, 0, 255}, pointSize: 20}},
+		{" ", spanStyle{}},
+		{"link", spanStyle{link: "https://example.com"}},
+		{"&\nend", spanStyle{}},
+	}
+	if !reflect.DeepEqual(styled, expected) {
+		t.Errorf("unexpected styled text spans:\n%v\nexpected\n%v", styled, expected)
+	}
+
+	markdown := parseMarkdownText(`a **bold _both_** \*not\* ~~gone~~ [link](https://example.com) x*y`)
+	expected = []textSpan{
+		{"a ", spanStyle{}},
+		{"bold ", spanStyle{bold: true}},
+		{"both", spanStyle{bold: true, italic: true}},
+		{" *not* ", spanStyle{}},
+		{"gone", spanStyle{strikeout: true}},
+		{" ", spanStyle{}},
+		{"link", spanStyle{link: "https://example.com"}},
+		{" x*y", spanStyle{}},
+	}
+	if !reflect.DeepEqual(markdown, expected) {
+		t.Errorf("unexpected markdown spans:\n%v\nexpected\n%v", markdown, expected)
+	}
+
+	if mightBeStyledText("1 < 2 > 0") || !mightBeStyledText("a <b>b</b>") {
+		t.Errorf("styled text has not been detected correctly")
+	}
+}
+
+const linkSource = `import Vit 1.0
+
+Item {
+    width: 800
+    height: 800
+
+    Text {
+        text: "see <a href=\"first\">here</a> or <b><a href=\"second\">there</a></b>"
+        textFormat: Text.AutoText
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+}
+`
+
+func TestTextLinks(t *testing.T) {
+	manager, handler := loadTestComponent(t, linkSource)
+	text := findComponents[*Text](manager.MainComponent())[0]
+	if text.fontFaceData == nil {
+		t.Fatal("the test font has not been loaded")
+	}
+	var activated []string
+	text.onLinkActivated.AddListener(vit.ListenerCB(func(e *LinkEvent) { activated = append(activated, e.Link) }))
+
+	face := text.fontFaceData
+	y := face.LineHeight() / 2
+	firstX := face.TextWidth("see h")
+	secondX := face.TextWidth("see here or th")
+	if link := text.LinkAt(firstX, y); link != "first" {
+		t.Errorf("expected the first link, got %q", link)
+	}
+	if link := text.LinkAt(secondX, y); link != "second" {
+		t.Errorf("expected the second link, got %q", link)
+	}
+	if link := text.LinkAt(face.TextWidth("se"), y); link != "" {
+		t.Errorf("expected no link, got %q", link)
+	}
+
+	handler.TriggerMouseEvent(MouseEvent{X: int(firstX), Y: int(y)})
+	if text.hoveredLink.String() != "first" || handler.CursorShape() != MouseArea_CursorShape_PointingHandCursor {
+		t.Errorf("expected the link to be hovered")
+	}
+	handler.TriggerMouseEvent(MouseEvent{X: int(firstX), Y: int(y), Buttons: MouseArea_MouseButtons_leftButton})
+	handler.TriggerMouseEvent(MouseEvent{X: int(firstX), Y: int(y)})
+	if !reflect.DeepEqual(activated, []string{"first"}) {
+		t.Errorf("expected the first link to be activated, got %v", activated)
+	}
+}
+
+const coveredLinkSource = `import Vit 1.0
+
+Item {
+    width: 800
+    height: 800
+
+    Text {
+        text: "see <a href=\"below\">here</a>"
+        textFormat: Text.AutoText
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+    Text {
+        text: "see <a href=\"above\">here</a>"
+        textFormat: Text.AutoText
+        font.family: "` + testfont.Family + `"
+        font.pointSize: 60
+    }
+    MouseArea {
+        id: cover
+        anchors.fill: parent
+        enabled: false
+    }
+}
+`
+
+func TestTextLinksCovered(t *testing.T) {
+	manager, handler := loadTestComponent(t, coveredLinkSource)
+	texts := findComponents[*Text](manager.MainComponent())
+	var activated []string
+	for _, text := range texts {
+		text.onLinkActivated.AddListener(vit.ListenerCB(func(e *LinkEvent) { activated = append(activated, e.Link) }))
+	}
+	face := texts[0].fontFaceData
+	if face == nil {
+		t.Fatal("the test font has not been loaded")
+	}
+	x, y := int(face.TextWidth("see h")), int(face.LineHeight()/2)
+	click := func(pressX, pressY int) {
+		handler.TriggerMouseEvent(MouseEvent{X: pressX, Y: pressY, Buttons: MouseArea_MouseButtons_leftButton})
+		handler.TriggerMouseEvent(MouseEvent{X: x, Y: y})
+	}
+
+	// only the topmost text receives the mouse
+	click(x, y)
+	if !reflect.DeepEqual(activated, []string{"above"}) {
+		t.Errorf("expected only the link of the text on top to be activated, got %v", activated)
+	}
+	if texts[0].hoveredLink.String() != "" {
+		t.Errorf("expected the covered link not to be hovered")
+	}
+
+	// a press outside of the text doesn't activate the link it is released on
+	activated = nil
+	click(x, 700)
+	if len(activated) != 0 {
+		t.Errorf("expected no link to be activated, got %v", activated)
+	}
+
+	// an enabled mouse area covers both texts
+	cover := findComponents[*MouseArea](manager.MainComponent())[0]
+	cover.SetProperty("enabled", true)
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(errs)
+	}
+	click(x, y)
+	if len(activated) != 0 {
+		t.Errorf("expected no link to be activated, got %v", activated)
+	}
+	if texts[1].hoveredLink.String() != "" || handler.CursorShape() == MouseArea_CursorShape_PointingHandCursor {
+		t.Errorf("expected the covered link not to be hovered")
+	}
+}
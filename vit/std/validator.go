@@ -0,0 +1,84 @@
+package std
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ValidationState describes how acceptable a text is for a Validator.
+type ValidationState int
+
+const (
+	InvalidInput      ValidationState = iota // the text can't become acceptable by adding more characters
+	IntermediateInput                        // the text is not acceptable yet but could become acceptable
+	AcceptableInput                          // the text is acceptable
+)
+
+// Validator decides which texts a user is allowed to enter into a TextInput.
+// Every component that implements this interface can be used as the validator of a TextInput.
+// Key presses that would result in an invalid text are rejected.
+type Validator interface {
+	Validate(text string) ValidationState
+}
+
+// changingValidator is implemented by validators whose rules can change, for example because their properties are bound to other components.
+// The function is called whenever the rules have changed so that the text can be validated again.
+type changingValidator interface {
+	Validator
+	onRulesChanged(func())
+}
+
+// rulesListener is used by validator components to implement changingValidator.
+type rulesListener struct {
+	changed func()
+}
+
+func (l *rulesListener) notify() {
+	if l.changed != nil {
+		l.changed()
+	}
+}
+
+// ValidatorFunc implements a Validator using a function.
+type ValidatorFunc func(text string) ValidationState
+
+func (f ValidatorFunc) Validate(text string) ValidationState {
+	return f(text)
+}
+
+// numberRangeState returns the state of a number that is outside of the range between bottom and top.
+// The number is intermediate if appending more digits could still bring it into the range.
+func numberRangeState(negative bool, integerDigits int, bottom, top float64) ValidationState {
+	if negative && bottom >= 0 || !negative && top < 0 {
+		return InvalidInput
+	}
+	limit := top
+	if negative {
+		limit = bottom
+	}
+	if integerDigits < digitCount(limit) {
+		return IntermediateInput
+	}
+	return InvalidInput
+}
+
+// digitCount returns the number of digits in front of the decimal point of the absolute value.
+func digitCount(value float64) int {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return math.MaxInt
+	}
+	return len(strconv.FormatFloat(math.Abs(math.Trunc(value)), 'f', 0, 64))
+}
+
+// splitSign removes a leading plus or minus from the text and reports whether it was a minus.
+func splitSign(text string) (string, bool) {
+	if strings.HasPrefix(text, "-") {
+		return text[1:], true
+	}
+	return strings.TrimPrefix(text, "+"), false
+}
+
+func isNotDigit(r rune) bool {
+	return r < '0' || r > '9'
+}
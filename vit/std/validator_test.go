@@ -0,0 +1,206 @@
+package std
+
+import (
+	"strings"
+	"testing"
+
+	vit "github.com/omniskop/vitrum/vit"
+)
+
+const validatorSource = `import Vit 1.0
+
+Item {
+    width: 400
+    height: 400
+
+    TextInput {
+        id: number
+        width: 300
+        height: 30
+        focus: true
+        validator: IntValidator {
+            bottom: -50
+            top: 150
+        }
+    }
+
+    TextInput {
+        id: double
+        validator: DoubleValidator {
+            bottom: 0
+            decimals: 2
+            notation: DoubleValidator.StandardNotation
+        }
+    }
+
+    TextInput {
+        id: pattern
+        validator: RegularExpressionValidator {
+            regularExpression: "[a-c]+-\\d{2}"
+        }
+    }
+
+    TextInput {
+        id: masked
+        inputMask: ">AA-9999;_"
+    }
+}
+`
+
+func TestValidators(t *testing.T) {
+	manager, _ := loadTestComponent(t, validatorSource)
+	inputs := findComponents[*TextInput](manager.MainComponent())
+	if len(inputs) != 4 {
+		t.Fatalf("expected 4 inputs, got %d", len(inputs))
+	}
+
+	tests := []struct {
+		input    *TextInput
+		text     string
+		expected ValidationState
+	}{
+		{inputs[0], "", IntermediateInput},
+		{inputs[0], "-", IntermediateInput},
+		{inputs[0], "42", AcceptableInput},
+		{inputs[0], "-50", AcceptableInput},
+		{inputs[0], "-5", AcceptableInput},
+		{inputs[0], "-500", InvalidInput},
+		{inputs[0], "151", InvalidInput},
+		{inputs[0], "4a", InvalidInput},
+		{inputs[1], "1.25", AcceptableInput},
+		{inputs[1], ".", IntermediateInput},
+		{inputs[1], "1.255", InvalidInput},
+		{inputs[1], "1e5", InvalidInput},
+		{inputs[1], "-1", InvalidInput},
+		{inputs[2], "abc-12", AcceptableInput},
+		{inputs[2], "ab", IntermediateInput},
+		{inputs[2], "abc-1", IntermediateInput},
+		{inputs[2], "abd", InvalidInput},
+		{inputs[2], "abc-123", InvalidInput},
+	}
+	for _, test := range tests {
+		if state := test.input.validate(test.text); state != test.expected {
+			t.Errorf("%s: expected %q to be %d, got %d", test.input.ID(), test.text, test.expected, state)
+		}
+	}
+}
+
+func TestTextInputValidation(t *testing.T) {
+	manager, handler := loadTestComponent(t, validatorSource)
+	inputs := findComponents[*TextInput](manager.MainComponent())
+	number, masked := inputs[0], inputs[3]
+
+	var accepted int
+	number.onAccepted.AddListener(vit.ListenerCB(func(e *TextInputEvent) { accepted++ }))
+
+	write := func(text string) {
+		for _, r := range text {
+			handler.TriggerKeyEvent(KeyEvent{Pressed: true, Text: string(r)})
+		}
+	}
+
+	// invalid key presses are rejected
+	write("1x2")
+	if number.text.String() != "12" {
+		t.Errorf("expected text %q, got %q", "12", number.text.String())
+	}
+	if !number.acceptableInput.Bool() {
+		t.Errorf("expected the input to be acceptable")
+	}
+	write("34")
+	if number.text.String() != "123" {
+		t.Errorf("expected text %q, got %q", "123", number.text.String())
+	}
+
+	// intermediate input can't be accepted
+	number.text.SetStringValue("-")
+	if number.acceptableInput.Bool() {
+		t.Errorf("expected the input not to be acceptable")
+	}
+	handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: "Enter"})
+	if accepted != 0 {
+		t.Errorf("intermediate input has been accepted")
+	}
+
+	// validators can be set from go
+	number.SetValidator(ValidatorFunc(func(text string) ValidationState {
+		if strings.Contains(text, "!") {
+			return InvalidInput
+		}
+		return AcceptableInput
+	}))
+	write("a!b")
+	if number.text.String() != "-ab" {
+		t.Errorf("expected text %q, got %q", "-ab", number.text.String())
+	}
+
+	// input masks
+	masked.ForceActiveFocus(OtherFocusReason)
+	if masked.displayText.String() != "__-____" || masked.text.String() != "-" {
+		t.Errorf("unexpected empty mask %q / %q", masked.displayText.String(), masked.text.String())
+	}
+	write("ab1x2")
+	if masked.displayText.String() != "AB-12__" {
+		t.Errorf("unexpected masked text %q", masked.displayText.String())
+	}
+	if masked.acceptableInput.Bool() {
+		t.Errorf("expected the incomplete mask not to be acceptable")
+	}
+	write("34")
+	if masked.text.String() != "AB-1234" || !masked.acceptableInput.Bool() {
+		t.Errorf("expected the complete mask to be acceptable, got %q", masked.text.String())
+	}
+	handler.TriggerKeyEvent(KeyEvent{Pressed: true, Key: "Backspace"})
+	if masked.displayText.String() != "AB-123_" {
+		t.Errorf("unexpected masked text after deleting %q", masked.displayText.String())
+	}
+}
+
+func TestValidatorBindings(t *testing.T) {
+	manager, _ := loadTestComponent(t, `import Vit 1.0
+
+Item {
+    id: root
+    property int maxValue: 10
+    property string pattern: "a+"
+
+    TextInput {
+        text: "50"
+        validator: IntValidator {
+            top: root.maxValue
+        }
+    }
+
+    TextInput {
+        text: "bb"
+        validator: RegularExpressionValidator {
+            regularExpression: root.pattern
+        }
+    }
+}
+`)
+	inputs := findComponents[*TextInput](manager.MainComponent())
+	number, pattern := inputs[0], inputs[1]
+	if number.acceptableInput.Bool() || pattern.acceptableInput.Bool() {
+		t.Fatalf("expected the inputs not to be acceptable")
+	}
+
+	// the inputs are validated again when the properties of their validators change
+	manager.MainComponent().SetProperty("maxValue", 100)
+	manager.MainComponent().SetProperty("pattern", "b+")
+	if errs := manager.UpdateFully(); errs.Failed() {
+		t.Fatal(errs)
+	}
+	if !number.acceptableInput.Bool() {
+		t.Errorf("expected %q to be acceptable after the top has been raised", number.text.String())
+	}
+	if !pattern.acceptableInput.Bool() {
+		t.Errorf("expected %q to be acceptable after the regular expression has been changed", pattern.text.String())
+	}
+
+	// a replaced validator is removed from the children
+	number.SetValidator(nil)
+	if children := len(number.Children()); children != 0 {
+		t.Errorf("expected the validator to be removed, got %d children", children)
+	}
+}
@@ -4,46 +4,36 @@ import (
 	"encoding/hex"
 	"fmt"
 	"image/color"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/lucasb-eyer/go-colorful"
 )
 
-// CSS color names from "Basic color keywords" https://www.w3.org/TR/css-color-3/#html4
-// plus some extra
-var ColorNames = map[string]color.Color{
-	"transparent": color.Transparent,
-	"opaque":      color.Opaque,
-
-	"black":   color.Black,
-	"silver":  color.RGBA{192, 192, 192, 255},
-	"gray":    color.RGBA{128, 128, 128, 255},
-	"grey":    color.RGBA{128, 128, 128, 255},
-	"white":   color.White,
-	"maroon":  color.RGBA{128, 0, 0, 255},
-	"red":     color.RGBA{255, 0, 0, 255},
-	"purple":  color.RGBA{128, 0, 128, 255},
-	"fuchsia": color.RGBA{255, 0, 255, 255},
-	"green":   color.RGBA{0, 128, 0, 255},
-	"lime":    color.RGBA{0, 255, 0, 255},
-	"olive":   color.RGBA{128, 128, 0, 255},
-	"yellow":  color.RGBA{255, 255, 0, 255},
-	"navy":    color.RGBA{0, 0, 128, 255},
-	"blue":    color.RGBA{0, 0, 255, 255},
-	"teal":    color.RGBA{0, 128, 128, 255},
-	"aqua":    color.RGBA{0, 255, 255, 255},
-}
-
+// StringRGBA parses a color string and returns its non-premultiplied components.
+// See String for the supported formats.
 func StringRGBA(input string) (r, g, b, a byte, err error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, 0, 0, 0, fmt.Errorf("empty color")
+	}
 	if input[0] == '#' {
 		// hex rgb(a) color
 		switch len(input) {
-		case 4:
-			var out [3]byte
-			_, err := hex.Decode(out[0:3], []byte{input[1], input[1], input[2], input[2], input[3], input[3]})
+		case 4, 5:
+			var expanded []byte
+			for _, digit := range []byte(input[1:]) {
+				expanded = append(expanded, digit, digit)
+			}
+			out, err := hex.DecodeString(string(expanded))
 			if err != nil {
 				return 0, 0, 0, 0, err
 			}
-			return out[0], out[1], out[2], 255, nil
+			if len(out) == 3 {
+				return out[0], out[1], out[2], 255, nil
+			}
+			return out[0], out[1], out[2], out[3], nil
 		case 7:
 			out, err := hex.DecodeString(input[1:])
 			if err != nil {
@@ -59,36 +49,174 @@ func StringRGBA(input string) (r, g, b, a byte, err error) {
 		default:
 			return 0, 0, 0, 0, fmt.Errorf("invalid color code %q", input)
 		}
+	} else if strings.HasSuffix(input, ")") {
+		// functional notation like rgb(...) or hsl(...)
+		c, err := parseFunction(input)
+		if err != nil {
+			return 0, 0, 0, 0, err
+		}
+		return c.R, c.G, c.B, c.A, nil
 	} else {
 		// css color name
-		if c, ok := ColorNames[input]; ok {
-			r, g, b, a := c.RGBA()
-			return byte(r >> 8), byte(g >> 8), byte(b >> 8), byte(a >> 8), nil
+		if c, ok := ColorNames[strings.ToLower(input)]; ok {
+			n := color.NRGBAModel.Convert(c).(color.NRGBA)
+			return n.R, n.G, n.B, n.A, nil
 		}
 		return 0, 0, 0, 0, fmt.Errorf("unknown color %q", input)
 	}
 }
 
+// String parses a color string. It supports CSS color names, hex codes in the forms #rgb, #rgba, #rrggbb and #rrggbbaa
+// as well as the functional notations rgb(), rgba(), hsl(), hsla(), hsv() and hsva().
+// Opaque colors are returned as color.RGBA and translucent colors as color.NRGBA.
 func String(input string) (color.Color, error) {
-	if input[0] != '#' {
+	if name := strings.ToLower(strings.TrimSpace(input)); name != "" && name[0] != '#' {
 		// doing this through StringRGBA would cause unnecessary conversions
-		if c, ok := ColorNames[input]; ok {
+		if c, ok := ColorNames[name]; ok {
 			return c, nil
 		}
-		return nil, fmt.Errorf("unknown color %q", input)
 	}
 
 	r, g, b, a, err := StringRGBA(input)
 	if err != nil {
 		return nil, err
 	}
-	return color.RGBA{r, g, b, a}, nil
+	if a == 255 {
+		return color.RGBA{r, g, b, a}, nil
+	}
+	return color.NRGBA{r, g, b, a}, nil
+}
+
+// parseFunction parses colors in functional notation. Arguments can be separated by commas or spaces
+// and the alpha value can also be separated by a slash like in rgb(255 0 0 / 50%).
+func parseFunction(input string) (color.NRGBA, error) {
+	open := strings.IndexByte(input, '(')
+	if open < 0 {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q", input)
+	}
+	name := strings.ToLower(strings.TrimSpace(input[:open]))
+	args := strings.FieldsFunc(input[open+1:len(input)-1], func(r rune) bool {
+		return r == ',' || r == '/' || r == ' ' || r == '\t'
+	})
+	if len(args) != 3 && len(args) != 4 {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q: expected 3 or 4 arguments", input)
+	}
+
+	alpha := 1.0
+	if len(args) == 4 {
+		var err error
+		alpha, err = parseFraction(args[3], 1)
+		if err != nil {
+			return color.NRGBA{}, fmt.Errorf("invalid color %q: %w", input, err)
+		}
+	}
+
+	var values [3]float64
+	for i := 0; i < 3; i++ {
+		var err error
+		switch {
+		case name == "rgb" || name == "rgba":
+			values[i], err = parseFraction(args[i], 255)
+		case i == 0:
+			values[i], err = parseHue(args[i])
+		default:
+			values[i], err = parseFraction(args[i], 100)
+		}
+		if err != nil {
+			return color.NRGBA{}, fmt.Errorf("invalid color %q: %w", input, err)
+		}
+	}
+
+	switch name {
+	case "rgb", "rgba":
+		return RGBA(values[0], values[1], values[2], alpha), nil
+	case "hsl", "hsla":
+		return HSLA(values[0], values[1], values[2], alpha), nil
+	case "hsv", "hsva", "hsb", "hsba":
+		return HSVA(values[0], values[1], values[2], alpha), nil
+	}
+	return color.NRGBA{}, fmt.Errorf("unknown color function %q", name)
+}
+
+// parseFraction parses a percentage or a number in the range of 0 to max and returns it as a value between 0 and 1.
+func parseFraction(arg string, max float64) (float64, error) {
+	if strings.HasSuffix(arg, "%") {
+		max = 100
+		arg = arg[:len(arg)-1]
+	}
+	value, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", arg)
+	}
+	return clamp(value / max), nil
+}
+
+// parseHue parses an angle and returns it in degrees. Angles without a unit are in degrees.
+func parseHue(arg string) (float64, error) {
+	factor := 1.0
+	// grad has to be checked before rad
+	for _, unit := range []struct {
+		suffix string
+		factor float64
+	}{{"deg", 1}, {"turn", 360}, {"grad", 0.9}, {"rad", 180 / math.Pi}} {
+		if strings.HasSuffix(arg, unit.suffix) {
+			factor = unit.factor
+			arg = arg[:len(arg)-len(unit.suffix)]
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid angle %q", arg)
+	}
+	return normalizeHue(value * factor), nil
+}
+
+func normalizeHue(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
+
+func clamp(value float64) float64 {
+	if value < 0 {
+		return 0
+	}
+	if value > 1 {
+		return 1
+	}
+	return value
+}
+
+// RGBA returns the color with the given components which are in the range of 0 to 1.
+func RGBA(r, g, b, a float64) color.NRGBA {
+	return color.NRGBA{
+		R: uint8(math.Round(clamp(r) * 255)),
+		G: uint8(math.Round(clamp(g) * 255)),
+		B: uint8(math.Round(clamp(b) * 255)),
+		A: uint8(math.Round(clamp(a) * 255)),
+	}
+}
+
+// HSLA returns the color with the given hue in degrees and saturation, lightness and alpha in the range of 0 to 1.
+func HSLA(h, s, l, a float64) color.NRGBA {
+	c := colorful.Hsl(normalizeHue(h), clamp(s), clamp(l))
+	return RGBA(c.R, c.G, c.B, a)
+}
+
+// HSVA returns the color with the given hue in degrees and saturation, value and alpha in the range of 0 to 1.
+func HSVA(h, s, v, a float64) color.NRGBA {
+	c := colorful.Hsv(normalizeHue(h), clamp(s), clamp(v))
+	return RGBA(c.R, c.G, c.B, a)
 }
 
+// ToColorful converts the color into its non-premultiplied color components and its alpha value.
 func ToColorful(in color.Color) (colorful.Color, byte) {
-	r, g, b, a := in.RGBA()
-	out := colorful.Color{R: float64(r) / 65535, G: float64(g) / 65535, B: float64(b) / 65535}
-	return out, byte(a >> 8)
+	c := color.NRGBA64Model.Convert(in).(color.NRGBA64)
+	out := colorful.Color{R: float64(c.R) / 65535, G: float64(c.G) / 65535, B: float64(c.B) / 65535}
+	return out, byte(c.A >> 8)
 }
 
 func RGBAToHex(r, g, b, a byte) string {
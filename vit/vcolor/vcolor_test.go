@@ -0,0 +1,45 @@
+package vcolor
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected color.NRGBA
+	}{
+		{"red", color.NRGBA{255, 0, 0, 255}},
+		{"RebeccaPurple", color.NRGBA{102, 51, 153, 255}},
+		{"lightgoldenrodyellow", color.NRGBA{250, 250, 210, 255}},
+		{"transparent", color.NRGBA{0, 0, 0, 0}},
+		{"#f80", color.NRGBA{255, 136, 0, 255}},
+		{"#f808", color.NRGBA{255, 136, 0, 136}},
+		{"#00ff0080", color.NRGBA{0, 255, 0, 128}},
+		{"rgb(255, 0, 128)", color.NRGBA{255, 0, 128, 255}},
+		{"rgba(100%, 0%, 0%, 0.5)", color.NRGBA{255, 0, 0, 128}},
+		{"rgb(0 0 255 / 25%)", color.NRGBA{0, 0, 255, 64}},
+		{"hsl(120, 100%, 50%)", color.NRGBA{0, 255, 0, 255}},
+		{"hsla(0.5turn, 100%, 50%, 0.5)", color.NRGBA{0, 255, 255, 128}},
+		{"hsl(-120deg 100% 50%)", color.NRGBA{0, 0, 255, 255}},
+		{"hsv(60, 100%, 100%)", color.NRGBA{255, 255, 0, 255}},
+		{"hsva(240, 50%, 100%, 1)", color.NRGBA{128, 128, 255, 255}},
+	}
+	for _, test := range tests {
+		c, err := String(test.input)
+		if err != nil {
+			t.Errorf("%q: %v", test.input, err)
+			continue
+		}
+		if n := color.NRGBAModel.Convert(c).(color.NRGBA); n != test.expected {
+			t.Errorf("%q: expected %v, got %v", test.input, test.expected, n)
+		}
+	}
+
+	for _, input := range []string{"", "#12", "#gggggg", "notacolor", "rgb(1, 2)", "hsl(a, 1, 1)", "foo(1, 2, 3)"} {
+		if _, err := String(input); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package vfont
+
+import (
+	"container/list"
+	"image/color"
+	"sync"
+
+	"github.com/tdewolff/canvas"
+)
+
+// The default number of entries that are kept in the caches.
+const (
+	DefaultFontCacheSize = 32
+	DefaultFaceCacheSize = 256
+)
+
+// cacheMutex guards all caches as well as the registered fonts and the fallback font.
+// Fonts are loaded from every goroutine that updates a window.
+var cacheMutex sync.Mutex
+
+// cachedFonts contains the system fonts that have been loaded. Each font family only contains a single style.
+var cachedFonts = newLRU[fontKey, *canvas.FontFamily](DefaultFontCacheSize)
+
+// cachedFaces contains the faces that have been created so that they can be shared between all users of the same style.
+var cachedFaces = newLRU[faceKey, *canvas.FontFace](DefaultFaceCacheSize)
+
+// fontKey identifies a single font file of a family.
+type fontKey struct {
+	family string
+	weight Weight
+	italic bool
+}
+
+// faceKey identifies a face of a font family. It contains all fields of the style in a comparable form.
+type faceKey struct {
+	family    string
+	color     color.RGBA64
+	hasColor  bool
+	pointSize float64
+	pixelSize int
+	italic    bool
+	underline bool
+	strikeout bool
+	weight    Weight
+}
+
+func newFaceKey(family string, style Style) faceKey {
+	key := faceKey{
+		family:    family,
+		pointSize: style.PointSize,
+		pixelSize: style.PixelSize,
+		italic:    style.Italic,
+		underline: style.Underline,
+		strikeout: style.Strikeout,
+		weight:    style.Weight,
+	}
+	if style.Color != nil {
+		key.color = color.RGBA64Model.Convert(style.Color).(color.RGBA64)
+		key.hasColor = true
+	}
+	return key
+}
+
+// SetCacheSize sets the number of loaded fonts and created faces that are kept in memory.
+// The least recently used entries are removed once the limits are exceeded.
+func SetCacheSize(fonts int, faces int) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	cachedFonts.resize(fonts)
+	cachedFaces.resize(faces)
+}
+
+// ClearCache removes all loaded fonts and faces from the cache. Registered fonts are kept.
+func ClearCache() {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	cachedFonts.removeIf(func(fontKey) bool { return true })
+	cachedFaces.removeIf(func(faceKey) bool { return true })
+}
+
+// invalidateFamily removes all cache entries of a family. The cacheMutex needs to be held.
+func invalidateFamily(family string) {
+	cachedFonts.removeIf(func(key fontKey) bool { return key.family == family })
+	cachedFaces.removeIf(func(key faceKey) bool { return key.family == family })
+}
+
+// lru is a cache with a limited size that removes the least recently used entries first.
+// It is not safe for concurrent use.
+type lru[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List // the most recently used entry is at the front
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRU[K comparable, V any](capacity int) *lru[K, V] {
+	return &lru[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *lru[K, V]) get(key K) (V, bool) {
+	element, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*lruEntry[K, V]).value, true
+}
+
+func (c *lru[K, V]) add(key K, value V) {
+	if element, ok := c.items[key]; ok {
+		element.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(element)
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key, value})
+	c.evict()
+}
+
+func (c *lru[K, V]) removeIf(predicate func(K) bool) {
+	for key, element := range c.items {
+		if predicate(key) {
+			c.order.Remove(element)
+			delete(c.items, key)
+		}
+	}
+}
+
+func (c *lru[K, V]) resize(capacity int) {
+	c.capacity = capacity
+	c.evict()
+}
+
+func (c *lru[K, V]) evict() {
+	for c.order.Len() > c.capacity && c.order.Len() > 0 {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+func (c *lru[K, V]) len() int {
+	return c.order.Len()
+}
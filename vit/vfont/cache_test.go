@@ -0,0 +1,81 @@
+package vfont
+
+import (
+	"image/color"
+	"sync"
+	"testing"
+
+	"github.com/omniskop/vitrum/internal/testfont"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+func TestLRU(t *testing.T) {
+	cache := newLRU[string, int](2)
+	cache.add("a", 1)
+	cache.add("b", 2)
+	if _, ok := cache.get("a"); !ok {
+		t.Fatalf("expected a to be cached")
+	}
+	// b is the least recently used entry now
+	cache.add("c", 3)
+	if _, ok := cache.get("b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	if v, ok := cache.get("a"); !ok || v != 1 {
+		t.Errorf("expected a to be kept")
+	}
+	if v, ok := cache.get("c"); !ok || v != 3 {
+		t.Errorf("expected c to be cached")
+	}
+
+	cache.resize(1)
+	if cache.len() != 1 {
+		t.Errorf("expected a single entry after resizing, got %d", cache.len())
+	}
+	if _, ok := cache.get("c"); !ok {
+		t.Errorf("expected the most recently used entry to be kept")
+	}
+	cache.removeIf(func(key string) bool { return key == "c" })
+	if cache.len() != 0 {
+		t.Errorf("expected the cache to be empty, got %d entries", cache.len())
+	}
+}
+
+func TestSharedFaces(t *testing.T) {
+	err := RegisterFontPath("Cache Test Family", Style{Weight: Normal}, vpath.FS(testfont.FS, testfont.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	style := Style{PointSize: 12, Color: color.RGBA{255, 0, 0, 255}}
+	first, err := LoadFontFace("Cache Test Family", style)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// equal colors of different types share the same face
+	style.Color = color.NRGBA{255, 0, 0, 255}
+	second, _ := LoadFontFace("Cache Test Family", style)
+	if first != second {
+		t.Errorf("expected the face to be shared")
+	}
+	style.PointSize = 14
+	if third, _ := LoadFontFace("Cache Test Family", style); third == first {
+		t.Errorf("expected a different face for a different size")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_, err := LoadFontFace("Cache Test Family", Style{PointSize: float64(10 + (i+j)%20)})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
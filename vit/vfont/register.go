@@ -0,0 +1,119 @@
+package vfont
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/omniskop/vitrum/vit/vpath"
+	"github.com/tdewolff/canvas"
+	"github.com/tdewolff/canvas/font"
+)
+
+// registeredFonts contains all fonts that have been registered by the application.
+// They take priority over the fonts installed on the system.
+var registeredFonts = make(map[string]*canvas.FontFamily)
+
+// fallbackFamily is used if a font family can't be found.
+var fallbackFamily string
+
+// RegisterFont registers a font in TTF, OTF or WOFF format for the given family and style.
+// Fonts that have been registered take priority over fonts with the same family name that are installed on the system.
+// Only the weight and italic flag of the style are relevant.
+func RegisterFont(family string, style Style, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("unable to read font %q: %w", family, err)
+	}
+
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	loadedFamily, ok := registeredFonts[family]
+	if !ok {
+		loadedFamily = canvas.NewFontFamily(family)
+	}
+	err = loadedFamily.LoadFont(data, 0, style.canvasStyle())
+	if err != nil {
+		return fmt.Errorf("unable to parse font %q: %w", family, err)
+	}
+	registeredFonts[family] = loadedFamily
+	// faces of the system font with the same name should not be used anymore
+	invalidateFamily(family)
+	return nil
+}
+
+// RegisterFontFS registers the font file with the given name from a file system like embed.FS.
+func RegisterFontFS(family string, style Style, fsys fs.FS, name string) error {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return RegisterFont(family, style, file)
+}
+
+// RegisterFontPath registers the font file at the given path.
+func RegisterFontPath(family string, style Style, path vpath.Path) error {
+	file, err := path.OpenFile()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return RegisterFont(family, style, file)
+}
+
+// DescribeFont returns the family name and style that are stored in the font data.
+func DescribeFont(data []byte) (string, Style, error) {
+	sfnt, err := font.ParseFont(data, 0)
+	if err != nil {
+		return "", Style{}, err
+	}
+
+	var family string
+	for _, id := range []font.NameID{font.NamePreferredFamily, font.NameFontFamily} {
+		if records := sfnt.Name.Get(id); len(records) > 0 {
+			family = records[0].String()
+			break
+		}
+	}
+
+	style := Style{Weight: Normal}
+	if sfnt.OS2 != nil {
+		if sfnt.OS2.UsWeightClass >= 100 && sfnt.OS2.UsWeightClass <= 900 {
+			// round to the closest weight that can be represented
+			style.Weight = Weight((sfnt.OS2.UsWeightClass + 50) / 100 * 100)
+		}
+		style.Italic = sfnt.OS2.FsSelection&0x0001 != 0
+	} else if sfnt.Head != nil {
+		if sfnt.Head.MacStyle[0] {
+			style.Weight = Bold
+		}
+		style.Italic = sfnt.Head.MacStyle[1]
+	}
+	return family, style, nil
+}
+
+// IsRegistered returns true if fonts have been registered for the family.
+func IsRegistered(family string) bool {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	_, ok := registeredFonts[family]
+	return ok
+}
+
+// SetFallbackFont sets the family that will be used if a requested font family is not available.
+// The fallback can be a registered or an installed font. An empty string disables the fallback.
+func SetFallbackFont(family string) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	fallbackFamily = family
+	// faces of missing families might have been created using the previous fallback
+	cachedFaces.removeIf(func(faceKey) bool { return true })
+}
+
+// FallbackFont returns the family that is used if a requested font family is not available.
+func FallbackFont() string {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	return fallbackFamily
+}
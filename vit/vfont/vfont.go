@@ -104,30 +104,45 @@ func (w Weight) searchableName() string {
 	}
 }
 
-type LoadedFont struct {
-	font   *canvas.FontFamily
-	styles map[Style]bool // what styles are contained in the font
+// LoadFontFace returns a face of the font family with the given style.
+// Registered fonts are preferred over fonts that are installed on the system. If the family can't be found the fallback font is used instead.
+// Faces are cached and shared between all callers and must not be modified. It is safe to call LoadFontFace from multiple goroutines.
+func LoadFontFace(familyName string, style Style) (*canvas.FontFace, error) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	return loadFontFace(familyName, style)
 }
 
-var cachedFonts = make(map[string]LoadedFont)
-
-func LoadFontFace(familyName string, style Style) (*canvas.FontFace, error) {
-	loadedFont, ok := cachedFonts[familyName]
-	if !ok {
-		loadedFont.font = canvas.NewFontFamily(familyName)
-		loadedFont.styles = make(map[Style]bool)
+// loadFontFace implements LoadFontFace. The cacheMutex needs to be held.
+func loadFontFace(familyName string, style Style) (*canvas.FontFace, error) {
+	key := newFaceKey(familyName, style)
+	if face, ok := cachedFaces.get(key); ok {
+		return face, nil
 	}
 
-	if !loadedFont.styles[style] {
-		err := loadedFont.font.LoadSystemFont(familyName, style.canvasStyle())
-		if err != nil {
-			return nil, err
+	family, ok := registeredFonts[familyName]
+	if !ok {
+		fontKey := fontKey{familyName, style.Weight, style.Italic}
+		family, ok = cachedFonts.get(fontKey)
+		if !ok {
+			family = canvas.NewFontFamily(familyName)
+			err := family.LoadSystemFont(familyName, style.canvasStyle())
+			if err != nil {
+				if fallbackFamily != "" && fallbackFamily != familyName {
+					return loadFontFace(fallbackFamily, style)
+				}
+				return nil, err
+			}
+			cachedFonts.add(fontKey, family)
 		}
-		loadedFont.styles[style] = true
-		cachedFonts[familyName] = loadedFont
-		// TODO: clean the cache somehow
 	}
 
+	face := newFace(family, style)
+	cachedFaces.add(key, face)
+	return face, nil
+}
+
+func newFace(family *canvas.FontFamily, style Style) *canvas.FontFace {
 	decorators := []any{
 		style.Color,
 		style.canvasStyle(),
@@ -144,7 +159,7 @@ func LoadFontFace(familyName string, style Style) (*canvas.FontFace, error) {
 		style.PointSize = PixelsToPoints(style.PixelSize)
 	}
 
-	return loadedFont.font.Face(style.PointSize, decorators...), nil
+	return family.Face(style.PointSize, decorators...)
 }
 
 func PixelsToPoints(px int) float64 {
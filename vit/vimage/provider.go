@@ -0,0 +1,149 @@
+// Package vimage allows applications to provide images that are generated in Go to Image components.
+package vimage
+
+import (
+	"fmt"
+	"image"
+	"strings"
+	"sync"
+)
+
+// URLPrefix is the prefix of all image urls that are resolved by providers.
+const URLPrefix = "image://"
+
+// Provider creates images for Image components with a path of the form image://<scheme>/<id>.
+type Provider interface {
+	// Image returns the image with the given id.
+	// The requested size is the sourceSize of the component. Each dimension is zero if the component doesn't request a specific size.
+	// Providers should use it to return an image at a fitting resolution but they are free to ignore it.
+	Image(id string, requestedSize image.Point) (image.Image, error)
+}
+
+// ProviderFunc allows an ordinary function to be used as a Provider.
+type ProviderFunc func(id string, requestedSize image.Point) (image.Image, error)
+
+func (f ProviderFunc) Image(id string, requestedSize image.Point) (image.Image, error) {
+	return f(id, requestedSize)
+}
+
+// providerMutex guards the providers, generations and listeners.
+// Providers are registered and refreshed by the application while images are loaded by every goroutine that updates a window.
+var providerMutex sync.Mutex
+
+var providers = make(map[string]Provider)
+
+// schemeGenerations and imageGenerations are incremented every time the images of a scheme or a single image are refreshed.
+var (
+	schemeGenerations = make(map[string]int)
+	imageGenerations  = make(map[string]int)
+)
+
+// refreshListeners are called whenever images have been refreshed. They are stored by an id because functions can't be compared.
+var (
+	refreshListeners = make(map[int]func())
+	nextListenerID   int
+)
+
+// RegisterProvider registers the provider for all images with urls of the form image://<scheme>/<id>.
+// A provider that has previously been registered for the scheme is replaced and all of its images are refreshed.
+func RegisterProvider(scheme string, provider Provider) {
+	providerMutex.Lock()
+	_, replaced := providers[scheme]
+	providers[scheme] = provider
+	providerMutex.Unlock()
+	if replaced {
+		Refresh(scheme, "")
+	}
+}
+
+// UnregisterProvider removes the provider of the scheme. Images of the scheme will fail to load afterwards.
+func UnregisterProvider(scheme string) {
+	providerMutex.Lock()
+	delete(providers, scheme)
+	providerMutex.Unlock()
+	Refresh(scheme, "")
+}
+
+// Refresh marks an image as outdated which causes all Image components showing it to request it from the provider again.
+// If the id is empty all images of the scheme are refreshed. It is safe to call Refresh from any goroutine.
+func Refresh(scheme, id string) {
+	providerMutex.Lock()
+	if id == "" {
+		schemeGenerations[scheme]++
+	} else {
+		imageGenerations[scheme+"/"+id]++
+	}
+	listeners := make([]func(), 0, len(refreshListeners))
+	for _, listener := range refreshListeners {
+		listeners = append(listeners, listener)
+	}
+	providerMutex.Unlock()
+
+	// listeners are called without holding the lock so that they can access the providers themselves
+	for _, listener := range listeners {
+		listener()
+	}
+}
+
+// AddRefreshListener registers a function that will be called whenever images have been refreshed.
+// It is called on the goroutine that called Refresh. Windows use it to be drawn again.
+// The returned function removes the listener again, for example after the window has been closed.
+func AddRefreshListener(listener func()) (remove func()) {
+	providerMutex.Lock()
+	defer providerMutex.Unlock()
+	id := nextListenerID
+	nextListenerID++
+	refreshListeners[id] = listener
+	return func() {
+		providerMutex.Lock()
+		defer providerMutex.Unlock()
+		delete(refreshListeners, id)
+	}
+}
+
+// IsProviderURL returns true if the path refers to an image of a provider.
+func IsProviderURL(path string) bool {
+	return strings.HasPrefix(path, URLPrefix)
+}
+
+// ParseURL splits an url of the form image://<scheme>/<id> into its scheme and id.
+// The id may contain slashes itself.
+func ParseURL(url string) (scheme, id string, err error) {
+	if !IsProviderURL(url) {
+		return "", "", fmt.Errorf("image url %q doesn't start with %s", url, URLPrefix)
+	}
+	scheme, id, ok := strings.Cut(strings.TrimPrefix(url, URLPrefix), "/")
+	if !ok || scheme == "" {
+		return "", "", fmt.Errorf("image url %q has no scheme", url)
+	}
+	return scheme, id, nil
+}
+
+// Generation returns a number that changes every time the image has been refreshed.
+func Generation(scheme, id string) int {
+	providerMutex.Lock()
+	defer providerMutex.Unlock()
+	return schemeGenerations[scheme] + imageGenerations[scheme+"/"+id]
+}
+
+// Load requests the image with the given url from its provider.
+func Load(url string, requestedSize image.Point) (image.Image, error) {
+	scheme, id, err := ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	providerMutex.Lock()
+	provider, ok := providers[scheme]
+	providerMutex.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no image provider registered for %q", scheme)
+	}
+	img, err := provider.Image(id, requestedSize)
+	if err != nil {
+		return nil, err
+	}
+	if img == nil {
+		return nil, fmt.Errorf("image provider %q returned no image for %q", scheme, id)
+	}
+	return img, nil
+}
@@ -0,0 +1,71 @@
+package vimage
+
+import (
+	"image"
+	"testing"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url, scheme, id string
+		valid           bool
+	}{
+		{"image://thumbnails/42", "thumbnails", "42", true},
+		{"image://plots/2023/sales", "plots", "2023/sales", true},
+		{"image://thumbnails/", "thumbnails", "", true},
+		{"image://thumbnails", "", "", false},
+		{"image:///42", "", "", false},
+		{"thumbnails/42", "", "", false},
+	}
+	for _, test := range tests {
+		scheme, id, err := ParseURL(test.url)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: unexpected error %v", test.url, err)
+			continue
+		}
+		if scheme != test.scheme || id != test.id {
+			t.Errorf("%q: got scheme %q and id %q but expected %q and %q", test.url, scheme, id, test.scheme, test.id)
+		}
+	}
+}
+
+func TestProvider(t *testing.T) {
+	var requested image.Point
+	RegisterProvider("test", ProviderFunc(func(id string, requestedSize image.Point) (image.Image, error) {
+		requested = requestedSize
+		return image.NewRGBA(image.Rect(0, 0, len(id), 1)), nil
+	}))
+	defer UnregisterProvider("test")
+
+	img, err := Load("image://test/abc", image.Pt(10, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds().Dx() != 3 || requested != image.Pt(10, 20) {
+		t.Errorf("provider returned %v for size %v", img.Bounds(), requested)
+	}
+	if _, err := Load("image://unknown/abc", image.Point{}); err == nil {
+		t.Error("loading from an unknown provider didn't fail")
+	}
+
+	var refreshed int
+	remove := AddRefreshListener(func() { refreshed++ })
+	first := Generation("test", "a")
+	other := Generation("test", "b")
+	Refresh("test", "a")
+	if Generation("test", "a") == first || Generation("test", "b") != other {
+		t.Error("refreshing a single image changed the wrong generations")
+	}
+	Refresh("test", "")
+	if Generation("test", "b") == other {
+		t.Error("refreshing all images of a scheme didn't change the generation of an image")
+	}
+	if refreshed != 2 {
+		t.Errorf("refresh listener has been called %d times but expected 2", refreshed)
+	}
+	remove()
+	Refresh("test", "")
+	if refreshed != 2 {
+		t.Error("removed refresh listener has been called")
+	}
+}
@@ -3,6 +3,7 @@ package vit
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/omniskop/vitrum/vit/script"
 )
@@ -65,6 +66,7 @@ func (e Enumeration) ResolveVariable(name string) (interface{}, bool) {
 
 type Method struct {
 	Name string
+	Tags map[string]string // optional tags of the method
 	AsyncFunction
 }
 
@@ -76,11 +78,19 @@ func NewMethod(name string, code Code) Method {
 }
 
 func (m Method) CopyInContext(fileCtx *FileContext) Method {
-	return NewMethod(m.Name, Code{
+	method := NewMethod(m.Name, Code{
 		FileCtx:  fileCtx,
 		Code:     m.code,
 		Position: m.Position,
 	})
+	method.Tags = m.Tags
+	return method
+}
+
+// HasTag returns true if the method has the given tag set.
+func (m Method) HasTag(tag string) bool {
+	_, ok := m.Tags[tag]
+	return ok
 }
 
 type AbstractComponent interface {
@@ -136,6 +146,7 @@ type GlobalContext struct {
 	KnownComponents ComponentContainer // globally known components
 	Variables       map[string]Value
 	Environment     ExecutionEnvironment
+	clipboard       *clipboardObject // created when it's accessed for the first time
 }
 
 func (c *GlobalContext) Get(name string) (AbstractComponent, bool) {
@@ -146,8 +157,16 @@ func (c *GlobalContext) ResolveVariable(name string) (interface{}, bool) {
 	if comp, ok := c.KnownComponents.Get(name); ok {
 		return comp, true
 	}
-	v, ok := c.Variables[name]
-	return v, ok
+	if v, ok := c.Variables[name]; ok {
+		return v, true
+	}
+	if name == "Clipboard" && c.Environment != nil {
+		if c.clipboard == nil {
+			c.clipboard = newClipboardObject(c.Environment.Clipboard())
+		}
+		return c.clipboard, true
+	}
+	return nil, false
 }
 
 func (c *GlobalContext) SetVariable(name string, value interface{}) error {
@@ -227,7 +246,12 @@ type ExecutionEnvironment interface {
 	RegisterComponent(string, Component)
 	UnregisterComponent(string, Component)
 	RequestFocus(FocusableComponent)
+	Clipboard() Clipboard
 	Logger() *log.Logger
+	ScheduleRedraw(at time.Time) // requests that the components are updated and drawn again at the given time, for example to show the next frame of an animation
+	// RunInBackground runs the work concurrently. The function it returns is called afterwards on the goroutine that updates the components to apply the results.
+	// Environments that can't wait for the results, like documents that are only drawn once, may run both functions immediately.
+	RunInBackground(work func() (finish func()))
 }
 
 // ErrorGroup contains a list of multiple error and may be used whenever multiple errors may occur without the need to fail immediately.
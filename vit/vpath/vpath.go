@@ -111,3 +111,18 @@ func (p virtualPath) Dir() Path {
 func (p virtualPath) String() string {
 	return fmt.Sprintf("VRT://%s", string(p))
 }
+
+// Join returns the path of the file with the given name relative to the directory dir.
+// Paths that are joined from the same directory and name are equal which allows them to be used as keys.
+func Join(dir Path, name string) Path {
+	switch dir := dir.(type) {
+	case fsPath:
+		return FS(dir.fs, path.Join(dir.path, name))
+	case localPath:
+		return Local(path.Join(string(dir), name))
+	case virtualPath:
+		return Virtual(path.Join(string(dir), name))
+	default:
+		return FS(dir, path.Clean(name))
+	}
+}
@@ -0,0 +1,246 @@
+// Package vittest helps to find visual regressions by rendering components in tests and comparing them against stored golden images.
+//
+// Golden images are png files that are stored in the testdata directory of the package by default.
+// Set the environment variable VITTEST_UPDATE to 1 to create them or to replace them after the appearance has been changed intentionally:
+//
+//	VITTEST_UPDATE=1 go test ./...
+//
+// If an image doesn't match, the rendered image and an image that highlights the differences are written into the "failed" directory next to the golden images.
+package vittest
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/omniskop/vitrum/raster"
+	"github.com/omniskop/vitrum/vit/vpath"
+)
+
+// update is set to write the rendered images as golden images instead of comparing them.
+// An environment variable is used instead of a flag because go test fails in packages that don't define the flag.
+var update = os.Getenv("VITTEST_UPDATE") == "1"
+
+// DefaultThreshold is the default perceptual difference above which two pixels are regarded as different.
+const DefaultThreshold = 0.1
+
+// Options control how components are rendered and compared.
+type Options struct {
+	// raster.Options set the size and pixel density of the image.
+	raster.Options
+	// Threshold is the perceptual difference between 0 and 1 above which two pixels are regarded as different.
+	// It allows small deviations like in the antialiasing of edges. If it is zero DefaultThreshold is used and a negative value only accepts identical pixels.
+	Threshold float64
+	// MaxDiffRatio is the fraction of pixels that may differ before the images don't match.
+	MaxDiffRatio float64
+	// Dir is the directory of the golden images. If it is empty "testdata" is used.
+	Dir string
+}
+
+func (o Options) threshold() float64 {
+	if o.Threshold == 0 {
+		return DefaultThreshold
+	}
+	if o.Threshold < 0 {
+		return 0
+	}
+	return o.Threshold
+}
+
+func (o Options) dir() string {
+	if o.Dir == "" {
+		return "testdata"
+	}
+	return o.Dir
+}
+
+// Load instantiates vit source code as the main file. Other files can't be referenced, LoadFS can be used for that.
+func Load(t testing.TB, source string) *raster.Renderer {
+	t.Helper()
+	return LoadFS(t, fstest.MapFS{"Main.vit": {Data: []byte(source)}}, "Main.vit")
+}
+
+// LoadFS instantiates the vit file with the given name in the file system which can also contain components, images and fonts that are used by it.
+// The test fails immediately if the file can't be instantiated. Messages that are logged by the components are written to the test log.
+func LoadFS(t testing.TB, fsys fs.ReadDirFS, name string) *raster.Renderer {
+	t.Helper()
+	if files, ok := fsys.(fstest.MapFS); ok {
+		fsys = &files // paths need to be comparable which maps are not
+	}
+	renderer, err := raster.NewRenderer(vpath.FS(fsys, name), log.New(testWriter{t}, "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := renderer.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	return renderer
+}
+
+// testWriter writes every line into the log of a test.
+type testWriter struct {
+	t testing.TB
+}
+
+func (w testWriter) Write(p []byte) (int, error) {
+	w.t.Log(strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+// MatchGolden renders the main component and compares it against the golden image with the given name.
+func MatchGolden(t testing.TB, renderer *raster.Renderer, name string, options Options) {
+	t.Helper()
+	img, err := renderer.Render(options.Options)
+	if err != nil {
+		t.Fatalf("render %s: %v", name, err)
+	}
+	MatchImage(t, img, name, options)
+}
+
+// MatchImage compares an image against the golden image with the given name. Only the tolerance and directory of the options are used.
+// The test fails if they differ or the golden image doesn't exist, unless VITTEST_UPDATE is set to 1 in which case the golden image is replaced.
+func MatchImage(t testing.TB, img image.Image, name string, options Options) {
+	t.Helper()
+	goldenPath := filepath.Join(options.dir(), name+".png")
+	actualPath := filepath.Join(options.dir(), "failed", name+".png")
+	diffPath := filepath.Join(options.dir(), "failed", name+".diff.png")
+
+	if update {
+		if err := writePNG(goldenPath, img); err != nil {
+			t.Fatalf("update %s: %v", name, err)
+		}
+		removeFailures(actualPath, diffPath)
+		t.Logf("updated golden image %s", goldenPath)
+		return
+	}
+
+	golden, err := readPNG(goldenPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		writeFailure(t, actualPath, img)
+		t.Errorf("golden image %s doesn't exist, run the tests with VITTEST_UPDATE=1 to create it", goldenPath)
+		return
+	} else if err != nil {
+		t.Fatalf("golden image %s: %v", goldenPath, err)
+	}
+
+	if img.Bounds().Size() != golden.Bounds().Size() {
+		writeFailure(t, actualPath, img)
+		t.Errorf("%s: image has size %v but the golden image has size %v, the image has been written to %s", name, img.Bounds().Size(), golden.Bounds().Size(), actualPath)
+		return
+	}
+
+	count, diff := Compare(img, golden, options.threshold())
+	size := img.Bounds().Size()
+	if float64(count) <= options.MaxDiffRatio*float64(size.X*size.Y) {
+		removeFailures(actualPath, diffPath)
+		return
+	}
+	writeFailure(t, actualPath, img)
+	writeFailure(t, diffPath, diff)
+	t.Errorf("%s: %d of %d pixels differ from the golden image, see %s and %s", name, count, size.X*size.Y, actualPath, diffPath)
+}
+
+// Compare counts the pixels of two images of the same size whose perceptual difference is above the threshold between 0 and 1.
+// The returned image shows the expected image faded out with the differing pixels marked in red.
+func Compare(actual, expected image.Image, threshold float64) (int, *image.RGBA) {
+	ab, eb := actual.Bounds(), expected.Bounds()
+	diff := image.NewRGBA(image.Rectangle{Max: eb.Size()})
+	var count int
+	for y := 0; y < eb.Dy(); y++ {
+		for x := 0; x < eb.Dx(); x++ {
+			a := actual.At(ab.Min.X+x, ab.Min.Y+y)
+			e := expected.At(eb.Min.X+x, eb.Min.Y+y)
+			if colorDelta(a, e) > threshold*threshold*maxDelta {
+				count++
+				diff.SetRGBA(x, y, color.RGBA{255, 0, 0, 255})
+				continue
+			}
+			// matching pixels are shown as a faint gray version of the expected image to give context
+			gray := uint8(255 - (255-brightness(e))/10)
+			diff.SetRGBA(x, y, color.RGBA{gray, gray, gray, 255})
+		}
+	}
+	return count, diff
+}
+
+// maxDelta is the largest possible value of colorDelta.
+const maxDelta = 35215
+
+// colorDelta measures how differently two colors are perceived using their squared distance in the YIQ color space
+// as described in "Measuring perceived color difference using YIQ NTSC transmission color space in mobile applications" by Y. Kotsarenko and F. Ramos.
+// The colors are blended onto white first so that transparent pixels compare equal regardless of their color.
+func colorDelta(a, b color.Color) float64 {
+	r1, g1, b1 := blendWhite(a)
+	r2, g2, b2 := blendWhite(b)
+	y := yiqY(r1, g1, b1) - yiqY(r2, g2, b2)
+	i := yiqI(r1, g1, b1) - yiqI(r2, g2, b2)
+	q := yiqQ(r1, g1, b1) - yiqQ(r2, g2, b2)
+	return 0.5053*y*y + 0.299*i*i + 0.1957*q*q
+}
+
+// blendWhite returns the color components between 0 and 255 after the color has been drawn onto white.
+func blendWhite(c color.Color) (float64, float64, float64) {
+	r, g, b, a := c.RGBA() // premultiplied
+	white := 0xffff - float64(a)
+	return (float64(r) + white) / 257, (float64(g) + white) / 257, (float64(b) + white) / 257
+}
+
+func yiqY(r, g, b float64) float64 { return r*0.29889531 + g*0.58662247 + b*0.11448223 }
+func yiqI(r, g, b float64) float64 { return r*0.59597799 - g*0.27417610 - b*0.32180189 }
+func yiqQ(r, g, b float64) float64 { return r*0.21147017 - g*0.52261711 + b*0.31114694 }
+
+func brightness(c color.Color) uint8 {
+	r, g, b := blendWhite(c)
+	return uint8(yiqY(r, g, b))
+}
+
+func readPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return png.Decode(file)
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(file, img)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// writeFailure writes an image that helps to investigate a failed comparison.
+func writeFailure(t testing.TB, path string, img image.Image) {
+	t.Helper()
+	if err := writePNG(path, img); err != nil {
+		t.Errorf("writing %s: %v", path, err)
+	}
+}
+
+// removeFailures removes the images of an earlier failed comparison.
+func removeFailures(paths ...string) {
+	for _, path := range paths {
+		os.Remove(path)
+	}
+	// the directory is only removed if it is empty
+	if len(paths) > 0 {
+		os.Remove(filepath.Dir(paths[0]))
+	}
+}
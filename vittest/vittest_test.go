@@ -0,0 +1,152 @@
+package vittest
+
+import (
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/omniskop/vitrum/raster"
+)
+
+const goldenSource = `import Vit 1.0
+
+Rectangle {
+    width: 20
+    height: 10
+    color: "red"
+
+    Rectangle {
+        width: 10
+        height: 10
+        color: "blue"
+    }
+}
+`
+
+// fakeT records failures instead of failing the actual test.
+// Like in testing.T, Fatalf stops the goroutine which is why the checks need to be run with expectFailure.
+type fakeT struct {
+	testing.TB
+	failed bool
+}
+
+func (t *fakeT) Helper()                                   {}
+func (t *fakeT) Log(args ...interface{})                   {}
+func (t *fakeT) Logf(format string, args ...interface{})   {}
+func (t *fakeT) Errorf(format string, args ...interface{}) { t.failed = true }
+func (t *fakeT) Fatalf(format string, args ...interface{}) { t.failed = true; runtime.Goexit() }
+
+// expectFailure runs the check in its own goroutine with a fakeT and reports whether it failed.
+func expectFailure(t *testing.T, check func(t testing.TB)) bool {
+	fake := &fakeT{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		check(fake)
+	}()
+	<-done
+	return fake.failed
+}
+
+// setUpdate overrides VITTEST_UPDATE so that the tests don't depend on the environment.
+func setUpdate(t *testing.T, value bool) {
+	previous := update
+	update = value
+	t.Cleanup(func() { update = previous })
+}
+
+func TestMatchGolden(t *testing.T) {
+	options := Options{Options: raster.Options{Scale: 2}, Dir: t.TempDir()}
+	goldenPath := filepath.Join(options.Dir, "rectangles.png")
+	diffPath := filepath.Join(options.Dir, "failed", "rectangles.diff.png")
+
+	setUpdate(t, false)
+	// a missing golden image fails the test
+	renderer := Load(t, goldenSource)
+	if !expectFailure(t, func(t testing.TB) { MatchGolden(t, renderer, "rectangles", options) }) {
+		t.Errorf("missing golden image didn't fail")
+	}
+
+	update = true
+	MatchGolden(t, renderer, "rectangles", options)
+	if _, err := os.Stat(goldenPath); err != nil {
+		t.Fatalf("golden image has not been written: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(options.Dir, "failed")); err == nil {
+		t.Errorf("images of the failed comparison have not been removed")
+	}
+
+	update = false
+	MatchGolden(t, Load(t, goldenSource), "rectangles", options)
+
+	// a barely visible change is tolerated
+	files := fstest.MapFS{"Main.vit": {Data: []byte(goldenSource)}}
+	renderer = LoadFS(t, files, "Main.vit")
+	renderer.MainComponent().SetProperty("color", color.RGBA{250, 0, 0, 255})
+	MatchGolden(t, renderer, "rectangles", options)
+
+	renderer.MainComponent().SetProperty("color", color.RGBA{0, 255, 0, 255})
+	if !expectFailure(t, func(t testing.TB) { MatchGolden(t, renderer, "rectangles", options) }) {
+		t.Fatalf("changed color didn't fail")
+	}
+	diff, err := readPNG(diffPath)
+	if err != nil {
+		t.Fatalf("diff image has not been written: %v", err)
+	}
+	// the right half has changed, the left half is covered by the blue rectangle
+	if r, g, b, _ := diff.At(30, 10).RGBA(); r>>8 != 255 || g != 0 || b != 0 {
+		t.Errorf("changed pixel is not marked in the diff image")
+	}
+	if r, g, _, _ := diff.At(10, 10).RGBA(); r == 0xffff && g == 0 {
+		t.Errorf("unchanged pixel is marked in the diff image")
+	}
+
+	// a golden image that can't be read stops the test
+	if err := os.Mkdir(filepath.Join(options.Dir, "broken.png"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	stopped := true
+	failed := expectFailure(t, func(t testing.TB) {
+		MatchGolden(t, renderer, "broken", options)
+		stopped = false
+	})
+	if !failed || !stopped {
+		t.Errorf("unreadable golden image didn't stop the test")
+	}
+
+	// the fraction of differing pixels can be allowed
+	options.MaxDiffRatio = 0.5
+	MatchGolden(t, renderer, "rectangles", options)
+	if _, err := os.Stat(diffPath); err == nil {
+		t.Errorf("diff image has not been removed after the images matched")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b      color.Color
+		threshold float64
+		differ    bool
+	}{
+		{color.RGBA{255, 0, 0, 255}, color.RGBA{255, 0, 0, 255}, 0, false},
+		{color.RGBA{255, 0, 0, 255}, color.RGBA{250, 2, 0, 255}, 0.1, false},
+		{color.RGBA{255, 0, 0, 255}, color.RGBA{250, 2, 0, 255}, 0, true},
+		{color.RGBA{255, 0, 0, 255}, color.RGBA{0, 0, 255, 255}, 0.1, true},
+		{color.RGBA{0, 0, 0, 255}, color.RGBA{255, 255, 255, 255}, 0.9, true},
+		// transparent pixels are compared as if they were drawn onto white
+		{color.RGBA{0, 0, 0, 0}, color.RGBA{255, 255, 255, 255}, 0, false},
+	}
+	for i, test := range tests {
+		a := image.NewRGBA(image.Rect(0, 0, 1, 1))
+		a.Set(0, 0, test.a)
+		b := image.NewRGBA(image.Rect(0, 0, 1, 1))
+		b.Set(0, 0, test.b)
+		if count, _ := Compare(a, b, test.threshold); (count != 0) != test.differ {
+			t.Errorf("%d: %v and %v with threshold %g: got %d differing pixels", i, test.a, test.b, test.threshold, count)
+		}
+	}
+}